@@ -0,0 +1,95 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/backup"
+)
+
+// BackupArgs is arguments that Backup service accepts.
+type BackupArgs struct {
+	Pulse uint32
+}
+
+// BackupReply is reply for Backup service requests.
+type BackupReply = backup.Result
+
+// BackupService is a service that provides API for making ledger snapshots.
+type BackupService struct {
+	runner *Runner
+}
+
+// NewBackupService creates new Backup service instance.
+func NewBackupService(runner *Runner) *BackupService {
+	return &BackupService{runner: runner}
+}
+
+// Create saves point-in-time snapshot of heavy material node storage up to provided pulse to the node's backup
+// directory.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "backup.Create",
+//     "params": {
+//       "Pulse": int // last pulse of the snapshot, 0 means the latest finalized pulse
+//     },
+//     "id": str|int|null
+//   }
+//
+//     Response structure:
+// 	{
+// 		"jsonrpc": "2.0",
+// 		"result": {
+// 			"Path": str, // snapshot file path on the node
+// 			"Pulse": int, // last pulse of the snapshot
+// 			"Pulses": int, // number of pulses in the snapshot
+// 			"Records": int, // number of records in the snapshot
+// 			"Blobs": int, // number of blobs in the snapshot
+// 			"Drops": int, // number of drops in the snapshot
+// 			"Indexes": int // number of indexes in the snapshot
+// 		},
+// 		"id": str|int|null // same as in request
+// 	}
+//
+func (s *BackupService) Create(r *http.Request, args *BackupArgs, reply *BackupReply) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+	inslog.Infof("[ BackupService.Create ] Incoming request: %s", r.RequestURI)
+
+	if s.runner.NodeNetwork.GetOrigin().Role() != insolar.StaticRoleHeavyMaterial {
+		return errors.New("[ BackupService.Create ] snapshots are available on heavy material nodes only")
+	}
+
+	result, err := s.runner.LedgerSnapshotter.Snapshot(ctx, insolar.PulseNumber(args.Pulse))
+	if err != nil {
+		inslog.Error(errors.Wrap(err, "[ BackupService.Create ] Can't create snapshot"))
+		return errors.Wrap(err, "[ BackupService.Create ] Can't create snapshot")
+	}
+
+	*reply = *result
+
+	return nil
+}
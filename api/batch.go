@@ -0,0 +1,152 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// JSON-RPC 2.0 error codes, see https://www.jsonrpc.org/specification#error_object.
+const (
+	rpcParseError     = -32700
+	rpcInvalidRequest = -32600
+	rpcInvalidParams  = -32602
+)
+
+type rpcRequest struct {
+	Version string           `json:"jsonrpc"`
+	Method  string           `json:"method"`
+	Params  *json.RawMessage `json:"params,omitempty"`
+	ID      *json.RawMessage `json:"id,omitempty"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type rpcResponse struct {
+	Version string           `json:"jsonrpc"`
+	Result  interface{}      `json:"result,omitempty"`
+	Error   *rpcError        `json:"error,omitempty"`
+	ID      *json.RawMessage `json:"id"`
+}
+
+func newRPCError(id *json.RawMessage, code int, err error) []byte {
+	res, _ := json.Marshal(rpcResponse{Version: "2.0", Error: &rpcError{Code: code, Message: err.Error()}, ID: id})
+	return res
+}
+
+// isBatch checks if JSON-RPC payload is an array of requests.
+func isBatch(body []byte) bool {
+	body = bytes.TrimLeft(body, " \t\r\n")
+	return len(body) > 0 && body[0] == '['
+}
+
+// rpcHandler serves JSON-RPC requests. Batches are split and every request is passed to RPC server separately.
+func (ar *Runner) rpcHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			ar.rpcServer.ServeHTTP(w, r)
+			return
+		}
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if !isBatch(body) {
+			r.Body = ioutil.NopCloser(bytes.NewReader(body))
+			ar.rpcServer.ServeHTTP(w, r)
+			return
+		}
+
+		res := ar.serveBatch(r, body)
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		if res == nil {
+			// Batch of notifications has no response.
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		_, _ = w.Write(res)
+	}
+}
+
+// serveBatch serves JSON-RPC batch. It returns nil if there is nothing to reply.
+func (ar *Runner) serveBatch(r *http.Request, body []byte) []byte {
+	var batch []json.RawMessage
+	if err := json.Unmarshal(body, &batch); err != nil {
+		return newRPCError(nil, rpcParseError, errors.Wrap(err, "[ serveBatch ] Can't parse batch"))
+	}
+	if len(batch) == 0 {
+		return newRPCError(nil, rpcInvalidRequest, errors.New("[ serveBatch ] Empty batch"))
+	}
+
+	replies := make([]json.RawMessage, 0, len(batch))
+	for _, req := range batch {
+		res := ar.serveSingle(r, req)
+		if len(res) > 0 {
+			replies = append(replies, res)
+		}
+	}
+	if len(replies) == 0 {
+		return nil
+	}
+
+	res, err := json.Marshal(replies)
+	if err != nil {
+		return newRPCError(nil, rpcParseError, errors.Wrap(err, "[ serveBatch ] Can't marshal replies"))
+	}
+	return res
+}
+
+// serveSingle passes one JSON-RPC request to RPC server. Notifications have empty reply.
+func (ar *Runner) serveSingle(r *http.Request, body []byte) []byte {
+	req, err := http.NewRequest(http.MethodPost, ar.cfg.RPC, bytes.NewReader(body))
+	if err != nil {
+		return newRPCError(nil, rpcInvalidRequest, errors.Wrap(err, "[ serveSingle ] Can't create request"))
+	}
+	req = req.WithContext(r.Context())
+	req.RequestURI = r.RequestURI
+	req.RemoteAddr = r.RemoteAddr
+	req.Header.Set("Content-Type", "application/json")
+
+	w := &responseBuffer{header: http.Header{}}
+	ar.rpcServer.ServeHTTP(w, req)
+	return bytes.TrimSpace(w.body.Bytes())
+}
+
+// responseBuffer collects RPC server response in memory.
+type responseBuffer struct {
+	header http.Header
+	body   bytes.Buffer
+}
+
+func (b *responseBuffer) Header() http.Header {
+	return b.header
+}
+
+func (b *responseBuffer) Write(p []byte) (int, error) {
+	return b.body.Write(p)
+}
+
+func (b *responseBuffer) WriteHeader(int) {}
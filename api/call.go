@@ -118,6 +118,15 @@ func processError(err error, extraMsg string, resp *answer, insLog insolar.Logge
 	insLog.Error(errors.Wrapf(err, "[ CallHandler ] %s", extraMsg))
 }
 
+// publishCall notifies subscribers about finished call. Calls that exceeded timeout are published as well.
+func (ar *Runner) publishCall(traceID string, result interface{}, err error) {
+	event := CallEvent{TraceID: traceID, Result: result}
+	if err != nil {
+		event.Error = err.Error()
+	}
+	ar.subscriptions.publish(TopicCall, traceID, event)
+}
+
 func (ar *Runner) callHandler() func(http.ResponseWriter, *http.Request) {
 	return func(response http.ResponseWriter, req *http.Request) {
 		traceID := utils.RandTraceID()
@@ -179,6 +188,7 @@ func (ar *Runner) callHandler() func(http.ResponseWriter, *http.Request) {
 		ch := make(chan interface{}, 1)
 		go func() {
 			result, err = ar.makeCall(ctx, params)
+			ar.publishCall(traceID, result, err)
 			ch <- nil
 		}()
 		select {
@@ -0,0 +1,126 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/network/telemetry"
+)
+
+// ConsensusReportsArgs is arguments that Consensus service Reports method accepts.
+type ConsensusReportsArgs struct {
+	Limit int
+	Pulse insolar.PulseNumber
+}
+
+// ConsensusReportsReply is reply for Consensus service Reports method.
+type ConsensusReportsReply struct {
+	Reports []telemetry.Report
+}
+
+// ConsensusService is a service that provides API for inspecting recent consensus rounds.
+type ConsensusService struct {
+	runner *Runner
+}
+
+// NewConsensusService creates new Consensus service instance.
+func NewConsensusService(runner *Runner) *ConsensusService {
+	return &ConsensusService{runner: runner}
+}
+
+// Reports returns reports of recent consensus rounds on the node from the oldest to the newest.
+//
+//	  Request structure:
+//	  {
+//	    "jsonrpc": "2.0",
+//	    "method": "consensus.Reports",
+//	    "params": {
+//	      "Limit": int, // max number of latest reports, 0 means all kept reports
+//	      "Pulse": int // pulse number of the round, 0 means any round
+//	    },
+//	    "id": str|int|null
+//	  }
+//
+//	    Response structure:
+//		{
+//			"jsonrpc": "2.0",
+//			"result": {
+//				"Reports": [
+//					{
+//						"Pulse": int, // pulse number of the round
+//						"Node": str, // reference of the node
+//						"Start": str, // start time of the round
+//						"Finish": str, // finish time of the round
+//						"Phases": [
+//							{
+//								"Phase": str, // 1, 2, 2.1 or 3
+//								"Start": str,
+//								"Duration": int, // nanoseconds
+//								"Timeout": int, // nanoseconds
+//								"Error": str // omitted if phase succeeded
+//							}, ...
+//						],
+//						"Participants": [
+//							{
+//								"Node": str, // reference of the participant
+//								"Received": [str, ...], // phases in which packet was received
+//								"Missing": [str, ...] // phases in which packet was not received
+//							}, ...
+//						],
+//						"BitSets": { // bitset state of each node by phase
+//							str: {str: str, ...}, ...
+//						},
+//						"Decision": {
+//							"Success": bool,
+//							"Error": str, // omitted if round succeeded
+//							"ActiveNodes": int,
+//							"ApprovedClaims": int
+//						}
+//					}, ...
+//				]
+//			},
+//			"id": str|int|null // same as in request
+//		}
+func (s *ConsensusService) Reports(r *http.Request, args *ConsensusReportsArgs, reply *ConsensusReportsReply) error {
+	_, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+	inslog.Infof("[ ConsensusService.Reports ] Incoming request: %s", r.RequestURI)
+
+	if args.Limit < 0 {
+		return errors.New("[ ConsensusService.Reports ] Limit should not be negative")
+	}
+
+	if args.Pulse != 0 {
+		report, ok := s.runner.ConsensusTelemetry.Report(args.Pulse)
+		if !ok {
+			return errors.Errorf("[ ConsensusService.Reports ] No report for pulse %d", args.Pulse)
+		}
+		reply.Reports = []telemetry.Report{*report}
+		return nil
+	}
+
+	reply.Reports = s.runner.ConsensusTelemetry.Reports(args.Limit)
+
+	return nil
+}
@@ -35,4 +35,12 @@ Package api provides http/json APIto interact with our system
 
 	// Gracefully stop api server
 	_ = api.Stop()
+
+RPC endpoint accepts JSON-RPC 2.0 batches. Websocket endpoint (APIRunner.WS) serves the same services and allows
+to subscribe for new pulses, node list changes and finished contract calls instead of polling:
+
+	--> {"jsonrpc": "2.0", "method": "subscribe", "params": {"Topic": "pulse"}, "id": 1}
+	<-- {"jsonrpc": "2.0", "result": {"Subscription": "1"}, "id": 1}
+	<-- {"jsonrpc": "2.0", "method": "subscription", "params": {"Subscription": "1", "Topic": "pulse", "Result": {...}}}
+	--> {"jsonrpc": "2.0", "method": "unsubscribe", "params": {"Subscription": "1"}, "id": 2}
 */
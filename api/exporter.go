@@ -0,0 +1,94 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+// StorageExporterArgs is arguments that StorageExporter service accepts.
+type StorageExporterArgs struct {
+	From uint32
+	Size int
+}
+
+// StorageExporterReply is reply for StorageExporter service requests.
+type StorageExporterReply = insolar.StorageExportResult
+
+// StorageExporterService is a service that provides API for exporting storage data.
+type StorageExporterService struct {
+	runner *Runner
+}
+
+// NewStorageExporterService creates new StorageExporter service instance.
+func NewStorageExporterService(runner *Runner) *StorageExporterService {
+	return &StorageExporterService{runner: runner}
+}
+
+// Export returns data of finalized pulses starting from provided pulse number.
+//
+//   Request structure:
+//   {
+//     "jsonrpc": "2.0",
+//     "method": "exporter.Export",
+//     "params": {
+//       "From": int, // pulse number to start export from
+//       "Size": int // max number of pulses in reply
+//     },
+//     "id": str|int|null
+//   }
+//
+//     Response structure:
+// 	{
+// 		"jsonrpc": "2.0",
+// 		"result": {
+// 			"Data": { // pulses data keyed by pulse number
+// 				str: {
+// 					"Pulse": object, // pulse info
+// 					"Records": [object], // records with ID, JetID, Type, Data and optional Payload
+// 					"Drops": [object], // jet drops of the pulse
+// 					"Indexes": [object] // indexes of objects touched by the records
+// 				}
+// 			},
+// 			"NextFrom": int, // pulse number to start next export from
+// 			"Size": int // number of pulses in reply
+// 		},
+// 		"id": str|int|null // same as in request
+// 	}
+//
+func (s *StorageExporterService) Export(r *http.Request, args *StorageExporterArgs, reply *StorageExporterReply) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+	inslog.Infof("[ StorageExporterService.Export ] Incoming request: %s", r.RequestURI)
+
+	result, err := s.runner.StorageExporter.Export(ctx, insolar.PulseNumber(args.From), args.Size)
+	if err != nil {
+		inslog.Error(errors.Wrap(err, "[ StorageExporterService.Export ] Can't export"))
+		return errors.Wrap(err, "[ StorageExporterService.Export ] Can't export")
+	}
+
+	*reply = *result
+
+	return nil
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/insolar/insolar/application/extractor"
 	"github.com/insolar/insolar/ledger/storage/pulse"
 	"github.com/pkg/errors"
+	"golang.org/x/net/websocket"
 
 	"github.com/insolar/insolar/api/seedmanager"
 
@@ -37,7 +38,10 @@ import (
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/reply"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/backup"
+	"github.com/insolar/insolar/ledger/exporter"
 	"github.com/insolar/insolar/logicrunner/artifacts"
+	"github.com/insolar/insolar/network/telemetry"
 	"github.com/insolar/insolar/platformpolicy"
 )
 
@@ -51,6 +55,10 @@ type Runner struct {
 	NodeNetwork         insolar.NodeNetwork         `inject:""`
 	PulseAccessor       pulse.Accessor              `inject:""`
 	ArtifactManager     artifacts.Client            `inject:""`
+	StorageExporter     insolar.StorageExporter     `inject:""`
+	LedgerSnapshotter   backup.Snapshotter          `inject:""`
+	ObjectHistory       exporter.HistoryReader      `inject:""`
+	ConsensusTelemetry  telemetry.Recorder          `inject:""`
 	server              *http.Server
 	rpcServer           *rpc.Server
 	cfg                 *configuration.APIRunner
@@ -58,6 +66,9 @@ type Runner struct {
 	cacheLock           *sync.RWMutex
 	SeedManager         *seedmanager.SeedManager
 	SeedGenerator       seedmanager.SeedGenerator
+	subscriptions       *subscriptions
+	stop                chan struct{}
+	stopOnce            sync.Once
 }
 
 func checkConfig(cfg *configuration.APIRunner) error {
@@ -106,6 +117,26 @@ func (ar *Runner) registerServices(rpcServer *rpc.Server) error {
 		return errors.Wrap(err, "[ registerServices ] Can't RegisterService: contract")
 	}
 
+	err = rpcServer.RegisterService(NewStorageExporterService(ar), "exporter")
+	if err != nil {
+		return errors.Wrap(err, "[ registerServices ] Can't RegisterService: exporter")
+	}
+
+	err = rpcServer.RegisterService(NewBackupService(ar), "backup")
+	if err != nil {
+		return errors.Wrap(err, "[ registerServices ] Can't RegisterService: backup")
+	}
+
+	err = rpcServer.RegisterService(NewObjectService(ar), "object")
+	if err != nil {
+		return errors.Wrap(err, "[ registerServices ] Can't RegisterService: object")
+	}
+
+	err = rpcServer.RegisterService(NewConsensusService(ar), "consensus")
+	if err != nil {
+		return errors.Wrap(err, "[ registerServices ] Can't RegisterService: consensus")
+	}
+
 	return nil
 }
 
@@ -124,6 +155,9 @@ func NewRunner(cfg *configuration.APIRunner) (*Runner, error) {
 		cfg:       cfg,
 		keyCache:  make(map[string]crypto.PublicKey),
 		cacheLock: &sync.RWMutex{},
+
+		subscriptions: newSubscriptions(),
+		stop:          make(chan struct{}),
 	}
 
 	rpcServer.RegisterCodec(jsonrpc.NewCodec(), "application/json")
@@ -144,7 +178,11 @@ func (ar *Runner) IsAPIRunner() bool {
 func (ar *Runner) Start(ctx context.Context) error {
 	ar.SeedManager = seedmanager.New()
 	http.HandleFunc(ar.cfg.Call, ar.callHandler())
-	http.Handle(ar.cfg.RPC, ar.rpcServer)
+	http.Handle(ar.cfg.RPC, ar.rpcHandler())
+	if ar.cfg.WS != "" {
+		http.Handle(ar.cfg.WS, websocket.Server{Handler: ar.serveWS})
+		go ar.watch(ctx, ar.stop)
+	}
 	inslog := inslogger.FromContext(ctx)
 	inslog.Info("Starting ApiRunner ...")
 	inslog.Info("Config: ", ar.cfg)
@@ -164,6 +202,8 @@ func (ar *Runner) Start(ctx context.Context) error {
 func (ar *Runner) Stop(ctx context.Context) error {
 	const timeOut = 5
 
+	ar.stopOnce.Do(func() { close(ar.stop) })
+
 	inslogger.FromContext(ctx).Infof("Shutting down server gracefully ...(waiting for %d seconds)", timeOut)
 	ctxWithTimeout, cancel := context.WithTimeout(ctx, time.Duration(timeOut)*time.Second)
 	defer cancel()
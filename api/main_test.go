@@ -26,6 +26,7 @@ import (
 	"github.com/insolar/insolar/certificate"
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/insolar/insolar/configuration"
@@ -109,4 +110,5 @@ func TestMainTestSuite(t *testing.T) {
 	suite.Run(t, new(MainAPISuite))
 
 	api.Stop(ctx)
+	require.NotPanics(t, func() { api.Stop(ctx) }, "Stop should be idempotent")
 }
@@ -0,0 +1,182 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/exporter"
+)
+
+// ObjectHistoryArgs is arguments that Object service History method accepts.
+type ObjectHistoryArgs struct {
+	Object string
+}
+
+// ObjectHistoryReply is reply for Object service History method.
+type ObjectHistoryReply = exporter.ObjectHistory
+
+// ObjectMemoryArgs is arguments that Object service Memory method accepts.
+type ObjectMemoryArgs struct {
+	Object string
+	State  string
+}
+
+// ObjectMemoryReply is reply for Object service Memory method.
+type ObjectMemoryReply = exporter.ObjectMemory
+
+// ObjectService is a service that provides API for reading past states of objects.
+type ObjectService struct {
+	runner *Runner
+}
+
+// NewObjectService creates new Object service instance.
+func NewObjectService(runner *Runner) *ObjectService {
+	return &ObjectService{runner: runner}
+}
+
+// History returns all states of an object from the latest one to the activation with requests and results
+// that produced them.
+//
+//	  Request structure:
+//	  {
+//	    "jsonrpc": "2.0",
+//	    "method": "object.History",
+//	    "params": {
+//	      "Object": str // object reference
+//	    },
+//	    "id": str|int|null
+//	  }
+//
+//	    Response structure:
+//		{
+//			"jsonrpc": "2.0",
+//			"result": {
+//				"Object": str, // object reference
+//				"States": [ // from the latest state to the activation
+//					{
+//						"ID": str, // state record id
+//						"Pulse": int, // pulse number of the state
+//						"State": str, // Activation, Amend or Deactivation
+//						"Memory": str|null, // memory blob id
+//						"Image": str, // prototype reference
+//						"Request": { // request that produced the state
+//							"ID": str,
+//							"MessageType": str,
+//							"Caller": str,
+//							"Method": str,
+//							"Arguments": any // decoded arguments
+//						}|null,
+//						"Result": { // result of the request
+//							"ID": str,
+//							"Payload": any // decoded result
+//						}|null
+//					}, ...
+//				]
+//			},
+//			"id": str|int|null // same as in request
+//		}
+func (s *ObjectService) History(r *http.Request, args *ObjectHistoryArgs, reply *ObjectHistoryReply) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+	inslog.Infof("[ ObjectService.History ] Incoming request: %s", r.RequestURI)
+
+	head, err := s.checkRequest(args.Object)
+	if err != nil {
+		return errors.Wrap(err, "[ ObjectService.History ]")
+	}
+
+	result, err := s.runner.ObjectHistory.History(ctx, *head)
+	if err != nil {
+		inslog.Error(errors.Wrap(err, "[ ObjectService.History ] Can't get object history"))
+		return errors.Wrap(err, "[ ObjectService.History ] Can't get object history")
+	}
+
+	*reply = *result
+
+	return nil
+}
+
+// Memory returns decoded memory of an object at provided state.
+//
+//	  Request structure:
+//	  {
+//	    "jsonrpc": "2.0",
+//	    "method": "object.Memory",
+//	    "params": {
+//	      "Object": str, // object reference
+//	      "State": str // state record id, empty means the latest state
+//	    },
+//	    "id": str|int|null
+//	  }
+//
+//	    Response structure:
+//		{
+//			"jsonrpc": "2.0",
+//			"result": {
+//				"Object": str, // object reference
+//				"State": str, // state record id
+//				"Pulse": int, // pulse number of the state
+//				"Memory": any // decoded memory, null for deactivated objects
+//			},
+//			"id": str|int|null // same as in request
+//		}
+func (s *ObjectService) Memory(r *http.Request, args *ObjectMemoryArgs, reply *ObjectMemoryReply) error {
+	ctx, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+
+	inslog.Infof("[ ObjectService.Memory ] Incoming request: %s", r.RequestURI)
+
+	head, err := s.checkRequest(args.Object)
+	if err != nil {
+		return errors.Wrap(err, "[ ObjectService.Memory ]")
+	}
+
+	var state *insolar.ID
+	if args.State != "" {
+		state, err = insolar.NewIDFromBase58(args.State)
+		if err != nil {
+			return errors.Wrap(err, "[ ObjectService.Memory ] Can't parse state")
+		}
+	}
+
+	result, err := s.runner.ObjectHistory.Memory(ctx, *head, state)
+	if err != nil {
+		inslog.Error(errors.Wrap(err, "[ ObjectService.Memory ] Can't get object memory"))
+		return errors.Wrap(err, "[ ObjectService.Memory ] Can't get object memory")
+	}
+
+	*reply = *result
+
+	return nil
+}
+
+func (s *ObjectService) checkRequest(object string) (*insolar.Reference, error) {
+	if s.runner.NodeNetwork.GetOrigin().Role() != insolar.StaticRoleHeavyMaterial {
+		return nil, errors.New("object history is available on heavy material nodes only")
+	}
+	head, err := insolar.NewReferenceFromBase58(object)
+	if err != nil {
+		return nil, errors.Wrap(err, "can't parse object reference")
+	}
+	return head, nil
+}
@@ -272,3 +272,61 @@ func Status(url string) (*StatusResponse, error) {
 
 	return res, nil
 }
+
+// ObjectHistory makes rpc request to object.History method and returns raw result
+func ObjectHistory(url string, object string) (json.RawMessage, error) {
+	params := getDefaultRPCParams("object.History")
+	params["params"] = map[string]string{"Object": object}
+
+	res, err := getRawResult(url, params)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ ObjectHistory ]")
+	}
+	return res, nil
+}
+
+// ObjectMemory makes rpc request to object.Memory method and returns raw result
+func ObjectMemory(url string, object string, state string) (json.RawMessage, error) {
+	params := getDefaultRPCParams("object.Memory")
+	params["params"] = map[string]string{"Object": object, "State": state}
+
+	res, err := getRawResult(url, params)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ ObjectMemory ]")
+	}
+	return res, nil
+}
+
+// ConsensusReports makes rpc request to consensus.Reports method and returns raw result
+func ConsensusReports(url string, limit int, pulse uint32) (json.RawMessage, error) {
+	params := getDefaultRPCParams("consensus.Reports")
+	params["params"] = map[string]interface{}{"Limit": limit, "Pulse": pulse}
+
+	res, err := getRawResult(url, params)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ ConsensusReports ]")
+	}
+	return res, nil
+}
+
+func getRawResult(url string, params PostParams) (json.RawMessage, error) {
+	body, err := GetResponseBody(url+"/rpc", params)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := rpcRawResponse{}
+
+	err = json.Unmarshal(body, &resp)
+	if err != nil {
+		return nil, errors.Wrap(err, "Can't unmarshal")
+	}
+	if resp.Error != nil {
+		return nil, errors.New("Field 'error' is not nil: " + fmt.Sprint(resp.Error))
+	}
+	if resp.Result == nil {
+		return nil, errors.New("Field 'result' is nil")
+	}
+
+	return resp.Result, nil
+}
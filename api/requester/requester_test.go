@@ -40,6 +40,7 @@ const TESTSEED = "VGVzdA=="
 var testSeedResponse = seedResponse{Seed: []byte("Test"), TraceID: "testTraceID"}
 var testInfoResponse = InfoResponse{RootMember: "root_member_ref", RootDomain: "root_domain_ref", NodeDomain: "node_domain_ref"}
 var testStatusResponse = StatusResponse{NetworkState: "OK"}
+var testObjectHistoryResponse = map[string]interface{}{"Object": TESTREFERENCE, "States": []interface{}{}}
 
 type rpcRequest struct {
 	RPCVersion string `json:"jsonrpc"`
@@ -98,6 +99,8 @@ func FakeRPCHandler(response http.ResponseWriter, req *http.Request) {
 		answer["result"] = testInfoResponse
 	case "seed.Get":
 		answer["result"] = testSeedResponse
+	case "object.History":
+		answer["result"] = testObjectHistoryResponse
 	}
 	writeReponse(response, answer)
 }
@@ -266,3 +269,17 @@ func TestStatus(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, resp, &testStatusResponse)
 }
+
+func TestObjectHistory(t *testing.T) {
+	resp, err := ObjectHistory(URL, TESTREFERENCE)
+	require.NoError(t, err)
+	res := map[string]interface{}{}
+	err = json.Unmarshal(resp, &res)
+	require.NoError(t, err)
+	require.Equal(t, testObjectHistoryResponse, res)
+}
+
+func TestObjectMemory_NoResult(t *testing.T) {
+	_, err := ObjectMemory(URL, TESTREFERENCE, "")
+	require.Error(t, err)
+}
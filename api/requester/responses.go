@@ -16,6 +16,10 @@
 
 package requester
 
+import (
+	"encoding/json"
+)
+
 type rpcResponse struct {
 	RPCVersion string                 `json:"jsonrpc"`
 	Error      map[string]interface{} `json:"error"`
@@ -52,3 +56,8 @@ type rpcInfoResponse struct {
 	rpcResponse
 	Result InfoResponse `json:"result"`
 }
+
+type rpcRawResponse struct {
+	rpcResponse
+	Result json.RawMessage `json:"result"`
+}
@@ -66,22 +66,11 @@ func (s *StatusService) Get(r *http.Request, args *interface{}, reply *StatusRep
 	reply.NetworkState = s.runner.NetworkSwitcher.GetState().String()
 	reply.NodeState = s.runner.NodeNetwork.GetOrigin().GetState().String()
 
-	activeNodes := s.runner.NodeNetwork.(network.NodeKeeper).GetAccessor().GetActiveNodes()
-	workingNodes := s.runner.NodeNetwork.GetWorkingNodes()
+	nodes := s.runner.getNodes()
+	reply.ActiveListSize = nodes.ActiveListSize
+	reply.WorkingListSize = nodes.WorkingListSize
+	reply.Nodes = nodes.Nodes
 
-	reply.ActiveListSize = len(activeNodes)
-	reply.WorkingListSize = len(workingNodes)
-
-	nodes := make([]Node, reply.ActiveListSize)
-	for i, node := range activeNodes {
-		nodes[i] = Node{
-			Reference: node.ID().String(),
-			Role:      node.Role().String(),
-			IsWorking: node.GetState() == insolar.NodeReady,
-		}
-	}
-
-	reply.Nodes = nodes
 	origin := s.runner.NodeNetwork.GetOrigin()
 	reply.Origin = Node{
 		Reference: origin.ID().String(),
@@ -100,3 +89,24 @@ func (s *StatusService) Get(r *http.Request, args *interface{}, reply *StatusRep
 
 	return nil
 }
+
+// getNodes returns active node list.
+func (ar *Runner) getNodes() NodesEvent {
+	activeNodes := ar.NodeNetwork.(network.NodeKeeper).GetAccessor().GetActiveNodes()
+	workingNodes := ar.NodeNetwork.GetWorkingNodes()
+
+	nodes := make([]Node, len(activeNodes))
+	for i, node := range activeNodes {
+		nodes[i] = Node{
+			Reference: node.ID().String(),
+			Role:      node.Role().String(),
+			IsWorking: node.GetState() == insolar.NodeReady,
+		}
+	}
+
+	return NodesEvent{
+		ActiveListSize:  len(activeNodes),
+		WorkingListSize: len(workingNodes),
+		Nodes:           nodes,
+	}
+}
@@ -0,0 +1,149 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"strconv"
+	"sync"
+)
+
+// Subscription topics.
+const (
+	// TopicPulse notifies about every new pulse with PulseEvent.
+	TopicPulse = "pulse"
+	// TopicNodes notifies about changes of active node list with NodesEvent.
+	TopicNodes = "nodes"
+	// TopicCall notifies about completion of contract calls with CallEvent.
+	TopicCall = "call"
+)
+
+// SubscribeArgs is arguments that subscribe method accepts.
+type SubscribeArgs struct {
+	Topic string
+	// TraceID selects call events. It is required for TopicCall, so subscribers receive only results of their own
+	// calls.
+	TraceID string
+}
+
+// SubscribeReply is reply for subscribe method.
+type SubscribeReply struct {
+	Subscription string
+}
+
+// UnsubscribeArgs is arguments that unsubscribe method accepts.
+type UnsubscribeArgs struct {
+	Subscription string
+}
+
+// PulseEvent is sent to TopicPulse subscribers.
+type PulseEvent struct {
+	PulseNumber uint32
+	Entropy     []byte
+}
+
+// NodesEvent is sent to TopicNodes subscribers. Nodes are the same as in status.Get reply.
+type NodesEvent struct {
+	ActiveListSize  int
+	WorkingListSize int
+	Nodes           []Node
+}
+
+// CallEvent is sent to TopicCall subscribers when contract call is finished.
+type CallEvent struct {
+	TraceID string
+	Result  interface{} `json:",omitempty"`
+	Error   string      `json:",omitempty"`
+}
+
+// Notification is pushed to subscriber.
+type Notification struct {
+	Subscription string
+	Topic        string
+	Result       interface{}
+}
+
+type subscription struct {
+	topic   string
+	traceID string
+	notify  func(Notification)
+}
+
+// subscriptions dispatches events to subscribers.
+type subscriptions struct {
+	lock   sync.RWMutex
+	lastID uint64
+	subs   map[string]*subscription
+}
+
+func newSubscriptions() *subscriptions {
+	return &subscriptions{subs: map[string]*subscription{}}
+}
+
+func isKnownTopic(topic string) bool {
+	switch topic {
+	case TopicPulse, TopicNodes, TopicCall:
+		return true
+	}
+	return false
+}
+
+// add registers subscriber and returns subscription id.
+func (s *subscriptions) add(topic, traceID string, notify func(Notification)) string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.lastID++
+	id := strconv.FormatUint(s.lastID, 10)
+	s.subs[id] = &subscription{topic: topic, traceID: traceID, notify: notify}
+	return id
+}
+
+// remove unregisters subscriber. It returns false if there is no such subscription.
+func (s *subscriptions) remove(id string) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	_, ok := s.subs[id]
+	delete(s.subs, id)
+	return ok
+}
+
+// has checks if topic has subscribers.
+func (s *subscriptions) has(topic string) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	for _, sub := range s.subs {
+		if sub.topic == topic {
+			return true
+		}
+	}
+	return false
+}
+
+// publish sends event to all subscribers of the topic. Subscribers with trace id receive only matching events.
+func (s *subscriptions) publish(topic, traceID string, event interface{}) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	for id, sub := range s.subs {
+		if sub.topic != topic || sub.traceID != traceID {
+			continue
+		}
+		sub.notify(Notification{Subscription: id, Topic: topic, Result: event})
+	}
+}
@@ -0,0 +1,273 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/websocket"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/utils"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+const (
+	// wsQueueSize is the number of outgoing messages buffered for a websocket connection. Notifications for slow
+	// clients are dropped when the queue is full.
+	wsQueueSize = 256
+	// watchInterval is how often pulse and node list are checked for changes.
+	watchInterval = 200 * time.Millisecond
+)
+
+type rpcNotification struct {
+	Version string       `json:"jsonrpc"`
+	Method  string       `json:"method"`
+	Params  Notification `json:"params"`
+}
+
+// wsSession holds state of a single websocket connection.
+type wsSession struct {
+	runner  *Runner
+	request *http.Request
+	out     chan []byte
+	closed  chan struct{}
+	subs    map[string]struct{}
+	logger  insolar.Logger
+}
+
+// serveWS serves JSON-RPC over websocket. Besides registered RPC services, subscribe and unsubscribe methods are
+// available. Notifications are pushed as JSON-RPC requests with "subscription" method and without id.
+func (ar *Runner) serveWS(conn *websocket.Conn) {
+	_, inslog := inslogger.WithTraceField(context.Background(), utils.RandTraceID())
+	inslog.Infof("[ serveWS ] New connection from %s", conn.Request().RemoteAddr)
+
+	s := &wsSession{
+		runner:  ar,
+		request: conn.Request(),
+		out:     make(chan []byte, wsQueueSize),
+		closed:  make(chan struct{}),
+		subs:    map[string]struct{}{},
+		logger:  inslog,
+	}
+	go s.write(conn)
+	defer func() {
+		// Notifications are not sent after subscriptions are removed, so the queue can be closed.
+		for id := range s.subs {
+			ar.subscriptions.remove(id)
+		}
+		close(s.out)
+	}()
+
+	for {
+		var data []byte
+		if err := websocket.Message.Receive(conn, &data); err != nil {
+			if err != io.EOF {
+				inslog.Warn(errors.Wrap(err, "[ serveWS ] Can't receive message"))
+			}
+			_ = conn.Close()
+			return
+		}
+
+		var res []byte
+		if isBatch(data) {
+			res = s.serveBatch(data)
+		} else {
+			res = s.serve(data)
+		}
+		if len(res) == 0 {
+			continue
+		}
+		select {
+		case s.out <- res:
+		case <-s.closed:
+			return
+		}
+	}
+}
+
+// write sends queued messages until queue is closed or connection fails.
+func (s *wsSession) write(conn *websocket.Conn) {
+	defer close(s.closed)
+	for msg := range s.out {
+		if err := websocket.Message.Send(conn, string(msg)); err != nil {
+			s.logger.Warn(errors.Wrap(err, "[ serveWS ] Can't send message"))
+			_ = conn.Close()
+			return
+		}
+	}
+}
+
+func (s *wsSession) serveBatch(data []byte) []byte {
+	var batch []json.RawMessage
+	if err := json.Unmarshal(data, &batch); err != nil {
+		return newRPCError(nil, rpcParseError, errors.Wrap(err, "[ serveWS ] Can't parse batch"))
+	}
+	if len(batch) == 0 {
+		return newRPCError(nil, rpcInvalidRequest, errors.New("[ serveWS ] Empty batch"))
+	}
+
+	replies := make([]json.RawMessage, 0, len(batch))
+	for _, req := range batch {
+		res := s.serve(req)
+		if len(res) > 0 {
+			replies = append(replies, res)
+		}
+	}
+	if len(replies) == 0 {
+		return nil
+	}
+	res, err := json.Marshal(replies)
+	if err != nil {
+		return newRPCError(nil, rpcParseError, errors.Wrap(err, "[ serveWS ] Can't marshal replies"))
+	}
+	return res
+}
+
+// serve handles single JSON-RPC request. Subscription methods are handled by session, others are passed to RPC
+// server.
+func (s *wsSession) serve(data []byte) []byte {
+	var req rpcRequest
+	if err := json.Unmarshal(data, &req); err != nil {
+		return newRPCError(nil, rpcParseError, errors.Wrap(err, "[ serveWS ] Can't parse request"))
+	}
+
+	var (
+		result interface{}
+		err    error
+	)
+	switch req.Method {
+	case "subscribe":
+		result, err = s.subscribe(req.Params)
+	case "unsubscribe":
+		result, err = s.unsubscribe(req.Params)
+	default:
+		return s.runner.serveSingle(s.request, data)
+	}
+
+	if req.ID == nil {
+		return nil
+	}
+	if err != nil {
+		return newRPCError(req.ID, rpcInvalidParams, err)
+	}
+	res, err := json.Marshal(rpcResponse{Version: "2.0", Result: result, ID: req.ID})
+	if err != nil {
+		return newRPCError(req.ID, rpcParseError, errors.Wrap(err, "[ serveWS ] Can't marshal reply"))
+	}
+	return res
+}
+
+func (s *wsSession) subscribe(params *json.RawMessage) (*SubscribeReply, error) {
+	var args SubscribeArgs
+	if params == nil {
+		return nil, errors.New("[ subscribe ] Params must exist")
+	}
+	if err := json.Unmarshal(*params, &args); err != nil {
+		return nil, errors.Wrap(err, "[ subscribe ] Can't parse params")
+	}
+	if !isKnownTopic(args.Topic) {
+		return nil, errors.Errorf("[ subscribe ] Unknown topic %q", args.Topic)
+	}
+	traceID := ""
+	if args.Topic == TopicCall {
+		if args.TraceID == "" {
+			return nil, errors.New("[ subscribe ] TraceID is required for call topic")
+		}
+		traceID = args.TraceID
+	}
+
+	id := s.runner.subscriptions.add(args.Topic, traceID, s.notify)
+	s.subs[id] = struct{}{}
+	return &SubscribeReply{Subscription: id}, nil
+}
+
+func (s *wsSession) unsubscribe(params *json.RawMessage) (bool, error) {
+	var args UnsubscribeArgs
+	if params == nil {
+		return false, errors.New("[ unsubscribe ] Params must exist")
+	}
+	if err := json.Unmarshal(*params, &args); err != nil {
+		return false, errors.Wrap(err, "[ unsubscribe ] Can't parse params")
+	}
+	if _, ok := s.subs[args.Subscription]; !ok {
+		return false, errors.Errorf("[ unsubscribe ] Unknown subscription %q", args.Subscription)
+	}
+
+	delete(s.subs, args.Subscription)
+	return s.runner.subscriptions.remove(args.Subscription), nil
+}
+
+// notify queues notification. It never blocks, so slow clients don't affect publishers.
+func (s *wsSession) notify(n Notification) {
+	msg, err := json.Marshal(rpcNotification{Version: "2.0", Method: "subscription", Params: n})
+	if err != nil {
+		s.logger.Error(errors.Wrap(err, "[ notify ] Can't marshal notification"))
+		return
+	}
+	select {
+	case s.out <- msg:
+	default:
+		s.logger.Warnf("[ notify ] Queue is full, notification for subscription %s dropped", n.Subscription)
+	}
+}
+
+// watch checks pulse and node list and notifies subscribers about changes until stop is closed. Components are
+// queried only while there are subscribers.
+func (ar *Runner) watch(ctx context.Context, stop <-chan struct{}) {
+	ticker := time.NewTicker(watchInterval)
+	defer ticker.Stop()
+
+	var (
+		lastPulse insolar.PulseNumber
+		lastNodes []Node
+	)
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+
+		if ar.subscriptions.has(TopicPulse) {
+			pulse, err := ar.PulseAccessor.Latest(ctx)
+			if err != nil {
+				inslogger.FromContext(ctx).Debug(errors.Wrap(err, "[ watch ] Can't get pulse"))
+			} else if pulse.PulseNumber != lastPulse {
+				lastPulse = pulse.PulseNumber
+				ar.subscriptions.publish(TopicPulse, "", PulseEvent{
+					PulseNumber: uint32(pulse.PulseNumber),
+					Entropy:     pulse.Entropy[:],
+				})
+			}
+		}
+
+		if ar.subscriptions.has(TopicNodes) {
+			nodes := ar.getNodes()
+			if !reflect.DeepEqual(nodes.Nodes, lastNodes) {
+				lastNodes = nodes.Nodes
+				ar.subscriptions.publish(TopicNodes, "", nodes)
+			}
+		}
+	}
+}
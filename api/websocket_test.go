@@ -0,0 +1,182 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
+
+	"github.com/insolar/insolar/api/seedmanager"
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/ledger/storage/pulse"
+)
+
+func newTestWSServer(t *testing.T) (*Runner, *httptest.Server) {
+	cfg := configuration.NewAPIRunner()
+	ar, err := NewRunner(&cfg)
+	require.NoError(t, err)
+	ar.SeedManager = seedmanager.New()
+
+	mux := http.NewServeMux()
+	mux.Handle(cfg.RPC, ar.rpcHandler())
+	mux.Handle(cfg.WS, websocket.Server{Handler: ar.serveWS})
+	server := httptest.NewServer(mux)
+	return ar, server
+}
+
+func dialWS(t *testing.T, server *httptest.Server) *websocket.Conn {
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/api/ws"
+	conn, err := websocket.Dial(url, "", server.URL)
+	require.NoError(t, err)
+	return conn
+}
+
+func TestRunner_rpcHandler_Batch(t *testing.T) {
+	_, server := newTestWSServer(t)
+	defer server.Close()
+
+	body := `[
+		{"jsonrpc": "2.0", "method": "seed.Get", "id": 1},
+		{"jsonrpc": "2.0", "method": "seed.Get"},
+		{"jsonrpc": "2.0", "method": "unknown.Method", "id": 2}
+	]`
+	resp, err := http.Post(server.URL+"/api/rpc", "application/json", bytes.NewBufferString(body))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	var replies []rpcResponse
+	require.NoError(t, json.Unmarshal(data, &replies))
+	require.Len(t, replies, 2, "notification must not have a reply")
+	require.Equal(t, "1", string(*replies[0].ID))
+	require.Nil(t, replies[0].Error)
+	require.NotNil(t, replies[0].Result)
+	require.Equal(t, "2", string(*replies[1].ID))
+	require.NotNil(t, replies[1].Error)
+
+	resp, err = http.Post(server.URL+"/api/rpc", "application/json", bytes.NewBufferString("[]"))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	data, err = ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	var reply rpcResponse
+	require.NoError(t, json.Unmarshal(data, &reply))
+	require.Equal(t, rpcInvalidRequest, reply.Error.Code)
+}
+
+func TestRunner_serveWS_Batch(t *testing.T) {
+	_, server := newTestWSServer(t)
+	defer server.Close()
+	conn := dialWS(t, server)
+	defer conn.Close()
+
+	err := websocket.Message.Send(conn, `[
+		{"jsonrpc": "2.0", "method": "seed.Get", "id": 1},
+		{"jsonrpc": "2.0", "method": "subscribe", "params": {"Topic": "call", "TraceID": "a"}, "id": 2},
+		{"jsonrpc": "2.0", "method": "subscribe", "params": {"Topic": "unknown"}, "id": 3}
+	]`)
+	require.NoError(t, err)
+
+	var replies []rpcResponse
+	require.NoError(t, websocket.JSON.Receive(conn, &replies))
+	require.Len(t, replies, 3)
+	require.Nil(t, replies[0].Error)
+	require.Nil(t, replies[1].Error)
+	require.Equal(t, map[string]interface{}{"Subscription": "1"}, replies[1].Result)
+	require.Equal(t, rpcInvalidParams, replies[2].Error.Code)
+}
+
+func TestRunner_serveWS_Subscriptions(t *testing.T) {
+	ar, server := newTestWSServer(t)
+	defer server.Close()
+
+	accessor := pulse.NewAccessorMock(t)
+	accessor.LatestMock.Return(insolar.Pulse{PulseNumber: insolar.FirstPulseNumber}, nil)
+	ar.PulseAccessor = accessor
+	stop := make(chan struct{})
+	defer close(stop)
+	go ar.watch(context.Background(), stop)
+
+	conn := dialWS(t, server)
+	defer conn.Close()
+
+	receive := func() map[string]interface{} {
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+		var msg map[string]interface{}
+		require.NoError(t, websocket.JSON.Receive(conn, &msg))
+		return msg
+	}
+
+	require.NoError(t, websocket.Message.Send(conn, `{"jsonrpc": "2.0", "method": "subscribe", "params": {"Topic": "call"}, "id": 0}`))
+	require.NotNil(t, receive()["error"], "call subscription without trace id should be rejected")
+
+	require.NoError(t, websocket.Message.Send(conn, `{"jsonrpc": "2.0", "method": "subscribe", "params": {"Topic": "call", "TraceID": "b"}, "id": 1}`))
+	require.Equal(t, map[string]interface{}{"Subscription": "1"}, receive()["result"])
+
+	ar.publishCall("a", nil, nil)
+	ar.publishCall("b", "done", nil)
+	msg := receive()
+	require.Equal(t, "subscription", msg["method"])
+	require.Equal(t, map[string]interface{}{
+		"Subscription": "1",
+		"Topic":        TopicCall,
+		"Result":       map[string]interface{}{"TraceID": "b", "Result": "done"},
+	}, msg["params"])
+
+	require.NoError(t, websocket.Message.Send(conn, `{"jsonrpc": "2.0", "method": "unsubscribe", "params": {"Subscription": "1"}, "id": 2}`))
+	require.Equal(t, true, receive()["result"])
+	require.False(t, ar.subscriptions.has(TopicCall))
+
+	require.NoError(t, websocket.Message.Send(conn, `{"jsonrpc": "2.0", "method": "subscribe", "params": {"Topic": "pulse"}, "id": 3}`))
+	require.Equal(t, map[string]interface{}{"Subscription": "2"}, receive()["result"])
+	msg = receive()
+	require.Equal(t, TopicPulse, msg["params"].(map[string]interface{})["Topic"])
+	require.Equal(t,
+		float64(insolar.FirstPulseNumber),
+		msg["params"].(map[string]interface{})["Result"].(map[string]interface{})["PulseNumber"],
+	)
+}
+
+func TestRunner_serveWS_RemovesSubscriptionsOnClose(t *testing.T) {
+	ar, server := newTestWSServer(t)
+	defer server.Close()
+	conn := dialWS(t, server)
+
+	require.NoError(t, websocket.Message.Send(conn, `{"jsonrpc": "2.0", "method": "subscribe", "params": {"Topic": "nodes"}, "id": 1}`))
+	var reply rpcResponse
+	require.NoError(t, websocket.JSON.Receive(conn, &reply))
+	require.True(t, ar.subscriptions.has(TopicNodes))
+
+	require.NoError(t, conn.Close())
+	deadline := time.Now().Add(5 * time.Second)
+	for ar.subscriptions.has(TopicNodes) && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.False(t, ar.subscriptions.has(TopicNodes))
+}
@@ -4,7 +4,8 @@ local insolar_params = params.insolar;
 
 {
     "versionmanager": {
-        "minalowedversion": "v0.3.0"
+        "minalowedversion": "v0.3.0",
+        "featureactivationpercent": 51
     },
     "host": {
         "transport": {
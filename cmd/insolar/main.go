@@ -17,6 +17,7 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -30,7 +31,10 @@ import (
 	"github.com/insolar/insolar/cryptography"
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/backup"
+	"github.com/insolar/insolar/ledger/verifier"
 	"github.com/insolar/insolar/log"
+	"github.com/insolar/insolar/network/telemetry"
 	"github.com/insolar/insolar/platformpolicy"
 	"github.com/insolar/insolar/testutils"
 	"github.com/insolar/insolar/version"
@@ -96,12 +100,19 @@ var (
 	sendUrls           string
 	rootAsCaller       bool
 	logLevelServer     insolar.LogLevel
+	snapshotPulse      uint32
+	snapshotPath       string
+	quarantine         bool
+	objectRef          string
+	objectState        string
+	reportsLimit       int
+	dumpPaths          []string
 )
 
 func parseInputParams() {
 	var rootCmd = &cobra.Command{}
 	rootCmd.Flags().StringVarP(&cmd, "cmd", "c", "",
-		"available commands: default_config | random_ref | version | gen_keys | gen_certificate | send_request | gen_send_configs | get_info | create_member")
+		"available commands: default_config | random_ref | version | gen_keys | gen_certificate | send_request | gen_send_configs | get_info | create_member | ledger_backup | ledger_restore | ledger_fsck | object_history | object_memory | consensus_reports | consensus_timeline")
 	rootCmd.Flags().BoolVarP(&verbose, "verbose", "v", false, "be verbose (default false)")
 	rootCmd.Flags().StringVarP(&output, "output", "o", defaultStdoutPath, "output file (use - for STDOUT)")
 	rootCmd.Flags().StringVarP(&sendUrls, "url", "u", defaultURL, "api url")
@@ -109,6 +120,13 @@ func parseInputParams() {
 	rootCmd.Flags().StringVarP(&configPath, "config", "g", "config.json", "path to configuration file")
 	rootCmd.Flags().StringVarP(&paramsPath, "params", "p", "", "path to params file (default params.json)")
 	rootCmd.Flags().BoolVarP(&rootAsCaller, "root_as_caller", "r", false, "use root member as caller")
+	rootCmd.Flags().Uint32Var(&snapshotPulse, "pulse", 0, "last pulse of ledger snapshot (default latest finalized pulse) or pulse of consensus round for consensus_reports")
+	rootCmd.Flags().StringVar(&snapshotPath, "snapshot", defaultStdoutPath, "ledger snapshot file to restore (use - for STDIN)")
+	rootCmd.Flags().BoolVar(&quarantine, "quarantine", false, "move inconsistent ledger data found by ledger_fsck to quarantine")
+	rootCmd.Flags().StringVar(&objectRef, "object", "", "object reference for object_history and object_memory")
+	rootCmd.Flags().StringVar(&objectState, "state", "", "object state id for object_memory (default latest state)")
+	rootCmd.Flags().IntVar(&reportsLimit, "limit", 0, "number of latest consensus reports for consensus_reports (default all kept reports)")
+	rootCmd.Flags().StringSliceVar(&dumpPaths, "dumps", nil, "comma separated consensus dump files of several nodes for consensus_timeline")
 
 	var logLevelServerString string
 	rootCmd.Flags().StringVarP(&logLevelServerString, "log_level_server", "L", "", "server log level")
@@ -154,6 +172,20 @@ func main() {
 		getInfo(out)
 	case "create_member":
 		createMember(out)
+	case "ledger_backup":
+		ledgerBackup(out)
+	case "ledger_restore":
+		ledgerRestore(out)
+	case "ledger_fsck":
+		ledgerFsck(out)
+	case "object_history":
+		objectHistory(out)
+	case "object_memory":
+		objectMemory(out)
+	case "consensus_reports":
+		consensusReports(out)
+	case "consensus_timeline":
+		consensusTimeline(out)
 	}
 }
 
@@ -322,3 +354,96 @@ func getInfo(out io.Writer) {
 	fmt.Fprintf(out, "NodeDomain : %s\n", info.NodeDomain)
 	fmt.Fprintf(out, "RootDomain : %s\n", info.RootDomain)
 }
+
+func objectHistory(out io.Writer) {
+	res, err := requester.ObjectHistory(sendUrls, objectRef)
+	check("[ objectHistory ]", err)
+	writeIndentedJSON(out, res)
+}
+
+func objectMemory(out io.Writer) {
+	res, err := requester.ObjectMemory(sendUrls, objectRef, objectState)
+	check("[ objectMemory ]", err)
+	writeIndentedJSON(out, res)
+}
+
+func consensusReports(out io.Writer) {
+	res, err := requester.ConsensusReports(sendUrls, reportsLimit, snapshotPulse)
+	check("[ consensusReports ]", err)
+	writeIndentedJSON(out, res)
+}
+
+func consensusTimeline(out io.Writer) {
+	if len(dumpPaths) == 0 {
+		check("[ consensusTimeline ]", errors.New("no dump files, use --dumps"))
+	}
+	var reports []telemetry.Report
+	for _, path := range dumpPaths {
+		loaded, err := telemetry.Load(path)
+		check("[ consensusTimeline ]", err)
+		reports = append(reports, loaded...)
+	}
+	err := telemetry.WriteTimeline(out, telemetry.MergeTimeline(reports))
+	check("[ consensusTimeline ] failed to write timeline", err)
+}
+
+func writeIndentedJSON(out io.Writer, raw json.RawMessage) {
+	var buf bytes.Buffer
+	err := json.Indent(&buf, raw, "", "    ")
+	check("[ writeIndentedJSON ] failed to format response", err)
+	buf.WriteByte('\n')
+	_, err = buf.WriteTo(out)
+	check("[ writeIndentedJSON ] failed to write response", err)
+}
+
+func loadLedgerConfig() configuration.Ledger {
+	cfgHolder := configuration.NewHolder()
+	err := cfgHolder.LoadFromFile(configPath)
+	check("[ loadLedgerConfig ] failed to load node configuration", err)
+	return cfgHolder.Configuration.Ledger
+}
+
+func ledgerBackup(out io.Writer) {
+	ctx := inslogger.ContextWithTrace(context.Background(), "insolarUtility")
+	res, err := backup.Backup(ctx, loadLedgerConfig(), insolar.PulseNumber(snapshotPulse), out)
+	check("[ ledgerBackup ]", err)
+
+	fmt.Fprintf(os.Stderr, "Snapshot of pulse %d: %+v\n", res.Pulse, res.Stats)
+}
+
+func ledgerRestore(out io.Writer) {
+	in := os.Stdin
+	if snapshotPath != defaultStdoutPath {
+		var err error
+		in, err = os.Open(snapshotPath)
+		check("[ ledgerRestore ] couldn't open snapshot file", err)
+		defer in.Close()
+	}
+
+	ctx := inslogger.ContextWithTrace(context.Background(), "insolarUtility")
+	res, err := backup.Restore(ctx, loadLedgerConfig(), in)
+	check("[ ledgerRestore ]", err)
+
+	fmt.Fprintf(out, "Restored snapshot of pulse %d: %+v\n", res.Pulse, res.Stats)
+}
+
+func ledgerFsck(out io.Writer) {
+	ctx := inslogger.ContextWithTrace(context.Background(), "insolarUtility")
+	report, err := verifier.Verify(ctx, loadLedgerConfig(), quarantine)
+	if report != nil {
+		for _, p := range report.Problems {
+			if p.Quarantined {
+				fmt.Fprintf(out, "%v (quarantined)\n", p)
+				continue
+			}
+			fmt.Fprintln(out, p)
+		}
+	}
+	check("[ ledgerFsck ]", err)
+
+	fmt.Fprintf(out, "Checked %d pulses, %d drops, %d records, %d indexes: %d problems found\n",
+		report.Pulses, report.Drops, report.Records, report.Indexes, len(report.Problems))
+	if len(report.Problems) > 0 {
+		os.Exit(1)
+	}
+}
@@ -97,7 +97,10 @@ func main() {
 		return
 	}
 	switch cert.GetRole() {
-	case insolar.StaticRoleHeavyMaterial, insolar.StaticRoleLightMaterial:
+	case insolar.StaticRoleHeavyMaterial:
+		s := server.NewHeavyServer(params.configPath, params.traceEnabled)
+		s.Serve()
+	case insolar.StaticRoleLightMaterial:
 		s := server.NewLightServer(params.configPath, params.traceEnabled)
 		s.Serve()
 	case insolar.StaticRoleVirtual:
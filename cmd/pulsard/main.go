@@ -138,7 +138,7 @@ func initPulsar(ctx context.Context, cfg configuration.Configuration) (*componen
 	cryptographyService := cryptography.NewCryptographyService()
 	keyProcessor := platformpolicy.NewKeyProcessor()
 
-	tp, publicAddress, err := transport.NewTransport(cfg.Pulsar.DistributionTransport)
+	tp, publicAddress, err := transport.NewTransport(cfg.Pulsar.DistributionTransport, nil)
 	if err != nil {
 		inslogger.FromContext(ctx).Fatal(err)
 	}
@@ -25,6 +25,8 @@ type APIRunner struct {
 	Address string
 	Call    string
 	RPC     string
+	// WS is the path of websocket JSON-RPC endpoint with subscriptions. Empty path disables the endpoint.
+	WS      string
 	Timeout uint32
 }
 
@@ -34,11 +36,12 @@ func NewAPIRunner() APIRunner {
 		Address: "localhost:19101",
 		Call:    "/api/call",
 		RPC:     "/api/rpc",
+		WS:      "/api/ws",
 		Timeout: 15,
 	}
 }
 
 func (ar *APIRunner) String() string {
-	res := fmt.Sprintln("Addr ->", ar.Address, ", Call ->", ar.Call, ", RPC ->", ar.RPC)
+	res := fmt.Sprintln("Addr ->", ar.Address, ", Call ->", ar.Call, ", RPC ->", ar.RPC, ", WS ->", ar.WS)
 	return res
 }
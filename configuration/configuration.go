@@ -29,6 +29,7 @@ import (
 type Configuration struct {
 	Host            HostNetwork
 	Service         ServiceNetwork
+	MessageBus      MessageBus
 	Ledger          Ledger
 	Log             Log
 	Metrics         Metrics
@@ -52,6 +53,7 @@ func NewConfiguration() Configuration {
 	cfg := Configuration{
 		Host:            NewHostNetwork(),
 		Service:         NewServiceNetwork(),
+		MessageBus:      NewMessageBus(),
 		Ledger:          NewLedger(),
 		Log:             NewLog(),
 		Metrics:         NewMetrics(),
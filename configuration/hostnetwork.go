@@ -18,7 +18,7 @@ package configuration
 
 // Transport holds transport protocol configuration for HostNetwork
 type Transport struct {
-	// protocol type
+	// protocol type: TCP, PURE_UDP, TLS or QUIC
 	Protocol string
 	// Address to listen
 	Address string
@@ -26,6 +26,8 @@ type Storage struct {
 	DataDirectory string
 	// DataDirectoryNewDB is a directory where new database's files live.
 	DataDirectoryNewDB string
+	// Backend is a new database implementation: badger or bolt.
+	Backend string
 	// TxRetriesOnConflict defines how many retries on transaction conflicts
 	// storage update methods should do.
 	TxRetriesOnConflict int
@@ -41,6 +43,11 @@ type PulseManager struct {
 	HeavyBackoff Backoff
 	// SplitThreshold is a drop size threshold in bytes to perform split.
 	SplitThreshold uint64
+	// SplitRecordsThreshold is a drop records count threshold to perform split (0 - disabled).
+	SplitRecordsThreshold int
+	// MergeThreshold is a drop size threshold in bytes. Sibling jets are joined when drops of both
+	// are smaller (0 - disabled). Should be much less than SplitThreshold to avoid split/join flapping.
+	MergeThreshold uint64
 }
 
 // Backoff configures retry backoff algorithm
@@ -64,6 +71,22 @@ type Exporter struct {
 	ExportLag uint32
 }
 
+// Backup holds configuration of ledger snapshots.
+type Backup struct {
+	// Directory is a directory where snapshots made via API are saved.
+	Directory string
+}
+
+// Retention holds configuration of heavy node storage retention policy.
+type Retention struct {
+	// Depth is maximum pulse difference (NOT number of pulses) between the latest pulse and the oldest pulse with
+	// full object history. Older object states are compacted to checkpoints. Zero disables compaction.
+	Depth int
+	// CheckpointInterval is a pulse difference between compacted object states. Only the latest object state
+	// of every interval is kept. Zero keeps only the latest state older than Depth.
+	CheckpointInterval int
+}
+
 // Ledger holds configuration for ledger.
 type Ledger struct {
 	// Storage defines storage configuration.
@@ -84,6 +107,12 @@ type Ledger struct {
 	// Exporter holds configuration of Exporter
 	Exporter Exporter
 
+	// Backup holds configuration of ledger snapshots
+	Backup Backup
+
+	// Retention holds configuration of heavy node storage retention policy
+	Retention Retention
+
 	// PendingRequestsLimit holds a number of pending requests, what can be stored in the system
 	// before they are declined
 	PendingRequestsLimit int
@@ -95,6 +124,7 @@ func NewLedger() Ledger {
 		Storage: Storage{
 			DataDirectory:       "./data",
 			DataDirectoryNewDB:  "./new-data",
+			Backend:             "badger",
 			TxRetriesOnConflict: 3,
 		},
 
@@ -107,7 +137,9 @@ func NewLedger() Ledger {
 				Max:    2 * time.Second,
 				Factor: 2,
 			},
-			SplitThreshold: 10 * 100, // 10 megabytes.
+			SplitThreshold:        10 * 1000 * 1000, // 10 megabytes.
+			SplitRecordsThreshold: 10000,
+			MergeThreshold:        100 * 1000, // 100 kilobytes.
 		},
 
 		RecentStorage: RecentStorage{
@@ -120,6 +152,15 @@ func NewLedger() Ledger {
 			ExportLag: 40, // 40 seconds
 		},
 
+		Backup: Backup{
+			Directory: "./backup",
+		},
+
+		Retention: Retention{
+			Depth:              0, // disabled
+			CheckpointInterval: 1000,
+		},
+
 		PendingRequestsLimit: 1000,
 	}
 }
@@ -0,0 +1,42 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package configuration
+
+// MessageBus holds configuration for incoming parcels throttling.
+type MessageBus struct {
+	// SenderRate is the number of parcels per second accepted from a single node. Zero disables the limit.
+	SenderRate float64
+	// SenderBurst is the number of parcels accepted from a single node at once.
+	SenderBurst int
+	// TypeRate is the number of parcels per second accepted for a single message type. Zero disables the limit.
+	TypeRate float64
+	// TypeBurst is the number of parcels of a single message type accepted at once.
+	TypeBurst int
+	// FuturePoolSize is the number of parcels from future pulses that can wait for pulse change. Zero disables
+	// the limit.
+	FuturePoolSize int
+	// TapeDirectory is the directory for temporary files of recorder and player tapes. Empty value means the system
+	// temporary directory.
+	TapeDirectory string
+}
+
+// NewMessageBus creates new default configuration for MessageBus.
+func NewMessageBus() MessageBus {
+	return MessageBus{
+		FuturePoolSize: 10000,
+	}
+}
@@ -20,12 +20,18 @@ package configuration
 type ServiceNetwork struct {
 	Skip           int // magic number that indicates what delta after last ignored pulse we should wait
 	CacheDirectory string
+	// ConsensusReportsLimit is a number of latest consensus round reports kept in memory
+	ConsensusReportsLimit int
+	// ConsensusDumpDirectory is a directory for reports of failed consensus rounds, dumps are disabled if empty
+	ConsensusDumpDirectory string
 }
 
 // NewServiceNetwork creates a new ServiceNetwork configuration.
 func NewServiceNetwork() ServiceNetwork {
 	return ServiceNetwork{
-		Skip:           10,
-		CacheDirectory: "network_cache",
+		Skip:                   10,
+		CacheDirectory:         "network_cache",
+		ConsensusReportsLimit:  100,
+		ConsensusDumpDirectory: "consensus_dumps",
 	}
 }
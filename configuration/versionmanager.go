@@ -19,9 +19,14 @@ package configuration
 // VersionManager holds configuration for VersionManager publishing.
 type VersionManager struct {
 	MinAlowedVersion string
+	// FeatureActivationPercent is a percent of active nodes that should support a feature to activate it.
+	FeatureActivationPercent int
 }
 
 // NewVersionManager creates new default configuration for VersionManager publishing.
 func NewVersionManager() VersionManager {
-	return VersionManager{MinAlowedVersion: "v0.3.0"}
+	return VersionManager{
+		MinAlowedVersion:         "v0.3.0",
+		FeatureActivationPercent: 51,
+	}
 }
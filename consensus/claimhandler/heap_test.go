@@ -109,7 +109,7 @@ func getJoinClaim(t *testing.T, ref insolar.Reference) *packets.NodeJoinClaim {
 	nodeJoinClaim.NodeRef = ref
 	_, err := rand.Read(nodeJoinClaim.NodePK[:])
 	assert.NoError(t, err)
-	nodeJoinClaim.NodeAddress.Set("127.0.0.1:5566")
+	_ = nodeJoinClaim.NodeAddress.Set("127.0.0.1:5566")
 
 	return nodeJoinClaim
 }
@@ -51,7 +51,11 @@
 package packets
 
 import (
+	"bytes"
 	"crypto"
+	"encoding/binary"
+	"net"
+	"strconv"
 
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/platformpolicy"
@@ -113,11 +117,51 @@ func (nb *NodeBroadcast) Type() ClaimType {
 	return TypeNodeBroadcast
 }
 
+// Types of capabilities that can be advertised with CapabilityPoolingAndActivation.
+const (
+	// CapabilityTypeFeature - CapabilityRef contains key of a feature from version table.
+	CapabilityTypeFeature = uint16(iota + 1)
+)
+
+// Polling flags of CapabilityPoolingAndActivation.
+const (
+	// CapabilityFlagSupported - capability is supported by the node.
+	CapabilityFlagSupported = uint16(1 << iota)
+	// CapabilityFlagActivated - capability is activated by the network at ActivationPulse.
+	CapabilityFlagActivated
+)
+
 // CapabilityPoolingAndActivation is a type 3.
 type CapabilityPoolingAndActivation struct {
 	PollingFlags   uint16
 	CapabilityType uint16
 	CapabilityRef  [ReferenceLength]byte
+	// ActivationPulse is a pulse at which capability was activated, it is set only with CapabilityFlagActivated.
+	ActivationPulse insolar.PulseNumber
+
+	// additional field that is not serialized and is set from transport layer on packet receive
+	NodeID insolar.Reference
+}
+
+// NewFeatureCapability creates claim that advertises support of the feature by the node.
+func NewFeatureCapability(key string) (*CapabilityPoolingAndActivation, error) {
+	if len(key) == 0 || len(key) > ReferenceLength {
+		return nil, errors.Errorf("[ NewFeatureCapability ] Invalid feature key length: %d", len(key))
+	}
+	result := &CapabilityPoolingAndActivation{
+		PollingFlags:   CapabilityFlagSupported,
+		CapabilityType: CapabilityTypeFeature,
+	}
+	copy(result.CapabilityRef[:], key)
+	return result, nil
+}
+
+// GetFeatureKey returns key of the advertised feature or empty string if claim does not contain a feature.
+func (cpa *CapabilityPoolingAndActivation) GetFeatureKey() string {
+	if cpa.CapabilityType != CapabilityTypeFeature {
+		return ""
+	}
+	return string(bytes.TrimRight(cpa.CapabilityRef[:], "\x00"))
 }
 
 func (cpa *CapabilityPoolingAndActivation) Clone() ReferendumClaim {
@@ -125,51 +169,229 @@ func (cpa *CapabilityPoolingAndActivation) Clone() ReferendumClaim {
 	return &result
 }
 
+func (cpa *CapabilityPoolingAndActivation) AddSupplementaryInfo(nodeID insolar.Reference) {
+	cpa.NodeID = nodeID
+}
+
 func (cpa *CapabilityPoolingAndActivation) Type() ClaimType {
 	return TypeCapabilityPollingAndActivation
 }
 
+// Types of violations that can be reported with NodeViolationBlame.
+const (
+	// ViolationConflictingProofs - node signed different phase 1 pulse proofs for one pulse.
+	ViolationConflictingProofs = uint8(iota + 1)
+	// ViolationInconsistentBitSet - node signed phase 2 packets with different bitsets for one pulse.
+	ViolationInconsistentBitSet
+)
+
 // NodeViolationBlame is a type 2.
 type NodeViolationBlame struct {
+	// additional field that is not serialized and is set from transport layer on packet receive
+	BlamerID insolar.Reference
+
 	BlameNodeID   uint32
 	TypeViolation uint8
+	// PulseHash is a hash of the pulse both proofs of Evidence are signed for
+	PulseHash [HashLength]byte
+	// Evidence contains both conflicting proofs in case of ViolationConflictingProofs
+	Evidence [2]NodePulseProof
+	// PacketEvidence contains both phase 2 packets in case of ViolationInconsistentBitSet, it's serialized only
+	// for this violation type
+	PacketEvidence [2]*Phase2Packet
 }
 
 func (nvb *NodeViolationBlame) Clone() ReferendumClaim {
 	result := *nvb
+	for i, packet := range nvb.PacketEvidence {
+		if packet != nil {
+			result.PacketEvidence[i] = packet.Clone().(*Phase2Packet)
+		}
+	}
 	return &result
 }
 
+func (nvb *NodeViolationBlame) AddSupplementaryInfo(nodeID insolar.Reference) {
+	nvb.BlamerID = nodeID
+}
+
+// Verify checks that the evidence is signed by the blamed node with provided public key. The evidence doesn't depend
+// on the node that sent the blame, so it can be checked by any node.
+func (nvb *NodeViolationBlame) Verify(
+	scheme insolar.PlatformCryptographyScheme,
+	cryptography insolar.CryptographyService,
+	key crypto.PublicKey,
+) error {
+	switch nvb.TypeViolation {
+	case ViolationConflictingProofs:
+		return nvb.verifyProofs(scheme, cryptography, key)
+	case ViolationInconsistentBitSet:
+		return nvb.verifyPackets(cryptography, key)
+	}
+	return errors.Errorf("unknown violation type %d", nvb.TypeViolation)
+}
+
+func (nvb *NodeViolationBlame) verifyProofs(
+	scheme insolar.PlatformCryptographyScheme,
+	cryptography insolar.CryptographyService,
+	key crypto.PublicKey,
+) error {
+	if nvb.Evidence[0].NodeStateHash == nvb.Evidence[1].NodeStateHash {
+		return errors.New("proofs are not conflicting")
+	}
+	for i := range nvb.Evidence {
+		// pulse proof signs a hash of the pulse hash and the node state hash
+		hasher := scheme.IntegrityHasher()
+		_, _ = hasher.Write(nvb.PulseHash[:])
+		_, _ = hasher.Write(nvb.Evidence[i].StateHash())
+		signature := insolar.SignatureFromBytes(nvb.Evidence[i].Signature())
+		if !cryptography.Verify(key, signature, hasher.Sum(nil)) {
+			return errors.Errorf("bad signature of proof %d", i)
+		}
+	}
+	return nil
+}
+
+func (nvb *NodeViolationBlame) verifyPackets(cryptography insolar.CryptographyService, key crypto.PublicKey) error {
+	var bitSets [2][]byte
+	for i, packet := range nvb.PacketEvidence {
+		if packet == nil || packet.GetBitSet() == nil {
+			return errors.Errorf("packet %d has no bitset", i)
+		}
+		if packet.GetOrigin() != insolar.ShortNodeID(nvb.BlameNodeID) {
+			return errors.Errorf("packet %d is sent by other node", i)
+		}
+		var err error
+		bitSets[i], err = packet.GetBitSet().Serialize()
+		if err != nil {
+			return errors.Wrapf(err, "failed to serialize bitset of packet %d", i)
+		}
+	}
+	if nvb.PacketEvidence[0].GetPulseNumber() != nvb.PacketEvidence[1].GetPulseNumber() {
+		return errors.New("packets are sent in different pulses")
+	}
+	if bytes.Equal(bitSets[0], bitSets[1]) {
+		return errors.New("bitsets are not inconsistent")
+	}
+	for i, packet := range nvb.PacketEvidence {
+		err := packet.Verify(cryptography, key)
+		if err != nil {
+			return errors.Wrapf(err, "bad signature of packet %d", i)
+		}
+	}
+	return nil
+}
+
 func (nvb *NodeViolationBlame) Type() ClaimType {
 	return TypeNodeViolationBlame
 }
 
-const NodeAddressSize = 20
+// NodeAddressType is a kind of address stored in NodeAddress.
+type NodeAddressType uint8
+
+const (
+	NodeAddressUnknown = NodeAddressType(iota)
+	NodeAddressIPv4
+	NodeAddressIPv6
+	NodeAddressHostname
+)
 
-// TODO: create heterogeneous structure for variuos types of adresses (IPv4, IPv6, etc.)
+const (
+	// NodeAddressSize is a size of serialized NodeAddress: type (1 byte), port (2 bytes) and address payload.
+	NodeAddressSize = 64
+
+	nodeAddressHeaderSize = 3
+	// MaxNodeAddressHostnameLength is a maximum length of hostname that fits into NodeAddress.
+	MaxNodeAddressHostnameLength = NodeAddressSize - nodeAddressHeaderSize - 1
+)
+
+// NodeAddress is a fixed size typed encoding of host:port address.
+//
+// Layout: [0] - NodeAddressType, [1:3] - port (big endian), [3:] - payload:
+// 4 bytes for IPv4, 16 bytes for IPv6, length byte followed by name for hostname.
 type NodeAddress [NodeAddressSize]byte
 
-func NewNodeAddress(address string) NodeAddress {
+// NewNodeAddress parses host:port string into NodeAddress.
+func NewNodeAddress(address string) (NodeAddress, error) {
 	var result NodeAddress
-	result.Set(address)
-	return result
+	err := result.Set(address)
+	return result, err
 }
 
-func (address *NodeAddress) Set(s string) {
-	copy(address[:], []byte(s)[:NodeAddressSize])
+// Set parses host:port string and stores it in address. Hostnames are stored as is, without resolving.
+func (address *NodeAddress) Set(s string) error {
+	host, portStr, err := net.SplitHostPort(s)
+	if err != nil {
+		return errors.Wrap(err, "[ NodeAddress.Set ] Failed to split host and port")
+	}
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil {
+		return errors.Wrap(err, "[ NodeAddress.Set ] Failed to parse port")
+	}
+
+	var result NodeAddress
+	binary.BigEndian.PutUint16(result[1:nodeAddressHeaderSize], uint16(port))
+	payload := result[nodeAddressHeaderSize:]
+
+	ip := net.ParseIP(host)
+	switch {
+	case ip != nil && ip.To4() != nil:
+		result[0] = byte(NodeAddressIPv4)
+		copy(payload, ip.To4())
+	case ip != nil:
+		result[0] = byte(NodeAddressIPv6)
+		copy(payload, ip.To16())
+	default:
+		if len(host) == 0 || len(host) > MaxNodeAddressHostnameLength {
+			return errors.Errorf("[ NodeAddress.Set ] Invalid hostname length: %d", len(host))
+		}
+		result[0] = byte(NodeAddressHostname)
+		payload[0] = byte(len(host))
+		copy(payload[1:], host)
+	}
+
+	*address = result
+	return nil
 }
 
-func (address NodeAddress) Get() string {
-	var i int
-	for i = 1; i < len(address); i++ {
-		if address[i] == 0 {
-			break
+// Type returns type of stored address.
+func (address NodeAddress) Type() NodeAddressType {
+	return NodeAddressType(address[0])
+}
+
+// Port returns stored port.
+func (address NodeAddress) Port() uint16 {
+	return binary.BigEndian.Uint16(address[1:nodeAddressHeaderSize])
+}
+
+// Host returns stored IP address or hostname without port.
+func (address NodeAddress) Host() string {
+	payload := address[nodeAddressHeaderSize:]
+	switch address.Type() {
+	case NodeAddressIPv4:
+		return net.IP(payload[:net.IPv4len]).String()
+	case NodeAddressIPv6:
+		return net.IP(payload[:net.IPv6len]).String()
+	case NodeAddressHostname:
+		length := int(payload[0])
+		if length > MaxNodeAddressHostnameLength {
+			length = MaxNodeAddressHostnameLength
 		}
+		return string(payload[1 : 1+length])
+	default:
+		return ""
+	}
+}
+
+// Get returns address in host:port form, IPv6 addresses are enclosed in square brackets.
+func (address NodeAddress) Get() string {
+	if address.Type() == NodeAddressUnknown {
+		return ""
 	}
-	return string(address[:i])
+	return net.JoinHostPort(address.Host(), strconv.Itoa(int(address.Port())))
 }
 
-// NodeJoinClaim is a type 1, len == 272.
+// NodeJoinClaim is a type 1, len == 280.
 type NodeJoinClaim struct {
 	ShortNodeID             insolar.ShortNodeID
 	RelayNodeID             insolar.ShortNodeID
@@ -204,7 +426,7 @@ func (njc *NodeJoinClaim) Type() ClaimType {
 	return TypeNodeJoinClaim
 }
 
-// NodeJoinClaim is a type 5, len == 272.
+// NodeAnnounceClaim is a type 5, len == 350.
 type NodeAnnounceClaim struct {
 	NodeJoinClaim
 
@@ -252,6 +474,10 @@ func (nlc *NodeLeaveClaim) Type() ClaimType {
 }
 
 func getClaimSize(claim ReferendumClaim) uint16 {
+	if blame, ok := claim.(*NodeViolationBlame); ok {
+		// blame size depends on packets in evidence
+		return claimSizeMap[claim.Type()] + blame.packetEvidenceSize()
+	}
 	return claimSizeMap[claim.Type()]
 }
 
@@ -268,6 +494,11 @@ func NodeToClaim(node insolar.NetworkNode) (*NodeJoinClaim, error) {
 	var keyData [PublicKeyLength]byte
 	copy(keyData[:], exportedKey[:PublicKeyLength])
 
+	address, err := NewNodeAddress(node.Address())
+	if err != nil {
+		return nil, errors.Wrap(err, "[ NodeToClaim ] failed to convert node address")
+	}
+
 	var s [SignatureLength]byte
 	return &NodeJoinClaim{
 		ShortNodeID:             node.ShortID(),
@@ -277,7 +508,7 @@ func NodeToClaim(node insolar.NetworkNode) (*NodeJoinClaim, error) {
 		NodeRoleRecID:           node.Role(),
 		NodeRef:                 node.ID(),
 		NodePK:                  keyData,
-		NodeAddress:             NewNodeAddress(node.Address()),
+		NodeAddress:             address,
 		Signature:               s,
 	}, nil
 }
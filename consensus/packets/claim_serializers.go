@@ -98,6 +98,11 @@ func (cpa *CapabilityPoolingAndActivation) Deserialize(data io.Reader) error {
 		return errors.Wrap(err, "[ CapabilityPoolingAndActivation.Deserialize ] Can't read CapabilityRef")
 	}
 
+	err = binary.Read(data, defaultByteOrder, &cpa.ActivationPulse)
+	if err != nil {
+		return errors.Wrap(err, "[ CapabilityPoolingAndActivation.Deserialize ] Can't read ActivationPulse")
+	}
+
 	return nil
 }
 
@@ -119,6 +124,11 @@ func (cpa *CapabilityPoolingAndActivation) Serialize() ([]byte, error) {
 		return nil, errors.Wrap(err, "[ CapabilityPoolingAndActivation.Serialize ] Can't write CapabilityRef")
 	}
 
+	err = binary.Write(result, defaultByteOrder, cpa.ActivationPulse)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ CapabilityPoolingAndActivation.Serialize ] Can't write ActivationPulse")
+	}
+
 	return result.Bytes(), nil
 }
 
@@ -134,12 +144,47 @@ func (nvb *NodeViolationBlame) Deserialize(data io.Reader) error {
 		return errors.Wrap(err, "[ NodeViolationBlame.Deserialize ] Can't read TypeViolation")
 	}
 
+	err = binary.Read(data, defaultByteOrder, &nvb.PulseHash)
+	if err != nil {
+		return errors.Wrap(err, "[ NodeViolationBlame.Deserialize ] Can't read PulseHash")
+	}
+
+	for i := range nvb.Evidence {
+		err = nvb.Evidence[i].Deserialize(data)
+		if err != nil {
+			return errors.Wrap(err, "[ NodeViolationBlame.Deserialize ] Can't read Evidence")
+		}
+	}
+
+	if nvb.TypeViolation != ViolationInconsistentBitSet {
+		return nil
+	}
+
+	for i := range nvb.PacketEvidence {
+		var length uint16
+		err = binary.Read(data, defaultByteOrder, &length)
+		if err != nil {
+			return errors.Wrap(err, "[ NodeViolationBlame.Deserialize ] Can't read PacketEvidence length")
+		}
+		raw := make([]byte, length)
+		_, err = io.ReadFull(data, raw)
+		if err != nil {
+			return errors.Wrap(err, "[ NodeViolationBlame.Deserialize ] Can't read PacketEvidence")
+		}
+		packet := &Phase2Packet{}
+		err = packet.Deserialize(bytes.NewReader(raw))
+		if err != nil {
+			return errors.Wrap(err, "[ NodeViolationBlame.Deserialize ] Can't deserialize PacketEvidence")
+		}
+		nvb.PacketEvidence[i] = packet
+	}
+
 	return nil
 }
 
 // Serialize implements interface method
 func (nvb *NodeViolationBlame) Serialize() ([]byte, error) {
-	result := allocateBuffer(64)
+	result := allocateBuffer(384)
 	err := binary.Write(result, defaultByteOrder, nvb.BlameNodeID)
 	if err != nil {
 		return nil, errors.Wrap(err, "[ NodeViolationBlame.Serialize ] Can't write BlameNodeID")
@@ -150,9 +195,66 @@ func (nvb *NodeViolationBlame) Serialize() ([]byte, error) {
 		return nil, errors.Wrap(err, "[ NodeViolationBlame.Serialize ] Can't write TypeViolation")
 	}
 
+	err = binary.Write(result, defaultByteOrder, nvb.PulseHash)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ NodeViolationBlame.Serialize ] Can't write PulseHash")
+	}
+
+	for i := range nvb.Evidence {
+		evidence, err := nvb.Evidence[i].Serialize()
+		if err != nil {
+			return nil, errors.Wrap(err, "[ NodeViolationBlame.Serialize ] Can't serialize Evidence")
+		}
+		_, err = result.Write(evidence)
+		if err != nil {
+			return nil, errors.Wrap(err, "[ NodeViolationBlame.Serialize ] Can't write Evidence")
+		}
+	}
+
+	if nvb.TypeViolation != ViolationInconsistentBitSet {
+		return result.Bytes(), nil
+	}
+
+	for _, packet := range nvb.PacketEvidence {
+		if packet == nil {
+			return nil, errors.New("[ NodeViolationBlame.Serialize ] PacketEvidence is missing")
+		}
+		raw, err := packet.Serialize()
+		if err != nil {
+			return nil, errors.Wrap(err, "[ NodeViolationBlame.Serialize ] Can't serialize PacketEvidence")
+		}
+		err = binary.Write(result, defaultByteOrder, uint16(len(raw)))
+		if err != nil {
+			return nil, errors.Wrap(err, "[ NodeViolationBlame.Serialize ] Can't write PacketEvidence length")
+		}
+		_, err = result.Write(raw)
+		if err != nil {
+			return nil, errors.Wrap(err, "[ NodeViolationBlame.Serialize ] Can't write PacketEvidence")
+		}
+	}
+
 	return result.Bytes(), nil
 }
 
+// packetEvidenceSize returns serialized size of PacketEvidence, it's zero for violations proved without packets.
+func (nvb *NodeViolationBlame) packetEvidenceSize() uint16 {
+	if nvb.TypeViolation != ViolationInconsistentBitSet {
+		return 0
+	}
+	var size int
+	for _, packet := range nvb.PacketEvidence {
+		if packet == nil {
+			continue
+		}
+		raw, err := packet.Serialize()
+		if err != nil {
+			continue
+		}
+		size += 2 + len(raw)
+	}
+	return uint16(size)
+}
+
 func (njc *NodeJoinClaim) deserializeRaw(data io.Reader) error {
 	err := binary.Read(data, defaultByteOrder, &njc.ShortNodeID)
 	if err != nil {
@@ -51,10 +51,16 @@
 package packets
 
 import (
+	"bytes"
+	"strings"
 	"testing"
 
+	"github.com/insolar/insolar/cryptography"
 	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/platformpolicy"
 	"github.com/insolar/insolar/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func makeNodeBroadCast() *NodeBroadcast {
@@ -73,6 +79,7 @@ func makeCapabilityPoolingAndActivation() *CapabilityPoolingAndActivation {
 	capabilityPoolingAndActivation.PollingFlags = uint16(10)
 	capabilityPoolingAndActivation.CapabilityType = uint16(7)
 	capabilityPoolingAndActivation.CapabilityRef = randomArray64()
+	capabilityPoolingAndActivation.ActivationPulse = insolar.PulseNumber(65541)
 
 	return capabilityPoolingAndActivation
 }
@@ -81,9 +88,31 @@ func TestCapabilityPoolingAndActivation(t *testing.T) {
 	checkSerializationDeserialization(t, makeCapabilityPoolingAndActivation())
 }
 
+func TestNewFeatureCapability(t *testing.T) {
+	claim, err := NewFeatureCapability("insolar_feature")
+	require.NoError(t, err)
+	assert.Equal(t, CapabilityTypeFeature, claim.CapabilityType)
+	assert.Equal(t, CapabilityFlagSupported, claim.PollingFlags)
+	assert.Equal(t, "insolar_feature", claim.GetFeatureKey())
+	checkSerializationDeserialization(t, claim)
+
+	_, err = NewFeatureCapability("")
+	assert.Error(t, err)
+	_, err = NewFeatureCapability(strings.Repeat("a", ReferenceLength+1))
+	assert.Error(t, err)
+
+	assert.Equal(t, "", makeCapabilityPoolingAndActivation().GetFeatureKey())
+}
+
 func makeNodeViolationBlame() *NodeViolationBlame {
 	nodeViolationBlame := &NodeViolationBlame{}
-	nodeViolationBlame.TypeViolation = uint8(4)
+	nodeViolationBlame.BlameNodeID = 42
+	nodeViolationBlame.TypeViolation = ViolationConflictingProofs
+	nodeViolationBlame.PulseHash = randomArray64()
+	for i := range nodeViolationBlame.Evidence {
+		nodeViolationBlame.Evidence[i].NodeStateHash = randomArray64()
+		nodeViolationBlame.Evidence[i].NodeSignature = randomArray66()
+	}
 
 	return nodeViolationBlame
 }
@@ -92,6 +121,100 @@ func TestNodeViolationBlame(t *testing.T) {
 	checkSerializationDeserialization(t, makeNodeViolationBlame())
 }
 
+func signPulseProof(t *testing.T, cs insolar.CryptographyService, pulseHash []byte, stateHash byte) NodePulseProof {
+	proof := NodePulseProof{NodeStateHash: [HashLength]byte{stateHash}}
+	hasher := platformpolicy.NewPlatformCryptographyScheme().IntegrityHasher()
+	_, _ = hasher.Write(pulseHash)
+	_, _ = hasher.Write(proof.StateHash())
+	signature, err := cs.Sign(hasher.Sum(nil))
+	require.NoError(t, err)
+	copy(proof.NodeSignature[:], signature.Bytes())
+	return proof
+}
+
+func TestNodeViolationBlame_Verify(t *testing.T) {
+	scheme := platformpolicy.NewPlatformCryptographyScheme()
+	kp := platformpolicy.NewKeyProcessor()
+	privateKey, err := kp.GeneratePrivateKey()
+	require.NoError(t, err)
+	cs := cryptography.NewKeyBoundCryptographyService(privateKey)
+	publicKey := kp.ExtractPublicKey(privateKey)
+	otherKey, err := kp.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	pulseHash := randomArray64()
+	blame := &NodeViolationBlame{
+		TypeViolation: ViolationConflictingProofs,
+		PulseHash:     pulseHash,
+		Evidence: [2]NodePulseProof{
+			signPulseProof(t, cs, pulseHash[:], 1),
+			signPulseProof(t, cs, pulseHash[:], 2),
+		},
+	}
+	assert.NoError(t, blame.Verify(scheme, cs, publicKey))
+	assert.Error(t, blame.Verify(scheme, cs, kp.ExtractPublicKey(otherKey)), "proofs signed by other node")
+
+	forged := *blame
+	forged.PulseHash = randomArray64()
+	assert.Error(t, forged.Verify(scheme, cs, publicKey), "proofs signed for other pulse")
+
+	same := *blame
+	same.Evidence[1] = same.Evidence[0]
+	assert.Error(t, same.Verify(scheme, cs, publicKey), "proofs are not conflicting")
+
+	unsigned := *blame
+	unsigned.Evidence[1].NodeSignature = randomArray66()
+	assert.Error(t, unsigned.Verify(scheme, cs, publicKey), "proof is not signed")
+}
+
+func signPhase2Packet(t *testing.T, cs insolar.CryptographyService, origin insolar.ShortNodeID, size int) *Phase2Packet {
+	bitSet, err := NewBitSet(size)
+	require.NoError(t, err)
+	packet := NewPhase2Packet(insolar.FirstPulseNumber)
+	packet.SetBitSet(bitSet)
+	packet.SetRouting(origin, origin+1)
+	require.NoError(t, packet.Sign(cs))
+	return packet
+}
+
+func TestNodeViolationBlame_InconsistentBitSet(t *testing.T) {
+	kp := platformpolicy.NewKeyProcessor()
+	privateKey, err := kp.GeneratePrivateKey()
+	require.NoError(t, err)
+	cs := cryptography.NewKeyBoundCryptographyService(privateKey)
+	publicKey := kp.ExtractPublicKey(privateKey)
+
+	blame := &NodeViolationBlame{
+		BlameNodeID:   42,
+		TypeViolation: ViolationInconsistentBitSet,
+		PacketEvidence: [2]*Phase2Packet{
+			signPhase2Packet(t, cs, 42, 3),
+			signPhase2Packet(t, cs, 42, 5),
+		},
+	}
+	assert.NoError(t, blame.Verify(nil, cs, publicKey))
+
+	data, err := blame.Serialize()
+	require.NoError(t, err)
+	assert.Equal(t, int(getClaimSize(blame)), len(data))
+	restored := &NodeViolationBlame{}
+	require.NoError(t, restored.Deserialize(bytes.NewReader(data)))
+	assert.NoError(t, restored.Verify(nil, cs, publicKey), "evidence is signed after deserialization")
+
+	other := *blame
+	other.BlameNodeID = 43
+	assert.Error(t, other.Verify(nil, cs, publicKey), "packets are sent by other node")
+
+	same := *blame
+	same.PacketEvidence[1] = same.PacketEvidence[0]
+	assert.Error(t, same.Verify(nil, cs, publicKey), "bitsets are not inconsistent")
+
+	unsigned := *blame
+	unsigned.PacketEvidence[1] = unsigned.PacketEvidence[1].Clone().(*Phase2Packet)
+	unsigned.PacketEvidence[1].SignatureHeaderSection1 = randomArray66()
+	assert.Error(t, unsigned.Verify(nil, cs, publicKey), "packet is not signed")
+}
+
 func makeNodeJoinClaim(withSignature bool) *NodeJoinClaim {
 	nodeJoinClaim := &NodeJoinClaim{}
 	nodeJoinClaim.ShortNodeID = insolar.ShortNodeID(77)
@@ -104,7 +227,7 @@ func makeNodeJoinClaim(withSignature bool) *NodeJoinClaim {
 	if withSignature {
 		nodeJoinClaim.Signature = randomArray66()
 	}
-	nodeJoinClaim.NodeAddress.Set("127.0.0.1:5566")
+	_ = nodeJoinClaim.NodeAddress.Set("127.0.0.1:5566")
 
 	return nodeJoinClaim
 }
@@ -117,6 +240,49 @@ func TestNodeJoinClaim_BadData(t *testing.T) {
 	checkBadDataSerializationDeserialization(t, makeNodeJoinClaim(true), "unexpected EOF")
 }
 
+func TestNodeJoinClaim_IPv6(t *testing.T) {
+	claim := makeNodeJoinClaim(true)
+	require.NoError(t, claim.NodeAddress.Set("[::1]:5566"))
+	checkSerializationDeserialization(t, claim)
+}
+
+func TestNodeAddress(t *testing.T) {
+	tests := []struct {
+		address  string
+		expected string
+		addrType NodeAddressType
+	}{
+		{"127.0.0.1:5566", "127.0.0.1:5566", NodeAddressIPv4},
+		{"[::1]:5566", "[::1]:5566", NodeAddressIPv6},
+		{"[fe80::1:2:3:4]:0", "[fe80::1:2:3:4]:0", NodeAddressIPv6},
+		{"[::ffff:10.0.0.1]:13831", "10.0.0.1:13831", NodeAddressIPv4},
+		{"insolar-node-1.example.com:13831", "insolar-node-1.example.com:13831", NodeAddressHostname},
+	}
+	for _, test := range tests {
+		address, err := NewNodeAddress(test.address)
+		require.NoError(t, err, test.address)
+		assert.Equal(t, test.addrType, address.Type(), test.address)
+		assert.Equal(t, test.expected, address.Get(), test.address)
+	}
+}
+
+func TestNodeAddress_Invalid(t *testing.T) {
+	invalid := []string{
+		"",
+		"127.0.0.1",
+		"::1:5566",
+		"127.0.0.1:65536",
+		"127.0.0.1:port",
+		":5566",
+		strings.Repeat("a", MaxNodeAddressHostnameLength+1) + ":5566",
+	}
+	for _, address := range invalid {
+		_, err := NewNodeAddress(address)
+		assert.Error(t, err, address)
+	}
+	assert.Equal(t, "", NodeAddress{}.Get())
+}
+
 func TestNodeLeaveClaim(t *testing.T) {
 	nodeLeaveClaim := &NodeLeaveClaim{}
 	checkSerializationDeserialization(t, nodeLeaveClaim)
@@ -359,7 +359,8 @@ func checkWrongPacket(t *testing.T, packet Serializer) {
 	data, err := packet.Serialize()
 	require.NoError(t, err)
 
-	buf := bytes.NewReader(data[:(len(data)-1)/3])
+	// claims with evidence make packets long, so only the beginning of the body is kept
+	buf := bytes.NewReader(data[:(len(data)-1)/8])
 	_, err = ExtractPacket(buf)
 	require.Contains(t, err.Error(), "Can't DeserializeWithoutHeader")
 }
@@ -52,9 +52,9 @@ package packets
 
 // claims auxiliar constants
 const (
-	headerTypeShift = 10
-	headerTypeMask  = 0xfc00
-	// headerLengthMask = 0x3ff
+	headerTypeShift  = 10
+	headerTypeMask   = 0xfc00
+	headerLengthMask = 0x3ff
 )
 
 const HeaderSize = 2
@@ -194,6 +194,10 @@ func (p1p *Phase1Packet) AddClaim(claim ReferendumClaim) bool {
 		log.Warn("claim is nil")
 		return false
 	}
+	// claim length has to fit into claim header
+	if getClaimSize(claim) > headerLengthMask {
+		return false
+	}
 
 	getClaimSize := func(claims ...ReferendumClaim) int {
 		result := 0
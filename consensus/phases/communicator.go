@@ -75,6 +75,7 @@ type Communicator interface {
 	// ExchangePhase1 used in first consensus step to exchange data between participants
 	ExchangePhase1(
 		ctx context.Context,
+		state *ConsensusState,
 		originClaim *packets.NodeAnnounceClaim,
 		participants []insolar.NetworkNode,
 		packet *packets.Phase1Packet,
@@ -114,6 +115,8 @@ type ConsensusCommunicator struct {
 	Cryptography     insolar.CryptographyService `inject:""`
 	NodeKeeper       network.NodeKeeper          `inject:""`
 
+	PlatformCryptographyScheme insolar.PlatformCryptographyScheme `inject:""`
+
 	phase1result chan phase1Result
 	phase2result chan phase2Result
 	phase3result chan phase3Result
@@ -266,6 +269,7 @@ func (nc *ConsensusCommunicator) generatePhase2Response(ctx context.Context, ori
 // ExchangePhase1 used in first consensus phase to exchange data between participants
 func (nc *ConsensusCommunicator) ExchangePhase1(
 	ctx context.Context,
+	state *ConsensusState,
 	originClaim *packets.NodeAnnounceClaim,
 	participants []insolar.NetworkNode,
 	packet *packets.Phase1Packet,
@@ -345,6 +349,9 @@ func (nc *ConsensusCommunicator) ExchangePhase1(
 				}
 			}
 			if !res.id.IsEmpty() {
+				if prev, ok := result[res.id]; ok {
+					nc.detectConflictingProofs(ctx, state, res.id, prev, res.packet)
+				}
 				sentRequests[res.id] = none{}
 				result[res.id] = res.packet
 			}
@@ -409,6 +416,9 @@ func (nc *ConsensusCommunicator) ExchangePhase2(ctx context.Context, state *Cons
 					logger.Error("Error sending phase2 response: " + err.Error())
 				}
 			}
+			if prev, ok := result[res.id]; ok {
+				nc.detectInconsistentBitSet(ctx, state, res.id, prev, res.packet)
+			}
 			result[res.id] = res.packet
 			sentRequests[res.id] = none{}
 
@@ -422,6 +432,56 @@ func (nc *ConsensusCommunicator) ExchangePhase2(ctx context.Context, state *Cons
 	}
 }
 
+// detectConflictingProofs blames active node that signed different phase1 pulse proofs in one pulse
+func (nc *ConsensusCommunicator) detectConflictingProofs(ctx context.Context, state *ConsensusState,
+	ref insolar.Reference, prev, packet *packets.Phase1Packet) {
+
+	if *prev.GetPulseProof() == *packet.GetPulseProof() {
+		return
+	}
+	node := state.NodesMutator.GetActiveNode(ref)
+	if node == nil {
+		return
+	}
+	blame := &packets.NodeViolationBlame{
+		BlameNodeID:   uint32(node.ShortID()),
+		TypeViolation: packets.ViolationConflictingProofs,
+		Evidence:      [2]packets.NodePulseProof{*prev.GetPulseProof(), *packet.GetPulseProof()},
+	}
+	copy(blame.PulseHash[:], state.PulseHash)
+	// packets are sent via unauthenticated transport, only proofs signed by the node itself prove violation
+	err := blame.Verify(nc.PlatformCryptographyScheme, nc.Cryptography, node.PublicKey())
+	if err != nil {
+		inslogger.FromContext(ctx).Debugf("Ignore conflicting phase1 pulse proofs from %s: %s", ref, err)
+		return
+	}
+	inslogger.FromContext(ctx).Warnf("Node %s signed conflicting phase1 pulse proofs", ref)
+	state.Violations.Add(node, blame)
+}
+
+// detectInconsistentBitSet blames active node that signed phase2 packets with different bitsets in one pulse
+func (nc *ConsensusCommunicator) detectInconsistentBitSet(ctx context.Context, state *ConsensusState,
+	ref insolar.Reference, prev, packet *packets.Phase2Packet) {
+
+	node := state.NodesMutator.GetActiveNode(ref)
+	if node == nil {
+		return
+	}
+	blame := &packets.NodeViolationBlame{
+		BlameNodeID:    uint32(node.ShortID()),
+		TypeViolation:  packets.ViolationInconsistentBitSet,
+		PacketEvidence: [2]*packets.Phase2Packet{prev, packet},
+	}
+	// packets are sent via unauthenticated transport, only packets signed by the node itself prove violation
+	err := blame.Verify(nc.PlatformCryptographyScheme, nc.Cryptography, node.PublicKey())
+	if err != nil {
+		inslogger.FromContext(ctx).Debugf("Ignore phase2 packets from %s: %s", ref, err)
+		return
+	}
+	inslogger.FromContext(ctx).Warnf("Node %s signed inconsistent phase2 bitsets", ref)
+	state.Violations.Add(node, blame)
+}
+
 func selectCandidate(candidates []insolar.Reference) insolar.Reference {
 	// TODO: make it random
 	if len(candidates) == 0 {
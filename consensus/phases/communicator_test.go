@@ -62,6 +62,7 @@ import (
 	"github.com/insolar/insolar/consensus/packets"
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/network"
+	"github.com/insolar/insolar/platformpolicy"
 	"github.com/insolar/insolar/testutils"
 	networkUtils "github.com/insolar/insolar/testutils/network"
 	"github.com/stretchr/testify/suite"
@@ -74,6 +75,7 @@ type communicatorSuite struct {
 	originNode       insolar.NetworkNode
 	participants     []insolar.NetworkNode
 	hostNetworkMock  *networkUtils.HostNetworkMock
+	cryptoServMock   *testutils.CryptographyServiceMock
 
 	consensusNetworkMock *networkUtils.ConsensusNetworkMock
 	pulseHandlerMock     *networkUtils.PulseHandlerMock
@@ -88,6 +90,8 @@ func NewSuite() *communicatorSuite {
 }
 
 func (s *communicatorSuite) SetupTest() {
+	s.componentManager = component.Manager{}
+	s.communicator = NewCommunicator()
 	s.consensusNetworkMock = networkUtils.NewConsensusNetworkMock(s.T())
 	s.pulseHandlerMock = networkUtils.NewPulseHandlerMock(s.T())
 	s.originNode = makeRandomNode()
@@ -101,6 +105,7 @@ func (s *communicatorSuite) SetupTest() {
 	cryptoServ.VerifyFunc = func(p crypto.PublicKey, p1 insolar.Signature, p2 []byte) (r bool) {
 		return true
 	}
+	s.cryptoServMock = cryptoServ
 
 	s.consensusNetworkMock.RegisterPacketHandlerMock.Set(func(p packets.PacketType, p1 network.ConsensusPacketHandler) {
 
@@ -116,7 +121,8 @@ func (s *communicatorSuite) SetupTest() {
 
 	})
 
-	s.componentManager.Inject(nodeN, cryptoServ, s.communicator, s.consensusNetworkMock, s.pulseHandlerMock)
+	s.componentManager.Inject(nodeN, cryptoServ, platformpolicy.NewPlatformCryptographyScheme(), s.communicator,
+		s.consensusNetworkMock, s.pulseHandlerMock)
 	err := s.componentManager.Start(context.TODO())
 	s.NoError(err)
 }
@@ -130,11 +136,120 @@ func (s *communicatorSuite) TestExchangeData() {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3)
 	defer cancel()
 
-	result, err := s.communicator.ExchangePhase1(ctx, nil, s.participants, &packets.Phase1Packet{})
+	result, err := s.communicator.ExchangePhase1(ctx, nil, nil, s.participants, &packets.Phase1Packet{})
 	s.Assert().NoError(err)
 	s.NotEqual(0, len(result))
 }
 
+// exchangeConflictingProofs runs phase1 exchange receiving two packets with different pulse proofs from one node.
+func (s *communicatorSuite) exchangeConflictingProofs(sender insolar.NetworkNode) (*ConsensusState, [2]*packets.Phase1Packet) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	snapshot := node.NewSnapshot(insolar.FirstPulseNumber, map[insolar.Reference]insolar.NetworkNode{sender.ID(): sender})
+	state := NewConsensusState(nil, snapshot)
+	state.PulseHash = make([]byte, packets.HashLength)
+	s.consensusNetworkMock.SignAndSendPacketMock.Return(nil)
+
+	pulse := insolar.Pulse{PulseNumber: insolar.FirstPulseNumber}
+	first := packets.NewPhase1Packet(pulse)
+	second := packets.NewPhase1Packet(pulse)
+	stateHash := make([]byte, packets.HashLength)
+	stateHash[0] = 1
+	s.Require().NoError(second.SetPulseProof(stateHash, make([]byte, packets.SignatureLength)))
+
+	communicator := s.communicator.(*ConsensusCommunicator)
+	s.Require().NoError(communicator.Init(ctx))
+	go func() {
+		communicator.phase1DataHandler(first, sender.ID())
+		communicator.phase1DataHandler(second, sender.ID())
+	}()
+
+	_, err := s.communicator.ExchangePhase1(ctx, state, nil, s.participants, packets.NewPhase1Packet(pulse))
+	s.Require().NoError(err)
+	return state, [2]*packets.Phase1Packet{first, second}
+}
+
+func (s *communicatorSuite) TestExchangePhase1ConflictingProofs() {
+	sender := makeRandomNode()
+	state, received := s.exchangeConflictingProofs(sender)
+	s.True(state.Violations.Contains(sender.ID()))
+
+	blames := state.Violations.Blames()
+	s.Require().Len(blames, 1)
+	s.Equal(packets.ViolationConflictingProofs, blames[0].TypeViolation)
+	s.Equal(uint32(sender.ShortID()), blames[0].BlameNodeID)
+	s.Equal(*received[0].GetPulseProof(), blames[0].Evidence[0])
+	s.Equal(*received[1].GetPulseProof(), blames[0].Evidence[1])
+}
+
+func (s *communicatorSuite) TestExchangePhase1ConflictingProofsNotSignedByNode() {
+	s.cryptoServMock.VerifyFunc = func(p crypto.PublicKey, p1 insolar.Signature, p2 []byte) (r bool) {
+		return false
+	}
+	sender := makeRandomNode()
+	state, _ := s.exchangeConflictingProofs(sender)
+	s.False(state.Violations.Contains(sender.ID()))
+	s.Empty(state.Violations.Blames())
+}
+
+// exchangeInconsistentBitSets runs phase2 exchange receiving two packets with different bitsets from one node.
+func (s *communicatorSuite) exchangeInconsistentBitSets(sender insolar.NetworkNode) (*ConsensusState, [2]*packets.Phase2Packet) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	snapshot := node.NewSnapshot(insolar.FirstPulseNumber, map[insolar.Reference]insolar.NetworkNode{sender.ID(): sender})
+	state := NewConsensusState(nil, snapshot)
+	s.consensusNetworkMock.SignAndSendPacketMock.Return(nil)
+
+	var received [2]*packets.Phase2Packet
+	for i := range received {
+		bitSet, err := packets.NewBitSet(i + 1)
+		s.Require().NoError(err)
+		received[i] = packets.NewPhase2Packet(insolar.FirstPulseNumber)
+		received[i].SetBitSet(bitSet)
+		received[i].SetRouting(sender.ShortID(), s.originNode.ShortID())
+	}
+
+	communicator := s.communicator.(*ConsensusCommunicator)
+	s.Require().NoError(communicator.Init(ctx))
+	communicator.setPulseNumber(insolar.FirstPulseNumber)
+	go func() {
+		communicator.phase2DataHandler(received[0], sender.ID())
+		communicator.phase2DataHandler(received[1], sender.ID())
+	}()
+
+	bitSet, err := packets.NewBitSet(1)
+	s.Require().NoError(err)
+	packet := packets.NewPhase2Packet(insolar.FirstPulseNumber)
+	packet.SetBitSet(bitSet)
+	_, err = s.communicator.ExchangePhase2(ctx, state, s.participants, packet)
+	s.Require().NoError(err)
+	return state, received
+}
+
+func (s *communicatorSuite) TestExchangePhase2InconsistentBitSets() {
+	sender := makeRandomNode()
+	state, received := s.exchangeInconsistentBitSets(sender)
+	s.True(state.Violations.Contains(sender.ID()))
+
+	blames := state.Violations.Blames()
+	s.Require().Len(blames, 1)
+	s.Equal(packets.ViolationInconsistentBitSet, blames[0].TypeViolation)
+	s.Equal(uint32(sender.ShortID()), blames[0].BlameNodeID)
+	s.Equal(received, blames[0].PacketEvidence)
+}
+
+func (s *communicatorSuite) TestExchangePhase2InconsistentBitSetsNotSignedByNode() {
+	s.cryptoServMock.VerifyFunc = func(p crypto.PublicKey, p1 insolar.Signature, p2 []byte) (r bool) {
+		return false
+	}
+	sender := makeRandomNode()
+	state, _ := s.exchangeInconsistentBitSets(sender)
+	s.False(state.Violations.Contains(sender.ID()))
+	s.Empty(state.Violations.Blames())
+}
+
 func TestNaiveCommunicator(t *testing.T) {
 	suite.Run(t, NewSuite())
 }
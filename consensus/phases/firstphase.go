@@ -53,6 +53,7 @@ package phases
 import (
 	"context"
 	"math"
+	"sort"
 
 	"github.com/insolar/insolar/consensus"
 	"github.com/insolar/insolar/consensus/claimhandler"
@@ -63,6 +64,7 @@ import (
 	"github.com/insolar/insolar/log"
 	"github.com/insolar/insolar/network"
 	"github.com/insolar/insolar/network/merkle"
+	"github.com/insolar/insolar/network/telemetry"
 	"github.com/insolar/insolar/platformpolicy"
 	"github.com/jbenet/go-base58"
 	"github.com/pkg/errors"
@@ -72,14 +74,14 @@ import (
 )
 
 const BFTPercent = 2.0 / 3.0
-const MajorityPercent = 0.5
+const MajorityPercent = consensus.MajorityPercent
 
 func consensusReachedBFT(resultLen, participanstLen int) bool {
 	return consensusReachedWithPercent(resultLen, participanstLen, BFTPercent)
 }
 
 func consensusReachedMajority(resultLen, participanstLen int) bool {
-	return consensusReachedWithPercent(resultLen, participanstLen, MajorityPercent)
+	return consensus.ReachedMajority(resultLen, participanstLen)
 }
 
 func consensusReachedWithPercent(resultLen, participanstLen int, percent float64) bool {
@@ -96,10 +98,14 @@ func NewFirstPhase() FirstPhase {
 }
 
 type FirstPhaseImpl struct {
-	Calculator   merkle.Calculator           `inject:""`
-	Communicator Communicator                `inject:""`
-	Cryptography insolar.CryptographyService `inject:""`
-	NodeKeeper   network.NodeKeeper          `inject:""`
+	Calculator       merkle.Calculator           `inject:""`
+	Communicator     Communicator                `inject:""`
+	Cryptography     insolar.CryptographyService `inject:""`
+	NodeKeeper       network.NodeKeeper          `inject:""`
+	FeatureActivator network.FeatureActivator    `inject:""`
+
+	// capabilityOffset is an index of the first supported feature to advertise in the next Phase1Packet
+	capabilityOffset int
 }
 
 // Execute do first phase
@@ -123,6 +129,8 @@ func (fp *FirstPhaseImpl) Execute(ctx context.Context, pulse *insolar.Pulse) (*F
 		return nil, errors.Wrap(err, "[ NET Consensus phase-1 ] Failed to calculate pulse proof")
 	}
 
+	state.PulseHash = pulseHash
+
 	packet := packets.NewPhase1Packet(*pulse)
 	err = packet.SetPulseProof(pulseProof.StateHash, pulseProof.Signature.Bytes())
 	if err != nil {
@@ -156,10 +164,13 @@ func (fp *FirstPhaseImpl) Execute(ctx context.Context, pulse *insolar.Pulse) (*F
 		_ = fp.NodeKeeper.GetClaimQueue().Pop()
 		log.Debugf("[ NET Consensus phase-1 ] Added claim %s to Phase1Packet", claim.Type())
 	}
+	if !state.ConsensusInfo.IsJoiner() {
+		fp.addCapabilityClaims(ctx, packet)
+	}
 	log.Infof("[ NET Consensus phase-1 ] Phase1Packet claims count: %d", len(packet.GetClaims()))
 
 	activeNodes := fp.NodeKeeper.GetAccessor().GetActiveNodes()
-	resultPackets, err := fp.Communicator.ExchangePhase1(ctx, originClaim, activeNodes, packet)
+	resultPackets, err := fp.Communicator.ExchangePhase1(ctx, state, originClaim, activeNodes, packet)
 	if err != nil {
 		return nil, errors.Wrap(err, "[ NET Consensus phase-1 ] Failed to exchange results")
 	}
@@ -171,6 +182,9 @@ func (fp *FirstPhaseImpl) Execute(ctx context.Context, pulse *insolar.Pulse) (*F
 	} else {
 		logger.Infof("[ NET Consensus phase-1 ] received packets: %d/%d", len(resultPackets), len(activeNodes))
 	}
+	for ref := range resultPackets {
+		telemetry.FromContext(ctx).PacketReceived(telemetry.Phase1, ref)
+	}
 	err = stats.RecordWithTags(ctx, []tag.Mutator{tag.Upsert(consensus.TagPhase, "phase 1")}, consensus.PacketsRecv.M(int64(len(resultPackets))))
 	if err != nil {
 		logger.Warn("[ NET Consensus phase-1 ] Failed to record received packets metric: " + err.Error())
@@ -188,6 +202,10 @@ func (fp *FirstPhaseImpl) Execute(ctx context.Context, pulse *insolar.Pulse) (*F
 			logger.Warnf("[ NET Consensus phase-1 ] Failed to check phase1 packet signature from %s: %s", ref, err.Error())
 			continue
 		}
+		if state.Violations.Contains(ref) {
+			logger.Warnf("[ NET Consensus phase-1 ] Ignore phase1 packet from violator %s", ref)
+			continue
+		}
 		rawProof := packet.GetPulseProof()
 		rawProofs[ref] = rawProof
 		proofSet[ref] = &merkle.PulseProof{
@@ -276,6 +294,47 @@ func getNodeState(node insolar.NetworkNode, pulseNumber insolar.PulseNumber) pac
 	return state
 }
 
+// addCapabilityClaims advertises features supported by the origin node and hands activation pulses of
+// features activated by the network. Claims that do not fit into the packet are advertised during the next pulses.
+func (fp *FirstPhaseImpl) addCapabilityClaims(ctx context.Context, packet *packets.Phase1Packet) {
+	claims := fp.capabilityClaims(ctx)
+	for i := 0; i < len(claims); i++ {
+		if !packet.AddClaim(claims[(fp.capabilityOffset+i)%len(claims)]) {
+			fp.capabilityOffset = (fp.capabilityOffset + i) % len(claims)
+			return
+		}
+	}
+}
+
+func (fp *FirstPhaseImpl) capabilityClaims(ctx context.Context) []*packets.CapabilityPoolingAndActivation {
+	activated := fp.FeatureActivator.ActivatedFeatures()
+	flags := make(map[string]uint16)
+	for _, key := range fp.FeatureActivator.SupportedFeatures() {
+		flags[key] |= packets.CapabilityFlagSupported
+	}
+	for key := range activated {
+		flags[key] |= packets.CapabilityFlagActivated
+	}
+	keys := make([]string, 0, len(flags))
+	for key := range flags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	result := make([]*packets.CapabilityPoolingAndActivation, 0, len(keys))
+	for _, key := range keys {
+		claim, err := packets.NewFeatureCapability(key)
+		if err != nil {
+			inslogger.FromContext(ctx).Warnf("[ NET Consensus phase-1 ] Failed to create capability claim for feature %s: %s", key, err)
+			continue
+		}
+		claim.PollingFlags = flags[key]
+		claim.ActivationPulse = activated[key]
+		result = append(result, claim)
+	}
+	return result
+}
+
 func (fp *FirstPhaseImpl) checkPacketSignature(state *ConsensusState, packet *packets.Phase1Packet, recordRef insolar.Reference) error {
 	if state.ConsensusInfo.IsJoiner() {
 		return fp.checkPacketSignatureFromClaim(packet, recordRef)
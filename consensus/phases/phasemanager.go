@@ -52,13 +52,16 @@ package phases
 
 import (
 	"context"
+	"sort"
 	"sync"
 	"time"
 
+	"github.com/insolar/insolar/consensus/packets"
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/network"
 	"github.com/insolar/insolar/network/merkle"
+	"github.com/insolar/insolar/network/telemetry"
 	"github.com/pkg/errors"
 )
 
@@ -66,22 +69,48 @@ type PhaseManager interface {
 	OnPulse(ctx context.Context, pulse *insolar.Pulse, pulseStartTime time.Time) error
 }
 
+// Clock is a source of time for phase deadlines.
+type Clock interface {
+	// Now returns current time.
+	Now() time.Time
+	// WithTimeout returns a copy of ctx that is done when timeout elapses by this clock.
+	WithTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc)
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) WithTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, timeout)
+}
+
 type Phases struct {
 	FirstPhase  FirstPhase  `inject:""`
 	SecondPhase SecondPhase `inject:""`
 	ThirdPhase  ThirdPhase  `inject:""`
 
-	PulseManager insolar.PulseManager `inject:""`
-	NodeKeeper   network.NodeKeeper   `inject:""`
-	Calculator   merkle.Calculator    `inject:""`
+	PulseManager     insolar.PulseManager     `inject:""`
+	NodeKeeper       network.NodeKeeper       `inject:""`
+	Calculator       merkle.Calculator        `inject:""`
+	FeatureActivator network.FeatureActivator `inject:""`
+	Telemetry        telemetry.Recorder       `inject:""`
 
+	clock     Clock
 	lastPulse insolar.PulseNumber
 	lock      sync.Mutex
 }
 
 // NewPhaseManager creates and returns a new phase manager.
 func NewPhaseManager() PhaseManager {
-	return &Phases{}
+	return NewPhaseManagerWithClock(realClock{})
+}
+
+// NewPhaseManagerWithClock creates phase manager that measures consensus delay and phase deadlines by clock.
+func NewPhaseManagerWithClock(clock Clock) PhaseManager {
+	return &Phases{clock: clock}
 }
 
 // OnPulse starts calculate args on phases.
@@ -89,15 +118,27 @@ func (pm *Phases) OnPulse(ctx context.Context, pulse *insolar.Pulse, pulseStartT
 	pm.lock.Lock()
 	defer pm.lock.Unlock()
 
-	var err error
-
 	// workaround for occasional race condition when multiple consensus processes are spawned for one pulse
 	if pulse.PulseNumber <= pm.lastPulse {
 		return nil
 	}
 	pm.lastPulse = pulse.PulseNumber
 
-	consensusDelay := time.Since(pulseStartTime)
+	participants := make([]insolar.Reference, 0)
+	for _, n := range pm.NodeKeeper.GetAccessor().GetActiveNodes() {
+		participants = append(participants, n.ID())
+	}
+	round := telemetry.NewRound(pulse.PulseNumber, pm.NodeKeeper.GetOrigin().ID(), participants)
+	err := pm.runConsensus(telemetry.WithRound(ctx, round), pulse, pulseStartTime)
+	pm.Telemetry.Record(ctx, round.Finish(err))
+	return err
+}
+
+func (pm *Phases) runConsensus(ctx context.Context, pulse *insolar.Pulse, pulseStartTime time.Time) error {
+	var err error
+	round := telemetry.FromContext(ctx)
+
+	consensusDelay := pm.clock.Now().Sub(pulseStartTime)
 	inslogger.FromContext(ctx).Infof("[ NET Consensus ] Starting consensus process, delay: %v", consensusDelay)
 
 	pulseDuration, err := getPulseDuration(pulse)
@@ -108,37 +149,46 @@ func (pm *Phases) OnPulse(ctx context.Context, pulse *insolar.Pulse, pulseStartT
 	var tctx context.Context
 	var cancel context.CancelFunc
 
-	tctx, cancel, err = contextTimeoutWithDelay(ctx, *pulseDuration, consensusDelay, 0.3)
+	tctx, cancel, err = pm.contextTimeoutWithDelay(ctx, *pulseDuration, consensusDelay, 0.3)
 	if err != nil {
 		return err
 	}
 	defer cancel()
 
+	round.StartPhase(telemetry.Phase1, pm.timeoutOf(tctx))
 	firstPhaseState, err := pm.FirstPhase.Execute(tctx, pulse)
+	round.EndPhase(telemetry.Phase1, err)
 	if err != nil {
 		return errors.Wrap(err, "[ NET Consensus ] Error executing phase 1")
 	}
+	defer pm.reportViolations(ctx, firstPhaseState.Violations)
 
-	tctx, cancel = contextTimeout(ctx, *pulseDuration, 0.05)
+	tctx, cancel = pm.contextTimeout(ctx, *pulseDuration, 0.05)
 	defer cancel()
 
+	round.StartPhase(telemetry.Phase2, pm.timeoutOf(tctx))
 	secondPhaseState, err := pm.SecondPhase.Execute(tctx, pulse, firstPhaseState)
+	round.EndPhase(telemetry.Phase2, err)
 	if err != nil {
 		return errors.Wrap(err, "[ NET Consensus ] Error executing phase 2.0")
 	}
 
-	tctx, cancel = contextTimeout(ctx, *pulseDuration, 0.05)
+	tctx, cancel = pm.contextTimeout(ctx, *pulseDuration, 0.05)
 	defer cancel()
 
+	round.StartPhase(telemetry.Phase21, pm.timeoutOf(tctx))
 	secondPhaseState, err = pm.SecondPhase.Execute21(tctx, pulse, secondPhaseState)
+	round.EndPhase(telemetry.Phase21, err)
 	if err != nil {
 		return errors.Wrap(err, "[ NET Consensus ] Error executing phase 2.1")
 	}
 
-	tctx, cancel = contextTimeout(ctx, *pulseDuration, 0.05)
+	tctx, cancel = pm.contextTimeout(ctx, *pulseDuration, 0.05)
 	defer cancel()
 
+	round.StartPhase(telemetry.Phase3, pm.timeoutOf(tctx))
 	thirdPhaseState, err := pm.ThirdPhase.Execute(tctx, pulse, secondPhaseState)
+	round.EndPhase(telemetry.Phase3, err)
 	if err != nil {
 		return errors.Wrap(err, "[ NET Consensus ] Error executing phase 3")
 	}
@@ -153,6 +203,8 @@ func (pm *Phases) OnPulse(ctx context.Context, pulse *insolar.Pulse, pulseStartT
 		return errors.Wrap(err, "[ NET Consensus ] Error calculating cloud hash")
 	}
 	pm.NodeKeeper.SetCloudHash(hash)
+	pm.activateFeatures(ctx, pulse.PulseNumber, state)
+	round.SetResult(len(state.ActiveNodes), len(state.ApprovedClaims))
 	return pm.NodeKeeper.Sync(ctx, state.ActiveNodes, state.ApprovedClaims)
 }
 
@@ -161,17 +213,75 @@ func getPulseDuration(pulse *insolar.Pulse) (*time.Duration, error) {
 	return &duration, nil
 }
 
-func contextTimeout(ctx context.Context, duration time.Duration, k float64) (context.Context, context.CancelFunc) {
+func (pm *Phases) timeoutOf(ctx context.Context) time.Duration {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return 0
+	}
+	return deadline.Sub(pm.clock.Now())
+}
+
+func (pm *Phases) contextTimeout(ctx context.Context, duration time.Duration, k float64) (context.Context, context.CancelFunc) {
 	timeout := time.Duration(k * float64(duration))
-	timedCtx, cancelFund := context.WithTimeout(ctx, timeout)
+	timedCtx, cancelFund := pm.clock.WithTimeout(ctx, timeout)
 	return timedCtx, cancelFund
 }
 
-func contextTimeoutWithDelay(ctx context.Context, duration, delay time.Duration, k float64) (context.Context, context.CancelFunc, error) {
+func (pm *Phases) contextTimeoutWithDelay(ctx context.Context, duration, delay time.Duration, k float64) (context.Context, context.CancelFunc, error) {
 	timeout := time.Duration(k*float64(duration)) - delay
 	if timeout < 0 {
 		return nil, nil, errors.New("[ NET Consensus ] Not enough time for consensus process")
 	}
-	timedCtx, cancelFund := context.WithTimeout(ctx, timeout)
+	timedCtx, cancelFund := pm.clock.WithTimeout(ctx, timeout)
 	return timedCtx, cancelFund, nil
 }
+
+// reportViolations schedules blame claims for nodes that misbehaved during consensus to the next pulse.
+func (pm *Phases) reportViolations(ctx context.Context, violations *Violations) {
+	for _, blame := range violations.Blames() {
+		inslogger.FromContext(ctx).Warnf("[ NET Consensus ] Blame node %d for violation %d",
+			blame.BlameNodeID, blame.TypeViolation)
+		pm.NodeKeeper.GetClaimQueue().Push(blame)
+	}
+}
+
+// activateFeatures passes features advertised by active nodes with capability claims and activation pulses
+// reported by them to FeatureActivator.
+func (pm *Phases) activateFeatures(ctx context.Context, pulseNumber insolar.PulseNumber, state *ThirdPhaseState) {
+	active := make([]insolar.Reference, 0, len(state.ActiveNodes))
+	for _, n := range state.ActiveNodes {
+		active = append(active, n.ID())
+	}
+
+	advertised := make(map[string][]insolar.Reference)
+	reported := make(map[string]map[insolar.Reference]insolar.PulseNumber)
+	for _, claim := range state.ApprovedClaims {
+		capability, ok := claim.(*packets.CapabilityPoolingAndActivation)
+		if !ok {
+			continue
+		}
+		key := capability.GetFeatureKey()
+		if key == "" {
+			continue
+		}
+		if capability.PollingFlags&packets.CapabilityFlagSupported != 0 {
+			advertised[key] = append(advertised[key], capability.NodeID)
+		}
+		if capability.PollingFlags&packets.CapabilityFlagActivated != 0 {
+			if reported[key] == nil {
+				reported[key] = make(map[insolar.Reference]insolar.PulseNumber)
+			}
+			reported[key][capability.NodeID] = capability.ActivationPulse
+		}
+	}
+
+	activated := pm.FeatureActivator.ProcessCapabilities(pulseNumber, active, advertised, reported)
+	keys := make([]string, 0, len(activated))
+	for key := range activated {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		inslogger.FromContext(ctx).Infof("[ NET Consensus ] Feature %s is activated at pulse %d", key, activated[key])
+	}
+}
@@ -62,6 +62,7 @@ import (
 	"github.com/insolar/insolar/instrumentation/instracer"
 	"github.com/insolar/insolar/network"
 	"github.com/insolar/insolar/network/merkle"
+	"github.com/insolar/insolar/network/telemetry"
 	"github.com/pkg/errors"
 	"go.opencensus.io/stats"
 	"go.opencensus.io/tag"
@@ -112,13 +113,17 @@ func (sp *SecondPhaseImpl) Execute(ctx context.Context, pulse *insolar.Pulse, st
 	packet.SetBitSet(state.BitSet)
 	participants := getPhase2Receivers(state.ValidProofs)
 
-	packets, err := sp.Communicator.ExchangePhase2(ctx, state.ConsensusState, participants, packet)
+	responses, err := sp.Communicator.ExchangePhase2(ctx, state.ConsensusState, participants, packet)
 	if err != nil {
 		return nil, errors.Wrap(err, "[ NET Consensus phase-2.0 ] Failed to exchange packets")
 	}
 	logger.Infof("[ NET Consensus phase-2.0 ] Received responses: %d/%d",
-		len(packets), state.BitsetMapper.Length())
-	err = stats.RecordWithTags(ctx, []tag.Mutator{tag.Upsert(consensus.TagPhase, "phase 2")}, consensus.PacketsRecv.M(int64(len(packets))))
+		len(responses), state.BitsetMapper.Length())
+	for ref := range responses {
+		telemetry.FromContext(ctx).PacketReceived(telemetry.Phase2, ref)
+	}
+	recordBitSet(ctx, telemetry.Phase2, state.BitSet, state.BitsetMapper)
+	err = stats.RecordWithTags(ctx, []tag.Mutator{tag.Upsert(consensus.TagPhase, "phase 2")}, consensus.PacketsRecv.M(int64(len(responses))))
 	if err != nil {
 		logger.Warn("[ NET Consensus phase-2.0 ] Failed to record received packets metric: " + err.Error())
 	}
@@ -126,7 +131,7 @@ func (sp *SecondPhaseImpl) Execute(ctx context.Context, pulse *insolar.Pulse, st
 	origin := sp.NodeKeeper.GetOrigin().ID()
 	stateMatrix := NewStateMatrix(state.BitsetMapper)
 
-	for ref, packet := range packets {
+	for ref, packet := range responses {
 		err = nil
 		if !ref.Equal(origin) {
 			err = sp.checkPacketSignature(packet, ref, state.NodesMutator)
@@ -299,6 +304,10 @@ func (sp *SecondPhaseImpl) Execute21(ctx context.Context, pulse *insolar.Pulse,
 		if list == nil {
 			list = make([]packets.ReferendumClaim, 0)
 		}
+		supClaim, ok := claim.Claim.(packets.ClaimSupplementary)
+		if ok {
+			supClaim.AddSupplementaryInfo(ref)
+		}
 		list = append(list, claim.Claim)
 		claimMap[ref] = list
 	}
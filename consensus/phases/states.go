@@ -95,6 +95,9 @@ type ConsensusState struct {
 	HashStorage   *HashStorage
 	BitsetMapper  *BitsetMapper
 	ClaimHandler  *claimhandler.ClaimHandler
+	Violations    *Violations
+	// PulseHash is a hash of the current pulse phase1 pulse proofs are signed for
+	PulseHash merkle.OriginHash
 }
 
 func NewConsensusState(consensusInfo network.ConsensusInfo, snapshot *node.Snapshot) *ConsensusState {
@@ -102,5 +105,6 @@ func NewConsensusState(consensusInfo network.ConsensusInfo, snapshot *node.Snaps
 		ConsensusInfo: consensusInfo,
 		NodesMutator:  node.NewMutator(snapshot),
 		HashStorage:   NewHashStorage(),
+		Violations:    NewViolations(),
 	}
 }
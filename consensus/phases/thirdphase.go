@@ -61,6 +61,7 @@ import (
 	"github.com/insolar/insolar/log"
 	"github.com/insolar/insolar/network"
 	"github.com/insolar/insolar/network/merkle"
+	"github.com/insolar/insolar/network/telemetry"
 	"github.com/pkg/errors"
 	"go.opencensus.io/stats"
 	"go.opencensus.io/tag"
@@ -104,6 +105,10 @@ func (tp *ThirdPhaseImpl) Execute(ctx context.Context, pulse *insolar.Pulse, sta
 		return nil, errors.Wrap(err, "[ NET Consensus phase-3 ] Failed to exchange packets")
 	}
 	logger.Infof("[ NET Consensus phase-3 ] received responses: %d/%d", len(responses), totalCount)
+	for ref := range responses {
+		telemetry.FromContext(ctx).PacketReceived(telemetry.Phase3, ref)
+	}
+	recordBitSet(ctx, telemetry.Phase3, state.BitSet, state.BitsetMapper)
 	err = stats.RecordWithTags(ctx, []tag.Mutator{tag.Upsert(consensus.TagPhase, "phase 3")}, consensus.PacketsRecv.M(int64(len(responses))))
 	if err != nil {
 		logger.Warn("[ NET Consensus phase-3 ] Failed to record received responses metric: " + err.Error())
@@ -22,6 +22,7 @@ import (
 	"encoding/gob"
 
 	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/message"
 	"github.com/pkg/errors"
 )
 
@@ -90,6 +91,12 @@ func (t *GetCodeRedirectToken) Verify(parcel insolar.Parcel) (bool, error) {
 }
 
 func init() {
+	message.RegisterToken(insolar.DTTypePendingExecution, func() insolar.DelegationToken { return &PendingExecutionToken{} })
+	message.RegisterToken(insolar.DTTypeGetObjectRedirect, func() insolar.DelegationToken { return &GetObjectRedirectToken{} })
+	message.RegisterToken(insolar.DTTypeGetChildrenRedirect, func() insolar.DelegationToken { return &GetChildrenRedirectToken{} })
+	message.RegisterToken(insolar.DTTypeGetCodeRedirect, func() insolar.DelegationToken { return &GetCodeRedirectToken{} })
+
+	// Tokens are still carried by gob-encoded replies.
 	gob.Register(&PendingExecutionToken{})
 	gob.Register(&GetObjectRedirectToken{})
 	gob.Register(&GetChildrenRedirectToken{})
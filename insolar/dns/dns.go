@@ -18,21 +18,52 @@ package dns
 
 import (
 	"net"
-	"strings"
+
+	"github.com/pkg/errors"
 )
 
 // GetIPFromDomain returns IP address string from domain.
+// Domain may contain port, IPv6 addresses are returned in square brackets in that case.
 func GetIPFromDomain(domain string) (string, error) {
-	woPort := strings.Split(domain, ":")
-	address := woPort[0]
-	var port string
-	if len(woPort) > 1 {
-		port = ":" + woPort[1]
+	ips, err := GetIPsFromDomain(domain)
+	if err != nil {
+		return "", err
+	}
+	return ips[0], nil
+}
+
+// GetIPsFromDomain returns all IP address strings resolved from domain, IPv4 addresses go first.
+func GetIPsFromDomain(domain string) ([]string, error) {
+	host, port, err := net.SplitHostPort(domain)
+	if err != nil {
+		host, port = domain, ""
 	}
 
-	ips, err := net.LookupIP(address)
+	ips, err := net.LookupIP(host)
 	if err != nil {
-		return "", err
+		return nil, errors.Wrap(err, "[ GetIPsFromDomain ] Failed to lookup IP")
+	}
+	if len(ips) == 0 {
+		return nil, errors.New("[ GetIPsFromDomain ] No IP addresses found for " + host)
+	}
+
+	result := make([]string, 0, len(ips))
+	for _, ip := range ips {
+		if ip.To4() != nil {
+			result = append(result, formatIP(ip, port))
+		}
+	}
+	for _, ip := range ips {
+		if ip.To4() == nil {
+			result = append(result, formatIP(ip, port))
+		}
+	}
+	return result, nil
+}
+
+func formatIP(ip net.IP, port string) string {
+	if port == "" {
+		return ip.String()
 	}
-	return ips[0].String() + port, nil
+	return net.JoinHostPort(ip.String(), port)
 }
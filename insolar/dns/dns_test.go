@@ -0,0 +1,51 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package dns
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetIPFromDomain(t *testing.T) {
+	ip, err := GetIPFromDomain("127.0.0.1:13831")
+	require.NoError(t, err)
+	assert.Equal(t, "127.0.0.1:13831", ip)
+
+	ip, err = GetIPFromDomain("127.0.0.1")
+	require.NoError(t, err)
+	assert.Equal(t, "127.0.0.1", ip)
+
+	ip, err = GetIPFromDomain("[::1]:13831")
+	require.NoError(t, err)
+	assert.Equal(t, "[::1]:13831", ip)
+
+	ip, err = GetIPFromDomain("::1")
+	require.NoError(t, err)
+	assert.Equal(t, "::1", ip)
+}
+
+func TestGetIPsFromDomain(t *testing.T) {
+	ips, err := GetIPsFromDomain("localhost:13831")
+	require.NoError(t, err)
+	require.NotEmpty(t, ips)
+	for _, ip := range ips {
+		assert.Contains(t, []string{"127.0.0.1:13831", "[::1]:13831"}, ip)
+	}
+}
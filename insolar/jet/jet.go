@@ -34,6 +34,7 @@ type Accessor interface {
 type Modifier interface {
 	Update(ctx context.Context, pulse insolar.PulseNumber, actual bool, ids ...insolar.JetID)
 	Split(ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID) (insolar.JetID, insolar.JetID, error)
+	Join(ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID) (insolar.JetID, error)
 	Clone(ctx context.Context, from, to insolar.PulseNumber)
 	Delete(ctx context.Context, pulse insolar.PulseNumber)
 }
@@ -56,6 +57,30 @@ func Parent(id insolar.JetID) insolar.JetID {
 	return *insolar.NewJetID(depth-1, resetBits(prefix, depth-1))
 }
 
+// Sibling returns a jet with the same parent as provided one or jet itself if depth of provided JetID is zero.
+func Sibling(id insolar.JetID) insolar.JetID {
+	depth, prefix := id.Depth(), id.Prefix()
+	if depth == 0 {
+		return id
+	}
+
+	siblingPrefix := resetBits(prefix, depth-1)
+	if !getBit(prefix, depth-1) {
+		setBit(siblingPrefix, depth-1)
+	}
+	return *insolar.NewJetID(depth, siblingPrefix)
+}
+
+// Children returns jets the provided jet is split into.
+func Children(id insolar.JetID) (insolar.JetID, insolar.JetID) {
+	depth, prefix := id.Depth(), id.Prefix()
+
+	leftPrefix := resetBits(prefix, depth)
+	rightPrefix := resetBits(prefix, depth)
+	setBit(rightPrefix, depth)
+	return *insolar.NewJetID(depth+1, leftPrefix), *insolar.NewJetID(depth+1, rightPrefix)
+}
+
 // resetBits returns a new byte slice with all bits in 'value' reset,
 // starting from 'start' number of bit.
 //
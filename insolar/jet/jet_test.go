@@ -38,6 +38,24 @@ func TestJet_Parent(t *testing.T) {
 	require.Equal(t, emptyChild, emptyParent, "for empty jet ID, got the same parent")
 }
 
+func TestJet_Sibling(t *testing.T) {
+	require.Equal(t, NewIDFromString("010100"), Sibling(NewIDFromString("010101")))
+	require.Equal(t, NewIDFromString("010101"), Sibling(NewIDFromString("010100")))
+
+	root := *insolar.NewJetID(0, nil)
+	require.Equal(t, root, Sibling(root), "for empty jet ID, got the same sibling")
+}
+
+func TestJet_Children(t *testing.T) {
+	left, right := Children(NewIDFromString("0101"))
+	require.Equal(t, NewIDFromString("01010"), left)
+	require.Equal(t, NewIDFromString("01011"), right)
+
+	left, right = Children(*insolar.NewJetID(0, nil))
+	require.Equal(t, NewIDFromString("0"), left)
+	require.Equal(t, NewIDFromString("1"), right)
+}
+
 func TestJet_ResetBits(t *testing.T) {
 	orig := []byte{0xFF}
 	got := resetBits(orig, 5)
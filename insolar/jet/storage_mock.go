@@ -40,6 +40,11 @@ type StorageMock struct {
 	ForIDPreCounter uint64
 	ForIDMock       mStorageMockForID
 
+	JoinFunc       func(p context.Context, p1 insolar.PulseNumber, p2 insolar.JetID) (r insolar.JetID, r1 error)
+	JoinCounter    uint64
+	JoinPreCounter uint64
+	JoinMock       mStorageMockJoin
+
 	SplitFunc       func(p context.Context, p1 insolar.PulseNumber, p2 insolar.JetID) (r insolar.JetID, r1 insolar.JetID, r2 error)
 	SplitCounter    uint64
 	SplitPreCounter uint64
@@ -63,6 +68,7 @@ func NewStorageMock(t minimock.Tester) *StorageMock {
 	m.CloneMock = mStorageMockClone{mock: m}
 	m.DeleteMock = mStorageMockDelete{mock: m}
 	m.ForIDMock = mStorageMockForID{mock: m}
+	m.JoinMock = mStorageMockJoin{mock: m}
 	m.SplitMock = mStorageMockSplit{mock: m}
 	m.UpdateMock = mStorageMockUpdate{mock: m}
 
@@ -618,6 +624,158 @@ func (m *StorageMock) ForIDFinished() bool {
 	return true
 }
 
+type mStorageMockJoin struct {
+	mock              *StorageMock
+	mainExpectation   *StorageMockJoinExpectation
+	expectationSeries []*StorageMockJoinExpectation
+}
+
+type StorageMockJoinExpectation struct {
+	input  *StorageMockJoinInput
+	result *StorageMockJoinResult
+}
+
+type StorageMockJoinInput struct {
+	p  context.Context
+	p1 insolar.PulseNumber
+	p2 insolar.JetID
+}
+
+type StorageMockJoinResult struct {
+	r  insolar.JetID
+	r1 error
+}
+
+//Expect specifies that invocation of Storage.Join is expected from 1 to Infinity times
+func (m *mStorageMockJoin) Expect(p context.Context, p1 insolar.PulseNumber, p2 insolar.JetID) *mStorageMockJoin {
+	m.mock.JoinFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &StorageMockJoinExpectation{}
+	}
+	m.mainExpectation.input = &StorageMockJoinInput{p, p1, p2}
+	return m
+}
+
+//Return specifies results of invocation of Storage.Join
+func (m *mStorageMockJoin) Return(r insolar.JetID, r1 error) *StorageMock {
+	m.mock.JoinFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &StorageMockJoinExpectation{}
+	}
+	m.mainExpectation.result = &StorageMockJoinResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Storage.Join is expected once
+func (m *mStorageMockJoin) ExpectOnce(p context.Context, p1 insolar.PulseNumber, p2 insolar.JetID) *StorageMockJoinExpectation {
+	m.mock.JoinFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &StorageMockJoinExpectation{}
+	expectation.input = &StorageMockJoinInput{p, p1, p2}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *StorageMockJoinExpectation) Return(r insolar.JetID, r1 error) {
+	e.result = &StorageMockJoinResult{r, r1}
+}
+
+//Set uses given function f as a mock of Storage.Join method
+func (m *mStorageMockJoin) Set(f func(p context.Context, p1 insolar.PulseNumber, p2 insolar.JetID) (r insolar.JetID, r1 error)) *StorageMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.JoinFunc = f
+	return m.mock
+}
+
+//Join implements github.com/insolar/insolar/insolar/jet.Storage interface
+func (m *StorageMock) Join(p context.Context, p1 insolar.PulseNumber, p2 insolar.JetID) (r insolar.JetID, r1 error) {
+	counter := atomic.AddUint64(&m.JoinPreCounter, 1)
+	defer atomic.AddUint64(&m.JoinCounter, 1)
+
+	if len(m.JoinMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.JoinMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to StorageMock.Join. %v %v %v", p, p1, p2)
+			return
+		}
+
+		input := m.JoinMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, StorageMockJoinInput{p, p1, p2}, "Storage.Join got unexpected parameters")
+
+		result := m.JoinMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the StorageMock.Join")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.JoinMock.mainExpectation != nil {
+
+		input := m.JoinMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, StorageMockJoinInput{p, p1, p2}, "Storage.Join got unexpected parameters")
+		}
+
+		result := m.JoinMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the StorageMock.Join")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.JoinFunc == nil {
+		m.t.Fatalf("Unexpected call to StorageMock.Join. %v %v %v", p, p1, p2)
+		return
+	}
+
+	return m.JoinFunc(p, p1, p2)
+}
+
+//JoinMinimockCounter returns a count of StorageMock.JoinFunc invocations
+func (m *StorageMock) JoinMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.JoinCounter)
+}
+
+//JoinMinimockPreCounter returns the value of StorageMock.Join invocations
+func (m *StorageMock) JoinMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.JoinPreCounter)
+}
+
+//JoinFinished returns true if mock invocations count is ok
+func (m *StorageMock) JoinFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.JoinMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.JoinCounter) == uint64(len(m.JoinMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.JoinMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.JoinCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.JoinFunc != nil {
+		return atomic.LoadUint64(&m.JoinCounter) > 0
+	}
+
+	return true
+}
+
 type mStorageMockSplit struct {
 	mock              *StorageMock
 	mainExpectation   *StorageMockSplitExpectation
@@ -919,6 +1077,10 @@ func (m *StorageMock) ValidateCallCounters() {
 		m.t.Fatal("Expected call to StorageMock.ForID")
 	}
 
+	if !m.JoinFinished() {
+		m.t.Fatal("Expected call to StorageMock.Join")
+	}
+
 	if !m.SplitFinished() {
 		m.t.Fatal("Expected call to StorageMock.Split")
 	}
@@ -960,6 +1122,10 @@ func (m *StorageMock) MinimockFinish() {
 		m.t.Fatal("Expected call to StorageMock.ForID")
 	}
 
+	if !m.JoinFinished() {
+		m.t.Fatal("Expected call to StorageMock.Join")
+	}
+
 	if !m.SplitFinished() {
 		m.t.Fatal("Expected call to StorageMock.Split")
 	}
@@ -986,6 +1152,7 @@ func (m *StorageMock) MinimockWait(timeout time.Duration) {
 		ok = ok && m.CloneFinished()
 		ok = ok && m.DeleteFinished()
 		ok = ok && m.ForIDFinished()
+		ok = ok && m.JoinFinished()
 		ok = ok && m.SplitFinished()
 		ok = ok && m.UpdateFinished()
 
@@ -1012,6 +1179,10 @@ func (m *StorageMock) MinimockWait(timeout time.Duration) {
 				m.t.Error("Expected call to StorageMock.ForID")
 			}
 
+			if !m.JoinFinished() {
+				m.t.Error("Expected call to StorageMock.Join")
+			}
+
 			if !m.SplitFinished() {
 				m.t.Error("Expected call to StorageMock.Split")
 			}
@@ -1048,6 +1219,10 @@ func (m *StorageMock) AllMocksCalled() bool {
 		return false
 	}
 
+	if !m.JoinFinished() {
+		return false
+	}
+
 	if !m.SplitFinished() {
 		return false
 	}
@@ -63,6 +63,12 @@ func (lt *lockedTree) split(id insolar.JetID) (insolar.JetID, insolar.JetID, err
 	return lt.t.Split(id)
 }
 
+func (lt *lockedTree) join(id insolar.JetID) (insolar.JetID, error) {
+	lt.Lock()
+	defer lt.Unlock()
+	return lt.t.Join(id)
+}
+
 // Store stores jet trees per pulse.
 // It provides methods for querying and modification this trees.
 type Store struct {
@@ -113,6 +119,17 @@ func (s *Store) Split(
 	return left, right, nil
 }
 
+// Join merges jet with its sibling and returns resulting parent jet id.
+func (s *Store) Join(
+	ctx context.Context, pulse insolar.PulseNumber, id insolar.JetID,
+) (insolar.JetID, error) {
+	parent, err := s.ltreeForPulse(pulse).join(id)
+	if err != nil {
+		return insolar.ZeroJetID, err
+	}
+	return parent, nil
+}
+
 // Clone copies tree from one pulse to another. Use it to copy past tree into new pulse.
 func (s *Store) Clone(
 	ctx context.Context, from, to insolar.PulseNumber,
@@ -70,6 +70,24 @@ func TestJetStorage_SplitJetTree(t *testing.T) {
 	require.Equal(t, "root (level=0 actual=false)\n 0 (level=1 actual=false)\n 1 (level=1 actual=false)\n", tree.String())
 }
 
+func TestJetStorage_JoinJetTree(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	s := NewStore()
+
+	left, _, err := s.Split(ctx, 100, *insolar.NewJetID(0, nil))
+	require.NoError(t, err)
+
+	parent, err := s.Join(ctx, 100, left)
+	require.NoError(t, err)
+	require.Equal(t, insolar.ZeroJetID, parent)
+
+	tree, _ := treeForPulse(s, 100)
+	require.Equal(t, "root (level=0 actual=false)\n", tree.String())
+
+	_, err = s.Join(ctx, 100, left)
+	require.Error(t, err)
+}
+
 func TestJetStorage_CloneJetTree(t *testing.T) {
 	ctx := inslogger.TestContext(t)
 	s := NewStore()
@@ -48,11 +48,30 @@ func (j *jet) Find(val []byte, depth uint8) (*jet, uint8) {
 	return j, depth
 }
 
+// Get returns jet located exactly at provided depth or nil if there is no such branch.
+func (j *jet) Get(prefix []byte, depth uint8) *jet {
+	cur := j
+	for i := uint8(0); i < depth && cur != nil; i++ {
+		if getBit(prefix, i) {
+			cur = cur.Right
+		} else {
+			cur = cur.Left
+		}
+	}
+	return cur
+}
+
 // Update add missing tree branches for provided prefix.
 func (j *jet) Update(prefix []byte, setActual bool, maxDepth, depth uint8) {
 	if depth == maxDepth {
 		if setActual {
 			j.Actual = true
+			// Actual jet is a leaf in its pulse. Branches cloned from the previous
+			// pulse are dropped if nothing below is actual (jets were joined).
+			if !j.Left.hasActual() && !j.Right.hasActual() {
+				j.Left = nil
+				j.Right = nil
+			}
 		}
 		return
 	}
@@ -84,6 +103,17 @@ func (j *jet) Clone(keep bool) *jet {
 	return res
 }
 
+func (j *jet) isLeaf() bool {
+	return j.Left == nil && j.Right == nil
+}
+
+func (j *jet) hasActual() bool {
+	if j == nil {
+		return false
+	}
+	return j.Actual || j.Left.hasActual() || j.Right.hasActual()
+}
+
 func (j *jet) ExtractLeafIDs(ids *[]insolar.JetID, path []byte, depth uint8) {
 	if j == nil {
 		return
@@ -263,6 +293,28 @@ func (t *Tree) Split(id insolar.JetID) (insolar.JetID, insolar.JetID, error) {
 	return *left, *right, nil
 }
 
+// Join merges provided jet with its sibling and returns their parent, which becomes a leaf.
+// Both jet and its sibling should be leaves, otherwise an error will be returned.
+func (t *Tree) Join(id insolar.JetID) (insolar.JetID, error) {
+	depth := id.Depth()
+	if depth == 0 {
+		return insolar.ZeroJetID, errors.New("failed to join: root jet has no sibling")
+	}
+
+	parent := Parent(id)
+	j := t.Head.Get(parent.Prefix(), depth-1)
+	if j == nil || j.Left == nil || j.Right == nil {
+		return insolar.ZeroJetID, errors.New("failed to join: incorrect jet provided")
+	}
+	if !j.Left.isLeaf() || !j.Right.isLeaf() {
+		return insolar.ZeroJetID, errors.New("failed to join: jet or its sibling is not a leaf")
+	}
+
+	j.Left = nil
+	j.Right = nil
+	return parent, nil
+}
+
 func (t *Tree) LeafIDs() []insolar.JetID {
 	var ids []insolar.JetID
 	t.Head.ExtractLeafIDs(&ids, make([]byte, insolar.RecordHashSize), 0)
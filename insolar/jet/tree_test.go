@@ -134,6 +134,66 @@ func TestTree_Split(t *testing.T) {
 	})
 }
 
+func TestTree_Join(t *testing.T) {
+	newTree := func() *Tree {
+		return &Tree{
+			Head: &jet{
+				Left: &jet{},
+				Right: &jet{
+					Left:  &jet{},
+					Right: &jet{},
+				},
+			},
+		}
+	}
+
+	t.Run("root can't be joined", func(t *testing.T) {
+		_, err := newTree().Join(*insolar.NewJetID(0, nil))
+		assert.Error(t, err)
+	})
+
+	t.Run("not existing jet returns error", func(t *testing.T) {
+		_, err := newTree().Join(NewIDFromString("1010"))
+		assert.Error(t, err)
+	})
+
+	t.Run("sibling is not a leaf", func(t *testing.T) {
+		_, err := newTree().Join(NewIDFromString("0"))
+		assert.Error(t, err)
+	})
+
+	t.Run("joins jet", func(t *testing.T) {
+		tree := newTree()
+		parent, err := tree.Join(NewIDFromString("11"))
+		require.NoError(t, err)
+		assert.Equal(t, NewIDFromString("1"), parent)
+		assert.Equal(t, []insolar.JetID{NewIDFromString("0"), NewIDFromString("1")}, tree.LeafIDs())
+	})
+}
+
+func TestTree_Update_DropsStaleBranches(t *testing.T) {
+	tree := Tree{
+		Head: &jet{
+			Left: &jet{},
+			Right: &jet{
+				Left:  &jet{},
+				Right: &jet{},
+			},
+		},
+	}
+
+	tree.Update(NewIDFromString("1"), false)
+	assert.Len(t, tree.LeafIDs(), 3, "not actual update keeps branches")
+
+	tree.Update(NewIDFromString("1"), true)
+	assert.Equal(t, []insolar.JetID{NewIDFromString("0"), NewIDFromString("1")}, tree.LeafIDs())
+
+	tree.Update(NewIDFromString("00"), true)
+	tree.Update(NewIDFromString("0"), true)
+	assert.Equal(t, []insolar.JetID{NewIDFromString("00"), NewIDFromString("01"), NewIDFromString("1")}, tree.LeafIDs(),
+		"branches with actual jets are kept")
+}
+
 func TestTree_String(t *testing.T) {
 	tree := Tree{
 		Head: &jet{
@@ -101,6 +101,13 @@ func KVSize(kvs []KV) (amount int64) {
 	return
 }
 
+// StorageExporter provides methods for fetching data view from storage.
+//go:generate minimock -i github.com/insolar/insolar/insolar.StorageExporter -o ../testutils -s _mock.go
+type StorageExporter interface {
+	// Export returns data view from storage.
+	Export(ctx context.Context, fromPulse PulseNumber, size int) (*StorageExportResult, error)
+}
+
 // StorageExportResult represents storage data view.
 type StorageExportResult struct {
 	Data     map[string]interface{}
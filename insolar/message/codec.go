@@ -0,0 +1,95 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package message
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/ugorji/go/codec"
+
+	"github.com/insolar/insolar/insolar"
+)
+
+// ErrUnknownType is returned when message or delegation token type is not registered in codec.
+var ErrUnknownType = errors.New("unknown type")
+
+var (
+	messages = map[insolar.MessageType]func() insolar.Message{}
+	tokens   = map[insolar.DelegationTokenType]func() insolar.DelegationToken{}
+)
+
+// Register adds message constructor to codec registry. Registered messages can be serialized and deserialized
+// with Serialize/Deserialize and as parcel payload. Panics if type is already registered.
+func Register(mt insolar.MessageType, constructor func() insolar.Message) {
+	if _, ok := messages[mt]; ok {
+		panic(fmt.Sprintf("message type %s is already registered", mt))
+	}
+	messages[mt] = constructor
+}
+
+// RegisterToken adds delegation token constructor to codec registry. Registered tokens can be carried by parcels.
+// Panics if type is already registered.
+func RegisterToken(tt insolar.DelegationTokenType, constructor func() insolar.DelegationToken) {
+	if _, ok := tokens[tt]; ok {
+		panic(fmt.Sprintf("delegation token type %s is already registered", tt))
+	}
+	tokens[tt] = constructor
+}
+
+func newMessage(mt insolar.MessageType) (insolar.Message, error) {
+	constructor, ok := messages[mt]
+	if !ok {
+		return nil, errors.Wrapf(ErrUnknownType, "message type %s", mt)
+	}
+	return constructor(), nil
+}
+
+func newToken(tt insolar.DelegationTokenType) (insolar.DelegationToken, error) {
+	constructor, ok := tokens[tt]
+	if !ok {
+		return nil, errors.Wrapf(ErrUnknownType, "delegation token type %s", tt)
+	}
+	return constructor(), nil
+}
+
+// cborHandle produces deterministic encoding (map keys are sorted), so equal values always have equal bytes.
+// It's required because serialized parcels are signed and hashed.
+var cborHandle = &codec.CborHandle{
+	BasicHandle: codec.BasicHandle{
+		EncodeOptions: codec.EncodeOptions{Canonical: true},
+	},
+}
+
+func encode(w io.Writer, v interface{}) error {
+	return codec.NewEncoder(w, cborHandle).Encode(v)
+}
+
+func decode(r io.Reader, v interface{}) error {
+	return codec.NewDecoder(r, cborHandle).Decode(v)
+}
+
+func encodeBytes(v interface{}) ([]byte, error) {
+	var buf []byte
+	err := codec.NewEncoderBytes(&buf, cborHandle).Encode(v)
+	return buf, err
+}
+
+func decodeBytes(b []byte, v interface{}) error {
+	return codec.NewDecoderBytes(b, cborHandle).Decode(v)
+}
@@ -0,0 +1,176 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package message
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/google/gofuzz"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+)
+
+// allMessageTypes returns every type declared in insolar.MessageType enum.
+func allMessageTypes() []insolar.MessageType {
+	var types []insolar.MessageType
+	for mt := insolar.MessageType(0); !strings.HasPrefix(mt.String(), "MessageType("); mt++ {
+		types = append(types, mt)
+	}
+	return types
+}
+
+// fuzzer fills messages with random data. Interface fields can't be fuzzed and are left empty.
+func fuzzer() *fuzz.Fuzzer {
+	return fuzz.New().NilChance(0).NumElements(1, 3).Funcs(
+		func(e *ExecutionQueueElement, c fuzz.Continue) {
+			e.Request = &insolar.Reference{}
+			c.Fuzz(e.Request)
+		},
+		func(r *CaseBindRequest, c fuzz.Continue) {
+			c.Fuzz(&r.Request)
+			c.Fuzz(&r.MessageBusTape)
+			c.Fuzz(&r.Error)
+		},
+		func(m *ReturnResults, c fuzz.Continue) {
+			c.Fuzz(&m.Target)
+			c.Fuzz(&m.Caller)
+			c.Fuzz(&m.Sequence)
+			c.Fuzz(&m.Error)
+		},
+		func(m *ValidateRecord, c fuzz.Continue) {
+			c.Fuzz(&m.Object)
+			c.Fuzz(&m.State)
+			c.Fuzz(&m.IsValid)
+		},
+	)
+}
+
+func TestCodec_AllMessageTypesRegistered(t *testing.T) {
+	for _, mt := range allMessageTypes() {
+		msg, err := newMessage(mt)
+		require.NoError(t, err, mt.String())
+		assert.Equal(t, mt, msg.Type(), mt.String())
+	}
+}
+
+func TestCodec_MessageRoundTrip(t *testing.T) {
+	f := fuzzer()
+	for _, mt := range allMessageTypes() {
+		t.Run(mt.String(), func(t *testing.T) {
+			for i := 0; i < 10; i++ {
+				msg, err := newMessage(mt)
+				require.NoError(t, err)
+				f.Fuzz(msg)
+
+				b := ToBytes(msg)
+				decoded, err := Deserialize(bytes.NewReader(b))
+				require.NoError(t, err)
+				assert.Equal(t, msg, decoded)
+				assert.Equal(t, b, ToBytes(decoded), "encoding must be deterministic")
+			}
+		})
+	}
+}
+
+func TestCodec_ParcelRoundTrip(t *testing.T) {
+	f := fuzzer()
+	for _, mt := range allMessageTypes() {
+		t.Run(mt.String(), func(t *testing.T) {
+			msg, err := newMessage(mt)
+			require.NoError(t, err)
+			f.Fuzz(msg)
+			parcel := &Parcel{Msg: msg}
+			f.Fuzz(&parcel.Sender)
+			f.Fuzz(&parcel.Signature)
+			f.Fuzz(&parcel.PulseNumber)
+			f.Fuzz(&parcel.ServiceData)
+
+			b := ParcelToBytes(parcel)
+			decoded, err := DeserializeParcel(bytes.NewReader(b))
+			require.NoError(t, err)
+			assert.Equal(t, parcel, decoded)
+			assert.Equal(t, b, ParcelToBytes(decoded), "encoding must be deterministic")
+		})
+	}
+}
+
+func TestCodec_UnknownMessageType(t *testing.T) {
+	unknown := insolar.MessageType(len(allMessageTypes()))
+
+	_, err := Deserialize(bytes.NewReader([]byte{byte(unknown)}))
+	require.Error(t, err)
+	assert.Equal(t, ErrUnknownType, errors.Cause(err))
+
+	_, err = Serialize(&unknownMessage{SetRecord{}, unknown})
+	require.Error(t, err)
+	assert.Equal(t, ErrUnknownType, errors.Cause(err))
+}
+
+func TestCodec_ParcelWithToken(t *testing.T) {
+	parcel := &Parcel{Msg: &SetRecord{}, Token: &testToken{Kind: registeredTokenType, Signature: []byte{1, 2, 3}}}
+
+	decoded, err := DeserializeParcel(bytes.NewReader(ParcelToBytes(parcel)))
+	require.NoError(t, err)
+	assert.Equal(t, parcel, decoded)
+}
+
+func TestCodec_UnknownTokenType(t *testing.T) {
+	parcel := &Parcel{Msg: &SetRecord{}, Token: &testToken{Kind: registeredTokenType + 1}}
+
+	_, err := SerializeParcel(parcel)
+	require.Error(t, err)
+	assert.Equal(t, ErrUnknownType, errors.Cause(err))
+}
+
+func TestCodec_RegisterDuplicate(t *testing.T) {
+	assert.Panics(t, func() {
+		Register(insolar.TypeSetRecord, func() insolar.Message { return &SetRecord{} })
+	})
+}
+
+type unknownMessage struct {
+	SetRecord
+	mt insolar.MessageType
+}
+
+func (m *unknownMessage) Type() insolar.MessageType {
+	return m.mt
+}
+
+const registeredTokenType = insolar.DelegationTokenType(100)
+
+type testToken struct {
+	Kind      insolar.DelegationTokenType
+	Signature []byte
+}
+
+func (t *testToken) Type() insolar.DelegationTokenType {
+	return t.Kind
+}
+
+func (*testToken) Verify(insolar.Parcel) (bool, error) {
+	return true, nil
+}
+
+func init() {
+	RegisterToken(registeredTokenType, func() insolar.DelegationToken { return &testToken{} })
+}
@@ -378,6 +378,7 @@ type HotData struct {
 	ledgerMessage
 	Jet             insolar.Reference
 	Drop            drop.Drop
+	SiblingDrop     *drop.Drop
 	RecentObjects   map[insolar.ID]HotIndex
 	PendingRequests map[insolar.ID]recentstorage.PendingObjectContext
 	PulseNumber     insolar.PulseNumber
@@ -544,3 +545,65 @@ func (*GetPendingRequestID) DefaultRole() insolar.DynamicRole {
 func (m *GetPendingRequestID) DefaultTarget() *insolar.Reference {
 	return insolar.NewReference(insolar.DomainID, m.ObjectID)
 }
+
+// GetObjectsByPrototype fetches heads of objects activated with provided prototype in pulses from FromPulse to
+// ToPulse inclusive. Secondary indexes of the whole ledger are kept by heavy material node, light material nodes
+// answer for objects of their jets when the message is sent to them directly.
+type GetObjectsByPrototype struct {
+	ledgerMessage
+
+	Prototype insolar.Reference
+	FromPulse insolar.PulseNumber
+	ToPulse   insolar.PulseNumber
+}
+
+// Type implementation of Message interface.
+func (*GetObjectsByPrototype) Type() insolar.MessageType {
+	return insolar.TypeGetObjectsByPrototype
+}
+
+// AllowedSenderObjectAndRole implements interface method
+func (m *GetObjectsByPrototype) AllowedSenderObjectAndRole() (*insolar.Reference, insolar.DynamicRole) {
+	return nil, insolar.DynamicRoleUndefined
+}
+
+// DefaultRole returns role for this event
+func (*GetObjectsByPrototype) DefaultRole() insolar.DynamicRole {
+	return insolar.DynamicRoleHeavyExecutor
+}
+
+// DefaultTarget returns of target of this event.
+func (m *GetObjectsByPrototype) DefaultTarget() *insolar.Reference {
+	return &m.Prototype
+}
+
+// GetObjectsByParent fetches heads of children of provided parent activated in pulses from FromPulse to ToPulse
+// inclusive. Secondary indexes of the whole ledger are kept by heavy material node, light material nodes answer for
+// objects of their jets when the message is sent to them directly.
+type GetObjectsByParent struct {
+	ledgerMessage
+
+	Parent    insolar.Reference
+	FromPulse insolar.PulseNumber
+	ToPulse   insolar.PulseNumber
+}
+
+// Type implementation of Message interface.
+func (*GetObjectsByParent) Type() insolar.MessageType {
+	return insolar.TypeGetObjectsByParent
+}
+
+// AllowedSenderObjectAndRole implements interface method
+func (m *GetObjectsByParent) AllowedSenderObjectAndRole() (*insolar.Reference, insolar.DynamicRole) {
+	return nil, insolar.DynamicRoleUndefined
+}
+
+// DefaultRole returns role for this event
+func (*GetObjectsByParent) DefaultRole() insolar.DynamicRole {
+	return insolar.DynamicRoleHeavyExecutor
+}
+
+// DefaultTarget returns of target of this event.
+func (m *GetObjectsByParent) DefaultTarget() *insolar.Reference {
+	return &m.Parent
+}
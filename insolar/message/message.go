@@ -23,84 +23,11 @@ import (
 	"io"
 	"io/ioutil"
 
-	"github.com/ugorji/go/codec"
-
 	"github.com/pkg/errors"
 
 	"github.com/insolar/insolar/insolar"
 )
 
-// GetEmptyMessage constructs specified message
-func getEmptyMessage(mt insolar.MessageType) (insolar.Message, error) {
-	switch mt {
-
-	// Logicrunner
-	case insolar.TypeCallMethod:
-		return &CallMethod{}, nil
-	case insolar.TypeCallConstructor:
-		return &CallConstructor{}, nil
-	case insolar.TypeReturnResults:
-		return &ReturnResults{}, nil
-	case insolar.TypeExecutorResults:
-		return &ExecutorResults{}, nil
-	case insolar.TypeValidateCaseBind:
-		return &ValidateCaseBind{}, nil
-	case insolar.TypeValidationResults:
-		return &ValidationResults{}, nil
-	case insolar.TypePendingFinished:
-		return &PendingFinished{}, nil
-	case insolar.TypeStillExecuting:
-		return &StillExecuting{}, nil
-
-	// Ledger
-	case insolar.TypeGetCode:
-		return &GetCode{}, nil
-	case insolar.TypeGetObject:
-		return &GetObject{}, nil
-	case insolar.TypeGetDelegate:
-		return &GetDelegate{}, nil
-	case insolar.TypeGetChildren:
-		return &GetChildren{}, nil
-	case insolar.TypeUpdateObject:
-		return &UpdateObject{}, nil
-	case insolar.TypeRegisterChild:
-		return &RegisterChild{}, nil
-	case insolar.TypeJetDrop:
-		return &JetDrop{}, nil
-	case insolar.TypeSetRecord:
-		return &SetRecord{}, nil
-	case insolar.TypeGetObjectIndex:
-		return &GetObjectIndex{}, nil
-	case insolar.TypeValidationCheck:
-		return &ValidationCheck{}, nil
-	case insolar.TypeGetPendingRequests:
-		return &GetPendingRequests{}, nil
-	case insolar.TypeGetJet:
-		return &GetJet{}, nil
-	case insolar.TypeAbandonedRequestsNotification:
-		return &AbandonedRequestsNotification{}, nil
-	case insolar.TypeGetPendingRequestID:
-		return &GetPendingRequestID{}, nil
-	case insolar.TypeGetRequest:
-		return &GetRequest{}, nil
-
-	// heavy sync
-	case insolar.TypeHeavyStartStop:
-		return &HeavyStartStop{}, nil
-	case insolar.TypeHeavyPayload:
-		return &HeavyPayload{}, nil
-	// Bootstrap
-	case insolar.TypeBootstrapRequest:
-		return &GenesisRequest{}, nil
-
-	// NodeCert
-	case insolar.TypeNodeSignRequest:
-		return &NodeSignPayload{}, nil
-	default:
-		return nil, errors.Errorf("unimplemented message type %d", mt)
-	}
-}
-
 // MustSerializeBytes returns encoded insolar.Message, panics on error.
 func MustSerializeBytes(msg insolar.Message) []byte {
 	r, err := Serialize(msg)
@@ -116,15 +43,19 @@ func MustSerializeBytes(msg insolar.Message) []byte {
 
 // Serialize returns io.Reader on buffer with encoded insolar.Message.
 func Serialize(msg insolar.Message) (io.Reader, error) {
-	buff := &bytes.Buffer{}
-	_, err := buff.Write([]byte{byte(msg.Type())})
-	if err != nil {
-		return nil, err
+	if msg == nil {
+		return nil, errors.New("can't serialize nil message")
+	}
+	if _, ok := messages[msg.Type()]; !ok {
+		return nil, errors.Wrapf(ErrUnknownType, "message type %s", msg.Type())
 	}
 
-	enc := codec.NewEncoder(buff, &codec.CborHandle{})
-	enc.MustEncode(msg)
-	return buff, err
+	buff := &bytes.Buffer{}
+	buff.WriteByte(byte(msg.Type()))
+	if err := encode(buff, msg); err != nil {
+		return nil, errors.Wrapf(err, "failed to encode message %s", msg.Type())
+	}
+	return buff, nil
 }
 
 // Deserialize returns decoded message.
@@ -135,13 +66,12 @@ func Deserialize(buff io.Reader) (insolar.Message, error) {
 		return nil, errors.New("too short slice for deserialize message")
 	}
 
-	msg, err := getEmptyMessage(insolar.MessageType(b[0]))
+	msg, err := newMessage(insolar.MessageType(b[0]))
 	if err != nil {
 		return nil, err
 	}
-	enc := codec.NewDecoder(buff, &codec.CborHandle{})
-	if err = enc.Decode(msg); err != nil {
-		return nil, err
+	if err = decode(buff, msg); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode message %s", msg.Type())
 	}
 	return msg, nil
 }
@@ -159,20 +89,101 @@ func ToBytes(msg insolar.Message) []byte {
 	return buff
 }
 
+// parcelEnvelope is a wire representation of Parcel. Message and token are encoded separately
+// and tagged with their types, so the envelope itself has no interface fields.
+type parcelEnvelope struct {
+	Sender      insolar.Reference
+	MessageType insolar.MessageType
+	Message     []byte
+	Signature   []byte
+	TokenType   insolar.DelegationTokenType
+	Token       []byte
+	PulseNumber insolar.PulseNumber
+	ServiceData ServiceData
+}
+
 // SerializeParcel returns io.Reader on buffer with encoded insolar.Parcel.
 func SerializeParcel(parcel insolar.Parcel) (io.Reader, error) {
+	p, ok := parcel.(*Parcel)
+	if !ok {
+		return nil, errors.Errorf("can't serialize parcel of type %T", parcel)
+	}
+
+	envelope := parcelEnvelope{
+		Sender:      p.Sender,
+		Signature:   p.Signature,
+		PulseNumber: p.PulseNumber,
+		ServiceData: p.ServiceData,
+	}
+
+	if p.Msg != nil {
+		msg, err := Serialize(p.Msg)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to serialize parcel message")
+		}
+		envelope.MessageType = p.Msg.Type()
+		envelope.Message, err = ioutil.ReadAll(msg)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to serialize parcel message")
+		}
+	}
+
+	if p.Token != nil {
+		if _, ok := tokens[p.Token.Type()]; !ok {
+			return nil, errors.Wrapf(ErrUnknownType, "delegation token type %s", p.Token.Type())
+		}
+		var err error
+		envelope.TokenType = p.Token.Type()
+		envelope.Token, err = encodeBytes(p.Token)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to encode parcel token")
+		}
+	}
+
 	buff := &bytes.Buffer{}
-	enc := gob.NewEncoder(buff)
-	err := enc.Encode(parcel)
-	return buff, err
+	if err := encode(buff, &envelope); err != nil {
+		return nil, errors.Wrap(err, "failed to encode parcel")
+	}
+	return buff, nil
 }
 
 // DeserializeParcel returns decoded signed message.
 func DeserializeParcel(buff io.Reader) (insolar.Parcel, error) {
-	var signed Parcel
-	enc := gob.NewDecoder(buff)
-	err := enc.Decode(&signed)
-	return &signed, err
+	var envelope parcelEnvelope
+	if err := decode(buff, &envelope); err != nil {
+		return nil, errors.Wrap(err, "failed to decode parcel")
+	}
+
+	parcel := &Parcel{
+		Sender:      envelope.Sender,
+		Signature:   envelope.Signature,
+		PulseNumber: envelope.PulseNumber,
+		ServiceData: envelope.ServiceData,
+	}
+
+	var err error
+	if envelope.Message != nil {
+		parcel.Msg, err = Deserialize(bytes.NewReader(envelope.Message))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to deserialize parcel message")
+		}
+		if parcel.Msg.Type() != envelope.MessageType {
+			return nil, errors.Errorf(
+				"parcel message type mismatch: expected %s, got %s", envelope.MessageType, parcel.Msg.Type(),
+			)
+		}
+	}
+
+	if envelope.Token != nil {
+		parcel.Token, err = newToken(envelope.TokenType)
+		if err != nil {
+			return nil, err
+		}
+		if err := decodeBytes(envelope.Token, parcel.Token); err != nil {
+			return nil, errors.Wrap(err, "failed to decode parcel token")
+		}
+	}
+	return parcel, nil
 }
 
 // ParcelToBytes deserialize a insolar.Parcel to bytes.
@@ -189,48 +200,52 @@ func ParcelToBytes(msg insolar.Parcel) []byte {
 }
 
 func init() {
-	// Bootstrap
-	gob.Register(&NodeSignPayload{})
-
 	// Logicrunner
-	gob.Register(&CallConstructor{})
-	gob.Register(&CallMethod{})
-	gob.Register(&ReturnResults{})
-	gob.Register(&ExecutorResults{})
-	gob.Register(&ValidateCaseBind{})
-	gob.Register(&ValidationResults{})
-	gob.Register(&PendingFinished{})
-	gob.Register(&StillExecuting{})
+	Register(insolar.TypeCallMethod, func() insolar.Message { return &CallMethod{} })
+	Register(insolar.TypeCallConstructor, func() insolar.Message { return &CallConstructor{} })
+	Register(insolar.TypeReturnResults, func() insolar.Message { return &ReturnResults{} })
+	Register(insolar.TypeExecutorResults, func() insolar.Message { return &ExecutorResults{} })
+	Register(insolar.TypeValidateCaseBind, func() insolar.Message { return &ValidateCaseBind{} })
+	Register(insolar.TypeValidationResults, func() insolar.Message { return &ValidationResults{} })
+	Register(insolar.TypePendingFinished, func() insolar.Message { return &PendingFinished{} })
+	Register(insolar.TypeStillExecuting, func() insolar.Message { return &StillExecuting{} })
 
 	// Ledger
-	gob.Register(&GetCode{})
-	gob.Register(&GetObject{})
-	gob.Register(&GetDelegate{})
-	gob.Register(&UpdateObject{})
-	gob.Register(&RegisterChild{})
-	gob.Register(&JetDrop{})
-	gob.Register(&SetRecord{})
-	gob.Register(&GetObjectIndex{})
-	gob.Register(&SetBlob{})
-	gob.Register(&ValidateRecord{})
-	gob.Register(&ValidationCheck{})
-	gob.Register(&GetPendingRequests{})
-	gob.Register(&GetJet{})
-	gob.Register(&AbandonedRequestsNotification{})
-	gob.Register(&HotData{})
-	gob.Register(&GetPendingRequestID{})
-	gob.Register(&GetRequest{})
-
-	// heavy
-	gob.Register(&HeavyStartStop{})
-	gob.Register(&HeavyPayload{})
+	Register(insolar.TypeGetCode, func() insolar.Message { return &GetCode{} })
+	Register(insolar.TypeGetObject, func() insolar.Message { return &GetObject{} })
+	Register(insolar.TypeGetDelegate, func() insolar.Message { return &GetDelegate{} })
+	Register(insolar.TypeGetChildren, func() insolar.Message { return &GetChildren{} })
+	Register(insolar.TypeUpdateObject, func() insolar.Message { return &UpdateObject{} })
+	Register(insolar.TypeRegisterChild, func() insolar.Message { return &RegisterChild{} })
+	Register(insolar.TypeJetDrop, func() insolar.Message { return &JetDrop{} })
+	Register(insolar.TypeSetRecord, func() insolar.Message { return &SetRecord{} })
+	Register(insolar.TypeValidateRecord, func() insolar.Message { return &ValidateRecord{} })
+	Register(insolar.TypeSetBlob, func() insolar.Message { return &SetBlob{} })
+	Register(insolar.TypeGetObjectIndex, func() insolar.Message { return &GetObjectIndex{} })
+	Register(insolar.TypeGetPendingRequests, func() insolar.Message { return &GetPendingRequests{} })
+	Register(insolar.TypeHotRecords, func() insolar.Message { return &HotData{} })
+	Register(insolar.TypeGetJet, func() insolar.Message { return &GetJet{} })
+	Register(insolar.TypeAbandonedRequestsNotification, func() insolar.Message { return &AbandonedRequestsNotification{} })
+	Register(insolar.TypeGetRequest, func() insolar.Message { return &GetRequest{} })
+	Register(insolar.TypeGetPendingRequestID, func() insolar.Message { return &GetPendingRequestID{} })
+	Register(insolar.TypeGetObjectsByPrototype, func() insolar.Message { return &GetObjectsByPrototype{} })
+	Register(insolar.TypeGetObjectsByParent, func() insolar.Message { return &GetObjectsByParent{} })
+	Register(insolar.TypeValidationCheck, func() insolar.Message { return &ValidationCheck{} })
+
+	// Heavy
+	Register(insolar.TypeHeavyStartStop, func() insolar.Message { return &HeavyStartStop{} })
+	Register(insolar.TypeHeavyPayload, func() insolar.Message { return &HeavyPayload{} })
 
 	// Bootstrap
-	gob.Register(&GenesisRequest{})
-	gob.Register(&Parcel{})
-	gob.Register(insolar.Reference{})
-	gob.Register(&GetChildren{})
+	Register(insolar.TypeBootstrapRequest, func() insolar.Message { return &GenesisRequest{} })
 
 	// NodeCert
-	gob.Register(&NodeSignPayload{})
+	Register(insolar.TypeNodeSignRequest, func() insolar.Message { return &NodeSignPayload{} })
+
+	// Parcels and messages are still embedded into gob-encoded structures outside of this package.
+	for _, constructor := range messages {
+		gob.Register(constructor())
+	}
+	gob.Register(&Parcel{})
+	gob.Register(insolar.Reference{})
 }
@@ -138,6 +138,11 @@ type RedirectReply interface {
 type MessageSendOptions struct {
 	Receiver *Reference
 	Token    DelegationToken
+	// Broadcast sends message to all nodes of the message's default role. Reply will be reply.Broadcast with reply or
+	// error of every node. Receiver is ignored.
+	Broadcast bool
+	// Quorum is the number of successful replies broadcast waits for. Zero means all nodes.
+	Quorum int
 }
 
 // Safe returns original options, falling back on defaults if nil.
@@ -158,6 +163,13 @@ type MessageBus interface {
 	// MustRegister is a Register wrapper that panics if an error was returned.
 	MustRegister(p MessageType, handler MessageHandler)
 
+	// AddInboundInterceptor adds interceptor to the chain that wraps handlers of all received messages. Interceptors
+	// are executed in the order they were added.
+	AddInboundInterceptor(InboundInterceptor)
+	// AddOutboundInterceptor adds interceptor to the chain that wraps all sent messages. Interceptors are executed in
+	// the order they were added.
+	AddOutboundInterceptor(OutboundInterceptor)
+
 	// NewPlayer creates a new player from stream. This is a very long operation, as it saves replies in storage until the
 	// stream is exhausted.
 	//
@@ -177,6 +189,11 @@ type TapeWriter interface {
 	WriteTape(ctx context.Context, writer io.Writer) error
 }
 
+type TapeCloser interface {
+	// CloseTape releases resources held by recorder's or player's tape, e.g. temporary files.
+	CloseTape(ctx context.Context) error
+}
+
 type messageBusKey struct{}
 
 // MessageBusFromContext returns MessageBus from context. If provided context does not have MessageBus, fallback will
@@ -201,6 +218,17 @@ func ContextWithMessageBus(ctx context.Context, bus MessageBus) context.Context
 // MessageHandler is a function for message handling. It should be registered via Register method.
 type MessageHandler func(context.Context, Parcel) (Reply, error)
 
+// MessageSender is a function for message sending. It has the same signature as MessageBus.Send.
+type MessageSender func(context.Context, Message, *MessageSendOptions) (Reply, error)
+
+// InboundInterceptor wraps message handler. It can change context, reject parcel or reply on its own without calling
+// the next handler.
+type InboundInterceptor func(next MessageHandler) MessageHandler
+
+// OutboundInterceptor wraps message sender. It can change message or options, or reply on its own without calling
+// the next sender.
+type OutboundInterceptor func(next MessageSender) MessageSender
+
 //go:generate stringer -type=MessageType
 const (
 	// Logicrunner
@@ -279,6 +307,13 @@ const (
 
 	// TypeNodeSignRequest used to request sign for new node
 	TypeNodeSignRequest
+
+	// Ledger secondary indexes
+
+	// TypeGetObjectsByPrototype fetches heads of objects activated with provided prototype.
+	TypeGetObjectsByPrototype
+	// TypeGetObjectsByParent fetches heads of children of provided parent.
+	TypeGetObjectsByParent
 )
 
 // DelegationTokenType is an enum type of delegation token
@@ -74,10 +74,17 @@ const (
 	TypeJet
 	// TypeRequest contains request.
 	TypeRequest
+	// TypeObjectHeads contains heads of objects from secondary indexes.
+	TypeObjectHeads
 	// TypeHeavyError carries heavy record sync
 	TypeHeavyError
 
 	TypeNodeSign
+
+	// TypeBroadcast contains replies of all nodes the message was broadcast to.
+	TypeBroadcast
+	// TypeOverloaded is returned when receiver drops message because of rate limits or full queues.
+	TypeOverloaded
 )
 
 // ErrType is used to determine and compare reply errors.
@@ -94,6 +101,8 @@ const (
 	ErrNoPendingRequests
 	// ErrTooManyPendingRequests is returned when a limit of pending requests has been reached
 	ErrTooManyPendingRequests
+	// ErrIncorrectPulse is returned when receiver rejects message sent in previous pulse.
+	ErrIncorrectPulse
 )
 
 func getEmptyReply(t insolar.ReplyType) (insolar.Reply, error) {
@@ -136,9 +145,15 @@ func getEmptyReply(t insolar.ReplyType) (insolar.Reply, error) {
 		return &Jet{}, nil
 	case TypeRequest:
 		return &Request{}, nil
+	case TypeObjectHeads:
+		return &ObjectHeads{}, nil
 
 	case TypeNodeSign:
 		return &NodeSign{}, nil
+	case TypeBroadcast:
+		return &Broadcast{}, nil
+	case TypeOverloaded:
+		return &Overloaded{}, nil
 
 	default:
 		return nil, errors.Errorf("unimplemented reply type: '%d'", t)
@@ -204,4 +219,7 @@ func init() {
 	gob.Register(&NodeSign{})
 	gob.Register(&HasPendingRequests{})
 	gob.Register(&Request{})
+	gob.Register(&ObjectHeads{})
+	gob.Register(&Broadcast{})
+	gob.Register(&Overloaded{})
 }
@@ -63,3 +63,25 @@ func (e *Error) Error() error {
 
 	return insolar.ErrUnknown
 }
+
+// Broadcast contains replies of nodes the message was broadcast to. Nodes that failed to reply are stored in Errors.
+// Nodes that didn't answer before quorum was reached are absent in both maps.
+type Broadcast struct {
+	Replies map[insolar.Reference]insolar.Reply
+	Errors  map[insolar.Reference]string
+}
+
+// Type implementation of Reply interface.
+func (e *Broadcast) Type() insolar.ReplyType {
+	return TypeBroadcast
+}
+
+// Overloaded is returned when receiver is not able to accept message right now. Sender should retry later.
+type Overloaded struct {
+	Reason string
+}
+
+// Type implementation of Reply interface.
+func (e *Overloaded) Type() insolar.ReplyType {
+	return TypeOverloaded
+}
@@ -78,6 +78,16 @@ func (e *Children) Type() insolar.ReplyType {
 	return TypeChildren
 }
 
+// ObjectHeads contains heads of objects from secondary indexes.
+type ObjectHeads struct {
+	Refs []insolar.Reference
+}
+
+// Type implementation of Reply interface.
+func (e *ObjectHeads) Type() insolar.ReplyType {
+	return TypeObjectHeads
+}
+
 // ObjectIndex contains serialized object index. It can be stored in DB without processing.
 type ObjectIndex struct {
 	Index []byte
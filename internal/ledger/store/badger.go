@@ -94,6 +94,61 @@ func (b *BadgerDB) Set(key Key, value []byte) error {
 	return nil
 }
 
+// Delete removes value for a key.
+func (b *BadgerDB) Delete(key Key) error {
+	fullKey := append(key.Scope().Bytes(), key.ID()...)
+
+	return b.backend.Update(func(txn *badger.Txn) error {
+		return txn.Delete(fullKey)
+	})
+}
+
+// Write applies all writes of the batch in a single transaction. ErrBatchTooBig is returned if the batch doesn't fit
+// into a transaction, nothing is saved in this case.
+func (b *BadgerDB) Write(batch *Batch) error {
+	err := b.backend.Update(func(txn *badger.Txn) error {
+		for _, op := range batch.ops {
+			var err error
+			if op.delete {
+				err = txn.Delete(op.key)
+			} else {
+				err = txn.Set(op.key, op.value)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err == badger.ErrTxnTooBig {
+		return ErrBatchTooBig
+	}
+	return err
+}
+
+// Iterate calls handler for every record of the scope which ID starts with provided prefix.
+func (b *BadgerDB) Iterate(scope Scope, prefix []byte, handler func(id, value []byte) error) error {
+	fullPrefix := append(scope.Bytes(), prefix...)
+
+	return b.backend.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+
+		for it.Seek(fullPrefix); it.ValidForPrefix(fullPrefix); it.Next() {
+			id := it.Item().KeyCopy(nil)[len(scope.Bytes()):]
+			value, err := it.Item().ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+			err = handler(id, value)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 // Stop gracefully stops all disk writes. After calling this, it's safe to kill the process without losing data.
 func (b *BadgerDB) Stop(ctx context.Context) error {
 	return b.backend.Close()
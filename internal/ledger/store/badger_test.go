@@ -97,3 +97,41 @@ func TestBadgerDB_Set(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, expectedValue, value)
 }
+
+func TestBadgerDB_Write_TooBigBatch(t *testing.T) {
+	t.Parallel()
+
+	tmpdir, err := ioutil.TempDir("", "bdb-test-")
+	defer os.RemoveAll(tmpdir)
+	assert.NoError(t, err)
+
+	db, err := NewBadgerDB(tmpdir)
+	require.NoError(t, err)
+
+	// Number of writes exceeds badger transaction limit.
+	const count = 200000
+	scope := Scope(1)
+	batch := &Batch{}
+	for i := 0; i < count; i++ {
+		batch.Set(testBadgerKey{scope: scope, id: []byte{byte(i >> 16), byte(i >> 8), byte(i)}}, []byte{byte(i)})
+	}
+	err = db.backend.Update(func(txn *badger.Txn) error {
+		for _, op := range batch.ops {
+			if err := txn.Set(op.key, op.value); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	require.Equal(t, badger.ErrTxnTooBig, err, "batch doesn't fit into a single transaction")
+
+	require.Equal(t, ErrBatchTooBig, db.Write(batch))
+
+	var written int
+	err = db.Iterate(scope, nil, func(id, value []byte) error {
+		written++
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Zero(t, written, "nothing of the rejected batch is saved")
+}
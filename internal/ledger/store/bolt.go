@@ -0,0 +1,164 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package store
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	boltFileName = "ledger.db"
+	// boltIterateChunk is a number of records read in a single transaction during iteration.
+	boltIterateChunk = 1000
+)
+
+var boltBucket = []byte("ledger")
+
+// BoltDB is a bbolt DB implementation. All scopes are stored in a single bucket.
+type BoltDB struct {
+	backend *bolt.DB
+}
+
+// NewBoltDB creates new BoltDB instance.
+// Creates new bolt.DB instance with database file in provided working dir and use it as backend for BoltDB.
+func NewBoltDB(dir string) (*BoltDB, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	err = os.MkdirAll(dir, 0700)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create bolt directory")
+	}
+
+	bdb, err := bolt.Open(filepath.Join(dir, boltFileName), 0600, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open bolt")
+	}
+	err = bdb.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(boltBucket)
+		return err
+	})
+	if err != nil {
+		_ = bdb.Close()
+		return nil, errors.Wrap(err, "failed to create bolt bucket")
+	}
+
+	return &BoltDB{backend: bdb}, nil
+}
+
+// Get returns a copy of the value for specified key or an error.
+func (b *BoltDB) Get(key Key) (value []byte, err error) {
+	err = b.backend.View(func(tx *bolt.Tx) error {
+		v := tx.Bucket(boltBucket).Get(scopedKey(key))
+		if v == nil {
+			return ErrNotFound
+		}
+		value = append([]byte{}, v...)
+		return nil
+	})
+	return
+}
+
+// Set stores value for a key.
+func (b *BoltDB) Set(key Key, value []byte) error {
+	return b.backend.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(boltBucket).Put(scopedKey(key), value)
+	})
+}
+
+// Delete removes value for a key.
+func (b *BoltDB) Delete(key Key) error {
+	return b.backend.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(boltBucket).Delete(scopedKey(key))
+	})
+}
+
+// Write applies all writes of the batch in a single transaction.
+func (b *BoltDB) Write(batch *Batch) error {
+	return b.backend.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(boltBucket)
+		for _, op := range batch.ops {
+			var err error
+			if op.delete {
+				err = bucket.Delete(op.key)
+			} else {
+				err = bucket.Put(op.key, op.value)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Iterate calls handler for every record of the scope which ID starts with provided prefix.
+//
+// Records are read by chunks in separate transactions and handler is called outside of them, so handler is allowed
+// to access DB (bolt transactions can't be nested safely).
+func (b *BoltDB) Iterate(scope Scope, prefix []byte, handler func(id, value []byte) error) error {
+	fullPrefix := append(scope.Bytes(), prefix...)
+
+	// last is the last key of the previous chunk.
+	var last []byte
+	for {
+		var keys, values [][]byte
+		err := b.backend.View(func(tx *bolt.Tx) error {
+			c := tx.Bucket(boltBucket).Cursor()
+			var k, v []byte
+			if last == nil {
+				k, v = c.Seek(fullPrefix)
+			} else {
+				k, v = c.Seek(last)
+				if bytes.Equal(k, last) {
+					k, v = c.Next()
+				}
+			}
+			for ; k != nil && bytes.HasPrefix(k, fullPrefix) && len(keys) < boltIterateChunk; k, v = c.Next() {
+				keys = append(keys, append([]byte{}, k...))
+				values = append(values, append([]byte{}, v...))
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for i, k := range keys {
+			err = handler(k[len(scope.Bytes()):], values[i])
+			if err != nil {
+				return err
+			}
+		}
+		if len(keys) < boltIterateChunk {
+			return nil
+		}
+		last = keys[len(keys)-1]
+	}
+}
+
+// Stop gracefully stops all disk writes. After calling this, it's safe to kill the process without losing data.
+func (b *BoltDB) Stop(ctx context.Context) error {
+	return b.backend.Close()
+}
@@ -16,12 +16,84 @@
 
 package store
 
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/configuration"
+)
+
 //go:generate minimock -i github.com/insolar/insolar/internal/ledger/store.DB -o ./ -s _gen_mock.go
 
 // DB provides a simple key-value store interface for persisting data.
 type DB interface {
 	Get(key Key) (value []byte, err error)
 	Set(key Key, value []byte) error
+	// Delete removes value for a key. Removing a missing key is not an error.
+	Delete(key Key) error
+	// Iterate calls handler for every record of the scope which ID starts with provided prefix. Records are
+	// visited in ascending order of IDs. Iteration stops on the first handler error, which is returned.
+	Iterate(scope Scope, prefix []byte, handler func(id, value []byte) error) error
+	// Write applies all writes of the batch atomically: either all of them are saved or none. Batch exceeding
+	// backend transaction size is rejected with ErrBatchTooBig.
+	Write(batch *Batch) error
+}
+
+// Backend is a DB that owns its files on disk.
+type Backend interface {
+	DB
+	// Stop gracefully stops all disk writes. After calling this, it's safe to kill the process without losing data.
+	Stop(ctx context.Context) error
+}
+
+// Available DB backends.
+const (
+	// BackendBadger is a badger (LSM tree) DB backend.
+	BackendBadger = "badger"
+	// BackendBolt is a bbolt (B+ tree) DB backend.
+	BackendBolt = "bolt"
+)
+
+// NewBackend opens DB of the backend selected by configuration in DataDirectoryNewDB directory.
+func NewBackend(conf configuration.Storage) (Backend, error) {
+	switch conf.Backend {
+	case BackendBadger, "":
+		return NewBadgerDB(conf.DataDirectoryNewDB)
+	case BackendBolt:
+		return NewBoltDB(conf.DataDirectoryNewDB)
+	}
+	return nil, errors.Errorf("unknown DB backend %q", conf.Backend)
+}
+
+// Batch collects writes to be applied atomically by DB.Write. Batch is not thread safe.
+type Batch struct {
+	ops []batchOp
+}
+
+type batchOp struct {
+	key    []byte
+	value  []byte
+	delete bool
+}
+
+// Set adds write of value for a key to the batch.
+func (b *Batch) Set(key Key, value []byte) {
+	b.ops = append(b.ops, batchOp{key: scopedKey(key), value: append([]byte{}, value...)})
+}
+
+// Delete adds removal of a key to the batch.
+func (b *Batch) Delete(key Key) {
+	b.ops = append(b.ops, batchOp{key: scopedKey(key), delete: true})
+}
+
+// Len returns number of writes in the batch.
+func (b *Batch) Len() int {
+	return len(b.ops)
+}
+
+func scopedKey(key Key) []byte {
+	return append(key.Scope().Bytes(), key.ID()...)
 }
 
 // Key represents a key for the key-value store. Scope is required to separate different DB clients and should be
@@ -52,4 +124,12 @@ const (
 	ScopeIndex Scope = 4
 	// ScopeBlob is the scope for a blobs records.
 	ScopeBlob Scope = 7
+	// ScopeQuarantine is the scope for inconsistent data moved aside by the ledger verifier.
+	ScopeQuarantine Scope = 8
+	// ScopeNode is the scope for active nodes storage.
+	ScopeNode Scope = 9
+	// ScopeSecondaryIndex is the scope for secondary indexes of objects.
+	ScopeSecondaryIndex Scope = 10
+	// ScopeTombstone is the scope for proofs of records removed by compaction.
+	ScopeTombstone Scope = 11
 )
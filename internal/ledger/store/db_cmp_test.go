@@ -17,15 +17,19 @@
 package store
 
 import (
+	"context"
 	"io/ioutil"
 	"math/rand"
 	"os"
+	"path/filepath"
 	"testing"
 
 	fuzz "github.com/google/gofuzz"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
 )
 
 type testKey struct {
@@ -41,16 +45,33 @@ func (k testKey) ID() []byte {
 	return k.id
 }
 
-func TestDB_Components(t *testing.T) {
-	t.Parallel()
-
+// testDBs returns instances of every DB implementation.
+func testDBs(t *testing.T) (map[string]DB, func()) {
 	tmpdir, err := ioutil.TempDir("", "bdb-test-")
-	defer os.RemoveAll(tmpdir)
-	assert.NoError(t, err)
-	badger, err := NewBadgerDB(tmpdir)
 	require.NoError(t, err)
 
-	mock := NewMemoryMockDB()
+	badger, err := NewBadgerDB(filepath.Join(tmpdir, BackendBadger))
+	require.NoError(t, err)
+	bolt, err := NewBoltDB(filepath.Join(tmpdir, BackendBolt))
+	require.NoError(t, err)
+
+	dbs := map[string]DB{
+		BackendBadger: badger,
+		BackendBolt:   bolt,
+		"mock":        NewMemoryMockDB(),
+	}
+	return dbs, func() {
+		_ = badger.Stop(context.Background())
+		_ = bolt.Stop(context.Background())
+		_ = os.RemoveAll(tmpdir)
+	}
+}
+
+func TestDB_Components(t *testing.T) {
+	t.Parallel()
+
+	dbs, cleanup := testDBs(t)
+	defer cleanup()
 
 	type data struct {
 		key   testKey
@@ -71,26 +92,177 @@ func TestDB_Components(t *testing.T) {
 	})
 	f.Fuzz(&datas)
 
-	for _, d := range datas {
-		{
-			err := badger.Set(d.key, d.value)
-			assert.NoError(t, err)
+	for name, db := range dbs {
+		for _, d := range datas {
+			err := db.Set(d.key, d.value)
+			assert.NoError(t, err, name)
 		}
-		{
-			err := mock.Set(d.key, d.value)
-			assert.NoError(t, err)
+		for _, d := range datas {
+			val, err := db.Get(d.key)
+			assert.NoError(t, err, name)
+			assert.Equal(t, d.value, val, name)
 		}
 	}
-	for _, d := range datas {
-		{
-			val, err := badger.Get(d.key)
-			assert.NoError(t, err)
-			assert.Equal(t, d.value, val)
+}
+
+func TestDB_Iterate(t *testing.T) {
+	t.Parallel()
+
+	dbs, cleanup := testDBs(t)
+	defer cleanup()
+
+	var (
+		scope  = Scope(1)
+		prefix = []byte{2}
+		want   = [][]byte{{2, 1}, {2, 3}}
+	)
+	for name, db := range dbs {
+		for _, key := range []testKey{
+			{scope: scope, id: []byte{2, 3}},
+			{scope: scope, id: []byte{2, 1}},
+			{scope: scope, id: []byte{1, 2}},
+			{scope: scope, id: []byte{3}},
+			{scope: Scope(2), id: []byte{2, 2}},
+		} {
+			require.NoError(t, db.Set(key, key.id), name)
+		}
+
+		var ids, values [][]byte
+		err := db.Iterate(scope, prefix, func(id, value []byte) error {
+			ids = append(ids, id)
+			values = append(values, value)
+			return nil
+		})
+		require.NoError(t, err, name)
+		assert.Equal(t, want, ids, name)
+		assert.Equal(t, want, values, name)
+
+		calls := 0
+		err = db.Iterate(scope, prefix, func(id, value []byte) error {
+			calls++
+			return ErrNotFound
+		})
+		assert.Equal(t, ErrNotFound, err, name)
+		assert.Equal(t, 1, calls, name)
+	}
+}
+
+func TestDB_Iterate_Large(t *testing.T) {
+	t.Parallel()
+
+	dbs, cleanup := testDBs(t)
+	defer cleanup()
+
+	// More records than bolt reads in a single transaction.
+	const count = boltIterateChunk*2 + 1
+	scope := Scope(1)
+	for name, db := range dbs {
+		batch := &Batch{}
+		for i := 0; i < count; i++ {
+			batch.Set(testKey{scope: scope, id: []byte{byte(i >> 8), byte(i)}}, []byte{byte(i)})
 		}
-		{
-			val, err := mock.Get(d.key)
-			assert.NoError(t, err)
-			assert.Equal(t, d.value, val)
+		require.NoError(t, db.Write(batch), name)
+
+		var ids [][]byte
+		err := db.Iterate(scope, nil, func(id, value []byte) error {
+			ids = append(ids, id)
+			// Handler is allowed to access DB.
+			_, err := db.Get(testKey{scope: scope, id: id})
+			return err
+		})
+		require.NoError(t, err, name)
+		require.Len(t, ids, count, name)
+		for i, id := range ids {
+			assert.Equal(t, []byte{byte(i >> 8), byte(i)}, id, name)
 		}
 	}
 }
+
+func TestDB_Delete(t *testing.T) {
+	t.Parallel()
+
+	dbs, cleanup := testDBs(t)
+	defer cleanup()
+
+	var (
+		deleted = testKey{scope: Scope(1), id: []byte{1}}
+		kept    = testKey{scope: Scope(1), id: []byte{2}}
+		missing = testKey{scope: Scope(1), id: []byte{3}}
+	)
+	for name, db := range dbs {
+		require.NoError(t, db.Set(deleted, []byte{1}), name)
+		require.NoError(t, db.Set(kept, []byte{2}), name)
+
+		require.NoError(t, db.Delete(deleted), name)
+		require.NoError(t, db.Delete(missing), name)
+
+		_, err := db.Get(deleted)
+		assert.Equal(t, ErrNotFound, err, name)
+		val, err := db.Get(kept)
+		require.NoError(t, err, name)
+		assert.Equal(t, []byte{2}, val, name)
+	}
+}
+
+func TestDB_Write(t *testing.T) {
+	t.Parallel()
+
+	dbs, cleanup := testDBs(t)
+	defer cleanup()
+
+	var (
+		updated = testKey{scope: Scope(1), id: []byte{1}}
+		deleted = testKey{scope: Scope(1), id: []byte{2}}
+		created = testKey{scope: Scope(2), id: []byte{1}}
+	)
+	for name, db := range dbs {
+		require.NoError(t, db.Set(updated, []byte{1}), name)
+		require.NoError(t, db.Set(deleted, []byte{2}), name)
+
+		batch := &Batch{}
+		value := []byte{3}
+		batch.Set(updated, value)
+		batch.Delete(deleted)
+		batch.Set(created, []byte{4})
+		batch.Set(created, []byte{5})
+		// Batch keeps its own copy of value.
+		value[0] = 42
+		assert.Equal(t, 4, batch.Len(), name)
+		require.NoError(t, db.Write(batch), name)
+
+		val, err := db.Get(updated)
+		require.NoError(t, err, name)
+		assert.Equal(t, []byte{3}, val, name)
+		_, err = db.Get(deleted)
+		assert.Equal(t, ErrNotFound, err, name)
+		val, err = db.Get(created)
+		require.NoError(t, err, name)
+		assert.Equal(t, []byte{5}, val, name)
+	}
+}
+
+func TestNewBackend(t *testing.T) {
+	t.Parallel()
+
+	tmpdir, err := ioutil.TempDir("", "bdb-test-")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpdir)
+
+	conf := configuration.NewLedger().Storage
+	conf.DataDirectoryNewDB = filepath.Join(tmpdir, "badger")
+	db, err := NewBackend(conf)
+	require.NoError(t, err)
+	assert.IsType(t, &BadgerDB{}, db)
+	require.NoError(t, db.Stop(context.Background()))
+
+	conf.Backend = BackendBolt
+	conf.DataDirectoryNewDB = filepath.Join(tmpdir, "bolt")
+	db, err = NewBackend(conf)
+	require.NoError(t, err)
+	assert.IsType(t, &BoltDB{}, db)
+	require.NoError(t, db.Stop(context.Background()))
+
+	conf.Backend = "unknown"
+	_, err = NewBackend(conf)
+	assert.Error(t, err)
+}
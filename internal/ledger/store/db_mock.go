@@ -17,6 +17,8 @@
 package store
 
 import (
+	"bytes"
+	"sort"
 	"sync"
 )
 
@@ -55,3 +57,53 @@ func (b *MockDB) Set(key Key, value []byte) error {
 	b.backend[string(fullKey)] = append([]byte{}, value...)
 	return nil
 }
+
+// Delete removes value for a key from memory storage.
+func (b *MockDB) Delete(key Key) error {
+	fullKey := append(key.Scope().Bytes(), key.ID()...)
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	delete(b.backend, string(fullKey))
+	return nil
+}
+
+// Write applies all writes of the batch to memory storage under a single lock.
+func (b *MockDB) Write(batch *Batch) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	for _, op := range batch.ops {
+		if op.delete {
+			delete(b.backend, string(op.key))
+			continue
+		}
+		b.backend[string(op.key)] = append([]byte{}, op.value...)
+	}
+	return nil
+}
+
+// Iterate calls handler for every record of the scope which ID starts with provided prefix.
+func (b *MockDB) Iterate(scope Scope, prefix []byte, handler func(id, value []byte) error) error {
+	fullPrefix := append(scope.Bytes(), prefix...)
+
+	b.lock.RLock()
+	var keys []string
+	for k := range b.backend {
+		if bytes.HasPrefix([]byte(k), fullPrefix) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	values := make([][]byte, 0, len(keys))
+	for _, k := range keys {
+		values = append(values, append([]byte{}, b.backend[k]...))
+	}
+	b.lock.RUnlock()
+
+	for i, k := range keys {
+		err := handler([]byte(k)[len(scope.Bytes()):], values[i])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
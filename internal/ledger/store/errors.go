@@ -23,4 +23,7 @@ import (
 var (
 	// ErrNotFound is returned when value was not found.
 	ErrNotFound = errors.New("value not found")
+	// ErrBatchTooBig is returned when batch doesn't fit into a single transaction of DB backend. Nothing of such batch
+	// is saved, it should be split by caller.
+	ErrBatchTooBig = errors.New("batch is too big")
 )
@@ -43,6 +43,7 @@ import (
 	"github.com/insolar/insolar/ledger/storage/drop"
 	"github.com/insolar/insolar/ledger/storage/node"
 	"github.com/insolar/insolar/ledger/storage/object"
+	"github.com/insolar/insolar/ledger/storage/secondary"
 )
 
 // MessageHandler processes messages for local storage interaction.
@@ -69,6 +70,9 @@ type MessageHandler struct {
 	RecordAccessor object.RecordAccessor `inject:""`
 	Nodes          node.Accessor         `inject:""`
 
+	SecondaryIndex         secondary.Accessor `inject:""`
+	SecondaryIndexModifier secondary.Modifier `inject:""`
+
 	DBContext     storage.DBContext `inject:""`
 	HotDataWaiter HotDataWaiter     `inject:""`
 
@@ -130,85 +134,83 @@ func (h *MessageHandler) Init(ctx context.Context) error {
 
 func (h *MessageHandler) setHandlersForLight(m *middleware) {
 	// Generic.
-	h.Bus.MustRegister(insolar.TypeGetCode, BuildMiddleware(h.handleGetCode,
+	h.register(insolar.TypeGetCode, BuildMiddleware(h.handleGetCode,
 		instrumentHandler("handleGetCode"),
-		m.addFieldsToLogger,
 		m.checkJet,
 	))
 
-	h.Bus.MustRegister(insolar.TypeGetObject,
+	h.register(insolar.TypeGetObject,
 		BuildMiddleware(h.handleGetObject,
 			instrumentHandler("handleGetObject"),
-			m.addFieldsToLogger,
 			m.checkJet,
 			m.waitForHotData))
 
-	h.Bus.MustRegister(insolar.TypeGetDelegate,
+	h.register(insolar.TypeGetDelegate,
 		BuildMiddleware(h.handleGetDelegate,
 			instrumentHandler("handleGetDelegate"),
-			m.addFieldsToLogger,
 			m.checkJet,
 			m.waitForHotData))
 
-	h.Bus.MustRegister(insolar.TypeGetChildren,
+	h.register(insolar.TypeGetChildren,
 		BuildMiddleware(h.handleGetChildren,
 			instrumentHandler("handleGetChildren"),
-			m.addFieldsToLogger,
 			m.checkJet,
 			m.waitForHotData))
 
-	h.Bus.MustRegister(insolar.TypeSetRecord,
+	h.register(insolar.TypeSetRecord,
 		BuildMiddleware(h.handleSetRecord,
 			instrumentHandler("handleSetRecord"),
-			m.addFieldsToLogger,
 			m.checkJet,
 			m.waitForHotData))
 
-	h.Bus.MustRegister(insolar.TypeUpdateObject,
+	h.register(insolar.TypeUpdateObject,
 		BuildMiddleware(h.handleUpdateObject,
 			instrumentHandler("handleUpdateObject"),
-			m.addFieldsToLogger,
 			m.checkJet,
 			m.waitForHotData))
 
-	h.Bus.MustRegister(insolar.TypeRegisterChild,
+	h.register(insolar.TypeRegisterChild,
 		BuildMiddleware(h.handleRegisterChild,
 			instrumentHandler("handleRegisterChild"),
-			m.addFieldsToLogger,
 			m.checkJet,
 			m.waitForHotData))
 
-	h.Bus.MustRegister(insolar.TypeSetBlob,
+	h.register(insolar.TypeSetBlob,
 		BuildMiddleware(h.handleSetBlob,
 			instrumentHandler("handleSetBlob"),
-			m.addFieldsToLogger,
 			m.checkJet,
 			m.waitForHotData))
 
-	h.Bus.MustRegister(insolar.TypeGetObjectIndex,
+	h.register(insolar.TypeGetObjectIndex,
 		BuildMiddleware(h.handleGetObjectIndex,
 			instrumentHandler("handleGetObjectIndex"),
-			m.addFieldsToLogger,
 			m.checkJet,
 			m.waitForHotData))
 
-	h.Bus.MustRegister(insolar.TypeGetPendingRequests,
+	h.register(insolar.TypeGetPendingRequests,
 		BuildMiddleware(h.handleHasPendingRequests,
 			instrumentHandler("handleHasPendingRequests"),
-			m.addFieldsToLogger,
 			m.checkJet,
 			m.waitForHotData))
 
-	h.Bus.MustRegister(insolar.TypeGetJet,
+	h.register(insolar.TypeGetJet,
 		BuildMiddleware(h.handleGetJet,
 			instrumentHandler("handleGetJet")))
 
-	h.Bus.MustRegister(insolar.TypeHotRecords,
+	h.register(insolar.TypeGetObjectsByPrototype,
+		BuildMiddleware(h.handleGetObjectsByPrototype,
+			instrumentHandler("handleGetObjectsByPrototype")))
+
+	h.register(insolar.TypeGetObjectsByParent,
+		BuildMiddleware(h.handleGetObjectsByParent,
+			instrumentHandler("handleGetObjectsByParent")))
+
+	h.register(insolar.TypeHotRecords,
 		BuildMiddleware(h.handleHotRecords,
 			instrumentHandler("handleHotRecords"),
 			m.releaseHotDataWaiters))
 
-	h.Bus.MustRegister(
+	h.register(
 		insolar.TypeGetRequest,
 		BuildMiddleware(
 			h.handleGetRequest,
@@ -217,7 +219,7 @@ func (h *MessageHandler) setHandlersForLight(m *middleware) {
 		),
 	)
 
-	h.Bus.MustRegister(
+	h.register(
 		insolar.TypeGetPendingRequestID,
 		BuildMiddleware(
 			h.handleGetPendingRequestID,
@@ -227,22 +229,24 @@ func (h *MessageHandler) setHandlersForLight(m *middleware) {
 	)
 
 	// Validation.
-	h.Bus.MustRegister(insolar.TypeValidateRecord,
+	h.register(insolar.TypeValidateRecord,
 		BuildMiddleware(h.handleValidateRecord,
-			m.addFieldsToLogger,
 			m.checkJet))
 
-	h.Bus.MustRegister(insolar.TypeValidationCheck,
+	h.register(insolar.TypeValidationCheck,
 		BuildMiddleware(h.handleValidationCheck,
-			m.addFieldsToLogger,
 			m.checkJet))
 
-	h.Bus.MustRegister(insolar.TypeJetDrop,
+	h.register(insolar.TypeJetDrop,
 		BuildMiddleware(h.handleJetDrop,
-			m.addFieldsToLogger,
 			m.checkJet))
 }
 
+// register registers ledger handler on the bus. Handler is wrapped with middleware common to all ledger handlers.
+func (h *MessageHandler) register(t insolar.MessageType, handler insolar.MessageHandler) {
+	h.Bus.MustRegister(t, h.middleware.addFieldsToLogger(handler))
+}
+
 func (h *MessageHandler) setReplayHandlers(m *middleware) {
 	// Generic.
 	h.replayHandlers[insolar.TypeGetCode] = BuildMiddleware(h.handleGetCode, m.addFieldsToLogger)
@@ -555,6 +559,32 @@ func (h *MessageHandler) handleGetJet(ctx context.Context, parcel insolar.Parcel
 	return &reply.Jet{ID: insolar.ID(jetID), Actual: actual}, nil
 }
 
+// handleGetObjectsByPrototype returns heads of objects indexed by this node. Only objects of jets executed by this
+// node in pulses that are not cleaned yet are returned.
+func (h *MessageHandler) handleGetObjectsByPrototype(ctx context.Context, parcel insolar.Parcel) (insolar.Reply, error) {
+	msg := parcel.Message().(*message.GetObjectsByPrototype)
+
+	refs, err := h.SecondaryIndex.ForPrototype(ctx, msg.Prototype, msg.FromPulse, msg.ToPulse)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch objects by prototype")
+	}
+
+	return &reply.ObjectHeads{Refs: refs}, nil
+}
+
+// handleGetObjectsByParent returns heads of children indexed by this node. Only children of jets executed by this
+// node in pulses that are not cleaned yet are returned.
+func (h *MessageHandler) handleGetObjectsByParent(ctx context.Context, parcel insolar.Parcel) (insolar.Reply, error) {
+	msg := parcel.Message().(*message.GetObjectsByParent)
+
+	refs, err := h.SecondaryIndex.ForParent(ctx, msg.Parent, msg.FromPulse, msg.ToPulse)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch objects by parent")
+	}
+
+	return &reply.ObjectHeads{Refs: refs}, nil
+}
+
 func (h *MessageHandler) handleGetDelegate(ctx context.Context, parcel insolar.Parcel) (insolar.Reply, error) {
 	msg := parcel.Message().(*message.GetDelegate)
 	jetID := jetFromContext(ctx)
@@ -836,7 +866,15 @@ func (h *MessageHandler) handleUpdateObject(ctx context.Context, parcel insolar.
 	idx.LatestState = id
 	idx.State = state.ID()
 	if state.ID() == object.StateActivation {
-		idx.Parent = state.(*object.ActivateRecord).Parent
+		activate := state.(*object.ActivateRecord)
+		idx.Parent = activate.Parent
+		// Objects are added to the parent index when they are registered as children.
+		if !activate.IsPrototype {
+			err = h.SecondaryIndexModifier.AddToPrototype(ctx, activate.Image, msg.Object)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to update prototype index")
+			}
+		}
 	}
 
 	idx.LatestUpdate = parcel.Pulse()
@@ -915,6 +953,11 @@ func (h *MessageHandler) handleRegisterChild(ctx context.Context, parcel insolar
 		return nil, errors.Wrap(err, "can't save record into storage")
 	}
 
+	err = h.SecondaryIndexModifier.AddToParent(ctx, msg.Parent, childRec.Ref)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to update parent index")
+	}
+
 	idx.ChildPointer = child
 	if msg.AsType != nil {
 		idx.Delegates[*msg.AsType] = msg.Child
@@ -1135,7 +1178,7 @@ func (h *MessageHandler) fetchObject(
 ) (*reply.Object, error) {
 	sender := BuildSender(
 		h.Bus.Send,
-		followRedirectSender(h.Bus),
+		countRedirectsSender(),
 		retryJetSender(pulse, h.JetStorage),
 	)
 	genericReply, err := sender(
@@ -1175,12 +1218,22 @@ func (h *MessageHandler) handleHotRecords(ctx context.Context, parcel insolar.Pa
 	}).Info("received hot data")
 
 	err := h.DropModifier.Set(ctx, msg.Drop)
-	if err == storage.ErrOverride {
+	if err == drop.ErrOverride {
 		err = nil
 	}
 	if err != nil {
 		return nil, errors.Wrapf(err, "[jet]: drop error (pulse: %v)", msg.Drop.Pulse)
 	}
+	// Jets were joined, drop of the second child is required to continue the drop hash chain.
+	if msg.SiblingDrop != nil {
+		err := h.DropModifier.Set(ctx, *msg.SiblingDrop)
+		if err == drop.ErrOverride {
+			err = nil
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "[jet]: sibling drop error (pulse: %v)", msg.SiblingDrop.Pulse)
+		}
+	}
 
 	pendingStorage := h.RecentStorageProvider.GetPendingStorage(ctx, jetID)
 	logger.Debugf("received %d pending requests", len(msg.PendingRequests))
@@ -44,6 +44,7 @@ import (
 	"github.com/insolar/insolar/ledger/storage/drop"
 	"github.com/insolar/insolar/ledger/storage/node"
 	"github.com/insolar/insolar/ledger/storage/object"
+	"github.com/insolar/insolar/ledger/storage/secondary"
 	"github.com/insolar/insolar/ledger/storage/storagetest"
 	"github.com/insolar/insolar/testutils"
 	"github.com/insolar/insolar/testutils/network"
@@ -871,6 +872,9 @@ func (s *handlerSuite) TestMessageHandler_HandleRegisterChild_FetchesIndexFromHe
 	h.RecentStorageProvider = provideMock
 	h.PlatformCryptographyScheme = s.scheme
 	h.RecordModifier = s.recordModifier
+	indexes := secondary.NewStorageMemory()
+	h.SecondaryIndex = indexes
+	h.SecondaryIndexModifier = indexes
 
 	idLockMock := storage.NewIDLockerMock(s.T())
 	idLockMock.LockMock.Return()
@@ -950,6 +954,9 @@ func (s *handlerSuite) TestMessageHandler_HandleRegisterChild_IndexStateUpdated(
 	h.RecentStorageProvider = provideMock
 	h.PlatformCryptographyScheme = s.scheme
 	h.RecordModifier = s.recordModifier
+	indexes := secondary.NewStorageMemory()
+	h.SecondaryIndex = indexes
+	h.SecondaryIndexModifier = indexes
 
 	idLockMock := storage.NewIDLockerMock(s.T())
 	idLockMock.LockMock.Return()
@@ -984,6 +991,10 @@ func (s *handlerSuite) TestMessageHandler_HandleRegisterChild_IndexStateUpdated(
 	idx, err := s.objectStorage.GetObjectIndex(s.ctx, jetID, msg.Parent.Record())
 	require.NoError(s.T(), err)
 	require.Equal(s.T(), int(idx.LatestUpdate), insolar.FirstPulseNumber+100)
+
+	children, err := h.SecondaryIndex.ForParent(s.ctx, msg.Parent, 0, insolar.FirstPulseNumber+100)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), []insolar.Reference{childRecord.Ref}, children)
 }
 
 func (s *handlerSuite) TestMessageHandler_HandleHotRecords() {
@@ -56,7 +56,9 @@ func newMiddleware(
 
 func (m *middleware) addFieldsToLogger(handler insolar.MessageHandler) insolar.MessageHandler {
 	return func(ctx context.Context, parcel insolar.Parcel) (insolar.Reply, error) {
-		ctx, _ = inslogger.WithField(ctx, "targetid", parcel.DefaultTarget().String())
+		if target := parcel.DefaultTarget(); target != nil {
+			ctx, _ = inslogger.WithField(ctx, "targetid", target.String())
+		}
 
 		return handler(ctx, parcel)
 	}
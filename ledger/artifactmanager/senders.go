@@ -22,42 +22,17 @@ import (
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/jet"
 	"github.com/insolar/insolar/insolar/reply"
-	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/messagebus"
 	"github.com/pkg/errors"
-	"go.opencensus.io/stats"
 )
 
 const jetMissRetryCount = 10
 
-// followRedirectSender is using for redirecting responses with delegation token
-func followRedirectSender(bus insolar.MessageBus) PreSender {
+// countRedirectsSender records redirects followed by MessageBus to AM metrics
+func countRedirectsSender() PreSender {
 	return func(sender Sender) Sender {
 		return func(ctx context.Context, msg insolar.Message, options *insolar.MessageSendOptions) (insolar.Reply, error) {
-			rep, err := sender(ctx, msg, options)
-			if err != nil {
-				return nil, err
-			}
-
-			if r, ok := rep.(insolar.RedirectReply); ok {
-				stats.Record(ctx, statRedirects.M(1))
-
-				redirected := r.Redirected(msg)
-				inslogger.FromContext(ctx).Debugf("redirect reciever=%v", r.GetReceiver())
-
-				rep, err = bus.Send(ctx, redirected, &insolar.MessageSendOptions{
-					Token:    r.GetToken(),
-					Receiver: r.GetReceiver(),
-				})
-				if err != nil {
-					return nil, err
-				}
-				if _, ok := rep.(insolar.RedirectReply); ok {
-					return nil, errors.New("double redirects are forbidden")
-				}
-				return rep, nil
-			}
-
-			return rep, err
+			return sender(messagebus.ContextWithRedirectMeasure(ctx, statRedirects), msg, options)
 		}
 	}
 }
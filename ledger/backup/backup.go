@@ -0,0 +1,103 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package backup
+
+import (
+	"context"
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/component"
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/retention"
+	"github.com/insolar/insolar/ledger/storage"
+	"github.com/insolar/insolar/ledger/storage/blob"
+	"github.com/insolar/insolar/ledger/storage/drop"
+	"github.com/insolar/insolar/ledger/storage/object"
+	"github.com/insolar/insolar/ledger/storage/pulse"
+	"github.com/insolar/insolar/platformpolicy"
+)
+
+// Backup writes snapshot of storage described by provided configuration up to provided pulse to w. Zero pulse means
+// the latest finalized one. Node using the storage should be stopped.
+func Backup(ctx context.Context, conf configuration.Ledger, pn insolar.PulseNumber, w io.Writer) (*Result, error) {
+	maker := NewMaker(conf.Backup)
+	closeStorage, err := injectStorage(conf, maker)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Backup ]")
+	}
+	defer closeStorage(ctx)
+
+	pn, err = maker.finalized(ctx, pn)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Backup ]")
+	}
+	stats, err := maker.Make(ctx, pn, w)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Backup ]")
+	}
+	return &Result{Pulse: pn, Stats: *stats}, nil
+}
+
+// Restore loads snapshot from r into an empty storage described by provided configuration. It allows to seed a new
+// heavy material node instead of replaying from genesis.
+func Restore(ctx context.Context, conf configuration.Ledger, r io.Reader) (*Result, error) {
+	restorer := NewRestorer()
+	closeStorage, err := injectStorage(conf, restorer)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Restore ]")
+	}
+	defer closeStorage(ctx)
+
+	return restorer.Restore(ctx, r)
+}
+
+// injectStorage opens heavy material node storage and injects it into provided components.
+func injectStorage(conf configuration.Ledger, components ...interface{}) (func(context.Context), error) {
+	legacyDB, err := storage.NewDB(conf, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open DB")
+	}
+	db, err := store.NewBackend(conf.Storage)
+	if err != nil {
+		_ = legacyDB.Close()
+		return nil, errors.Wrap(err, "failed to open DB")
+	}
+
+	cm := component.Manager{}
+	cm.Inject(append([]interface{}{
+		platformpolicy.NewPlatformCryptographyScheme(),
+		legacyDB,
+		db,
+		pulse.NewStorageDB(db),
+		drop.NewStorageDB(db),
+		blob.NewStorageDB(db),
+		object.NewRecordDB(db),
+		retention.NewTombstoneDB(db),
+		storage.NewReplicaStorage(),
+		jet.NewStore(),
+	}, components...)...)
+
+	return func(ctx context.Context) {
+		_ = db.Stop(ctx)
+		_ = legacyDB.Close()
+	}, nil
+}
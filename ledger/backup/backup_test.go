@@ -0,0 +1,288 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/component"
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/retention"
+	"github.com/insolar/insolar/ledger/storage"
+	"github.com/insolar/insolar/ledger/storage/blob"
+	"github.com/insolar/insolar/ledger/storage/drop"
+	"github.com/insolar/insolar/ledger/storage/object"
+	"github.com/insolar/insolar/ledger/storage/pulse"
+	"github.com/insolar/insolar/platformpolicy"
+)
+
+type testStorage struct {
+	legacyDB storage.DBContext
+	db       *store.BadgerDB
+
+	pulses  *pulse.StorageDB
+	records *object.RecordDB
+	blobs   *blob.StorageDB
+	drops   interface {
+		drop.Accessor
+		drop.Modifier
+	}
+	tombstones *retention.TombstoneDB
+	objects    storage.ObjectStorage
+	replicas   storage.ReplicaStorage
+}
+
+func openTestStorage(t *testing.T, conf configuration.Ledger) *testStorage {
+	legacyDB, err := storage.NewDB(conf, nil)
+	require.NoError(t, err)
+	db, err := store.NewBadgerDB(conf.Storage.DataDirectoryNewDB)
+	require.NoError(t, err)
+
+	s := &testStorage{
+		legacyDB:   legacyDB,
+		db:         db,
+		pulses:     pulse.NewStorageDB(db),
+		records:    object.NewRecordDB(db),
+		blobs:      blob.NewStorageDB(db),
+		drops:      drop.NewStorageDB(db),
+		tombstones: retention.NewTombstoneDB(db),
+		objects:    storage.NewObjectStorage(),
+		replicas:   storage.NewReplicaStorage(),
+	}
+	cm := component.Manager{}
+	cm.Inject(platformpolicy.NewPlatformCryptographyScheme(), legacyDB, s.objects, s.replicas)
+	return s
+}
+
+func (s *testStorage) close(ctx context.Context) {
+	_ = s.db.Stop(ctx)
+	_ = s.legacyDB.Close()
+}
+
+func testConf(t *testing.T) (configuration.Ledger, func()) {
+	tmpdir, err := ioutil.TempDir("", "backup-test-")
+	require.NoError(t, err)
+
+	conf := configuration.NewLedger()
+	conf.Storage.DataDirectory = filepath.Join(tmpdir, "data")
+	conf.Storage.DataDirectoryNewDB = filepath.Join(tmpdir, "new-data")
+	conf.Backup.Directory = filepath.Join(tmpdir, "backup")
+	return conf, func() { _ = os.RemoveAll(tmpdir) }
+}
+
+type testData struct {
+	pulses      []insolar.PulseNumber
+	jetID       insolar.JetID
+	objectID    insolar.ID
+	blobID      insolar.ID
+	compactedID insolar.ID
+}
+
+// fillStorage saves three pulses with records, blobs, drops and an index. Drop of the second pulse has provided hash
+// if it's not nil. A record of the second pulse is compacted. The object is amended in the third pulse.
+func fillStorage(ctx context.Context, t *testing.T, conf configuration.Ledger, badHash []byte) testData {
+	s := openTestStorage(t, conf)
+	defer s.close(ctx)
+	pcs := platformpolicy.NewPlatformCryptographyScheme()
+
+	first := insolar.PulseNumber(insolar.FirstPulseNumber)
+	data := testData{
+		pulses: []insolar.PulseNumber{first, first + 10, first + 20},
+		jetID:  *insolar.NewJetID(0, nil),
+	}
+	for _, pn := range data.pulses {
+		require.NoError(t, s.pulses.Append(ctx, insolar.Pulse{PulseNumber: pn}))
+	}
+
+	data.blobID = *insolar.NewID(first, []byte{1})
+	require.NoError(t, s.blobs.Set(ctx, data.blobID, blob.Blob{Value: []byte{42}, JetID: data.jetID}))
+
+	var prevHash []byte
+	for i, pn := range data.pulses[:2] {
+		id := *insolar.NewID(pn, []byte{2})
+		if i == 0 {
+			data.objectID = id
+		}
+		err := s.records.Set(ctx, id, record.MaterialRecord{
+			Record: &object.ActivateRecord{StateRecord: object.StateRecord{Memory: &data.blobID}},
+			JetID:  data.jetID,
+		})
+		require.NoError(t, err)
+
+		ids := []insolar.ID{id}
+		if i == 1 {
+			data.compactedID = *insolar.NewID(pn, []byte{4})
+			err = s.tombstones.Set(ctx, data.compactedID, retention.Tombstone{JetID: data.jetID})
+			require.NoError(t, err)
+			ids = append(ids, data.compactedID)
+		}
+
+		d := drop.Drop{Pulse: pn, JetID: data.jetID, PrevHash: prevHash}
+		d.Hash = drop.CalculateHash(pcs.IntegrityHasher(), prevHash, ids)
+		if i == 1 && badHash != nil {
+			d.Hash = badHash
+		}
+		require.NoError(t, s.drops.Set(ctx, d))
+		prevHash = d.Hash
+	}
+
+	amendID := *insolar.NewID(data.pulses[2], []byte{3})
+	err := s.records.Set(ctx, amendID, record.MaterialRecord{
+		Record: &object.AmendRecord{PrevState: data.objectID},
+		JetID:  data.jetID,
+	})
+	require.NoError(t, err)
+	err = s.objects.SetObjectIndex(ctx, insolar.ID(data.jetID), &data.objectID, &object.Lifeline{
+		LatestState:  &amendID,
+		State:        object.StateAmend,
+		LatestUpdate: data.pulses[2],
+		JetID:        data.jetID,
+	})
+	require.NoError(t, err)
+	require.NoError(t, s.legacyDB.Set(ctx, storage.GenesisPrefixKey(), []byte{1, 2, 3}))
+
+	return data
+}
+
+func TestBackupRestore(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	srcConf, cleanSrc := testConf(t)
+	defer cleanSrc()
+	dstConf, cleanDst := testConf(t)
+	defer cleanDst()
+
+	data := fillStorage(ctx, t, srcConf, nil)
+
+	var buf bytes.Buffer
+	res, err := Backup(ctx, srcConf, 0, &buf)
+	require.NoError(t, err)
+	assert.Equal(t, data.pulses[1], res.Pulse)
+	assert.Equal(t, Stats{Pulses: 2, Records: 2, Blobs: 1, Drops: 2, Indexes: 1, Tombstones: 1}, res.Stats)
+
+	snapshot := buf.Bytes()
+	res, err = Restore(ctx, dstConf, bytes.NewReader(snapshot))
+	require.NoError(t, err)
+	assert.Equal(t, data.pulses[1], res.Pulse)
+	assert.Equal(t, Stats{Pulses: 2, Records: 2, Blobs: 1, Drops: 2, Indexes: 1, Tombstones: 1}, res.Stats)
+
+	_, err = Restore(ctx, dstConf, bytes.NewReader(snapshot))
+	assert.Error(t, err, "restore to non-empty storage")
+
+	s := openTestStorage(t, dstConf)
+	defer s.close(ctx)
+
+	latest, err := s.pulses.Latest(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, data.pulses[1], latest.PulseNumber)
+	_, err = s.records.ForID(ctx, data.objectID)
+	assert.NoError(t, err)
+	tombstone, err := s.tombstones.ForID(ctx, data.compactedID)
+	require.NoError(t, err)
+	assert.Equal(t, data.jetID, tombstone.JetID)
+	b, err := s.blobs.ForID(ctx, data.blobID)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{42}, b.Value)
+	_, err = s.drops.ForPulse(ctx, data.jetID, data.pulses[1])
+	assert.NoError(t, err)
+	idx, err := s.objects.GetObjectIndex(ctx, insolar.ID(data.jetID), &data.objectID)
+	require.NoError(t, err)
+	assert.Equal(t, object.StateActivation, idx.State, "index is rewound to the snapshot pulse")
+	assert.Equal(t, &data.objectID, idx.LatestState)
+	assert.Equal(t, data.pulses[0], idx.LatestUpdate)
+	genesis, err := s.legacyDB.Get(ctx, storage.GenesisPrefixKey())
+	require.NoError(t, err)
+	assert.Equal(t, []byte{1, 2, 3}, genesis)
+	synced, err := s.replicas.GetHeavySyncedPulse(ctx, insolar.ID(data.jetID))
+	require.NoError(t, err)
+	assert.Equal(t, data.pulses[1], synced)
+}
+
+func TestRestore_Fails(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+
+	t.Run("drop hash mismatch", func(t *testing.T) {
+		srcConf, cleanSrc := testConf(t)
+		defer cleanSrc()
+		dstConf, cleanDst := testConf(t)
+		defer cleanDst()
+		fillStorage(ctx, t, srcConf, []byte{1, 2, 3})
+
+		var buf bytes.Buffer
+		_, err := Backup(ctx, srcConf, 0, &buf)
+		require.NoError(t, err)
+		_, err = Restore(ctx, dstConf, &buf)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "hash mismatch")
+	})
+
+	t.Run("truncated snapshot", func(t *testing.T) {
+		srcConf, cleanSrc := testConf(t)
+		defer cleanSrc()
+		dstConf, cleanDst := testConf(t)
+		defer cleanDst()
+		fillStorage(ctx, t, srcConf, nil)
+
+		var buf bytes.Buffer
+		_, err := Backup(ctx, srcConf, 0, &buf)
+		require.NoError(t, err)
+		_, err = Restore(ctx, dstConf, bytes.NewReader(buf.Bytes()[:buf.Len()-10]))
+		assert.Error(t, err)
+	})
+}
+
+func TestMaker_Snapshot(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	conf, clean := testConf(t)
+	defer clean()
+	data := fillStorage(ctx, t, conf, nil)
+
+	maker := NewMaker(conf.Backup)
+	closeStorage, err := injectStorage(conf, maker)
+	require.NoError(t, err)
+	defer closeStorage(ctx)
+
+	_, err = maker.Snapshot(ctx, data.pulses[2])
+	assert.Error(t, err, "latest pulse is not finalized")
+
+	left, right := jet.Children(data.jetID)
+	maker.JetAccessor.(*jet.Store).Update(ctx, data.pulses[0], true, left, right)
+
+	res, err := maker.Snapshot(ctx, data.pulses[0])
+	require.NoError(t, err)
+	assert.Equal(t, data.pulses[0], res.Pulse)
+	assert.Equal(t, 1, res.Records)
+	f, err := os.Open(res.Path)
+	require.NoError(t, err)
+	defer f.Close()
+	sr, err := newSnapshotReader(f, platformpolicy.NewPlatformCryptographyScheme().IntegrityHasher())
+	require.NoError(t, err)
+	assert.Equal(t, []insolar.JetID{data.jetID}, sr.header.Jets)
+	assert.Equal(t, []insolar.JetID{left, right}, sr.header.JetTree)
+}
@@ -0,0 +1,356 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/retention"
+	"github.com/insolar/insolar/ledger/storage"
+	"github.com/insolar/insolar/ledger/storage/drop"
+	"github.com/insolar/insolar/ledger/storage/object"
+	"github.com/insolar/insolar/ledger/storage/pulse"
+)
+
+// Result describes created or restored snapshot.
+type Result struct {
+	Path  string
+	Pulse insolar.PulseNumber
+	Stats
+}
+
+// Snapshotter creates snapshots of running node storage.
+type Snapshotter interface {
+	// Snapshot saves snapshot of storage up to provided pulse to the backup directory. Zero pulse means the latest
+	// finalized one.
+	Snapshot(ctx context.Context, pn insolar.PulseNumber) (*Result, error)
+}
+
+// Maker creates point-in-time snapshots of storage up to a finalized pulse.
+type Maker struct {
+	PCS             insolar.PlatformCryptographyScheme `inject:""`
+	PulseAccessor   pulse.Accessor                     `inject:""`
+	PulseCalculator pulse.Calculator                   `inject:""`
+	RecordIterator  object.RecordPulseIterator         `inject:""`
+	RecordAccessor  object.RecordAccessor              `inject:""`
+	Tombstones      retention.TombstoneAccessor        `inject:""`
+	JetAccessor     jet.Accessor                       `inject:""`
+	DB              store.DB                           `inject:""`
+	DBContext       storage.DBContext                  `inject:""`
+
+	cfg configuration.Backup
+}
+
+// NewMaker creates new Maker instance.
+func NewMaker(cfg configuration.Backup) *Maker {
+	return &Maker{cfg: cfg}
+}
+
+// Snapshot saves snapshot of storage up to provided pulse to the backup directory.
+func (m *Maker) Snapshot(ctx context.Context, pn insolar.PulseNumber) (*Result, error) {
+	pn, err := m.finalized(ctx, pn)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Snapshot ]")
+	}
+
+	err = os.MkdirAll(m.cfg.Directory, 0700)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Snapshot ] failed to create backup directory")
+	}
+	path := filepath.Join(m.cfg.Directory, fmt.Sprintf("snapshot_%d.bin", pn))
+	tmpPath := path + ".tmp"
+
+	f, err := os.OpenFile(tmpPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Snapshot ] failed to create snapshot file")
+	}
+	stats, err := m.Make(ctx, pn, f)
+	closeErr := f.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(tmpPath)
+		return nil, errors.Wrap(err, "[ Snapshot ]")
+	}
+	err = os.Rename(tmpPath, path)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Snapshot ] failed to save snapshot file")
+	}
+
+	return &Result{Path: path, Pulse: pn, Stats: *stats}, nil
+}
+
+// Make writes snapshot of storage up to provided pulse to w. Zero pulse means the latest finalized one.
+func (m *Maker) Make(ctx context.Context, pn insolar.PulseNumber, w io.Writer) (*Stats, error) {
+	pn, err := m.finalized(ctx, pn)
+	if err != nil {
+		return nil, err
+	}
+
+	pulses, err := m.pulses(ctx, pn)
+	if err != nil {
+		return nil, err
+	}
+	drops, err := m.drops(pn)
+	if err != nil {
+		return nil, err
+	}
+
+	header := Header{Version: snapshotVersion, Pulse: pn}
+	for _, d := range drops[pn] {
+		header.Jets = append(header.Jets, d.JetID)
+	}
+	header.JetTree = m.jetTree(ctx, pn, header.Jets)
+	sw, err := newSnapshotWriter(w, m.PCS.IntegrityHasher(), header)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range pulses {
+		buf, err := encode(&p)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to encode pulse")
+		}
+		err = sw.write(kindPulse, p.PulseNumber.Bytes(), buf)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, p := range pulses {
+		err = m.RecordIterator.IterateOnPulse(ctx, p.PulseNumber, func(id insolar.ID, rec record.MaterialRecord) error {
+			return sw.write(kindRecord, id[:], object.EncodeMaterial(rec))
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to write records of pulse %v", p.PulseNumber)
+		}
+		err = m.DB.Iterate(store.ScopeBlob, p.PulseNumber.Bytes(), func(id, value []byte) error {
+			return sw.write(kindBlob, id, value)
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to write blobs of pulse %v", p.PulseNumber)
+		}
+		// Tombstones are required to verify hashes of drops with compacted records.
+		err = m.Tombstones.IterateOnPulse(ctx, p.PulseNumber, func(id insolar.ID, t retention.Tombstone) error {
+			return sw.write(kindTombstone, id[:], retention.MustEncodeTombstone(t))
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to write tombstones of pulse %v", p.PulseNumber)
+		}
+		for _, d := range drops[p.PulseNumber] {
+			err = sw.write(kindDrop, nil, drop.MustEncode(&d))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	err = storage.IterateIndexKVs(ctx, m.DBContext, pn, func(kv insolar.KV) error {
+		idx, err := m.rewindIndex(ctx, object.DecodeIndex(kv.V), pn)
+		if err != nil {
+			return errors.Wrapf(err, "failed to rewind index %x", kv.K)
+		}
+		return sw.write(kindIndex, kv.K, object.EncodeIndex(idx))
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to write indexes")
+	}
+	genesis, err := m.DBContext.Get(ctx, storage.GenesisPrefixKey())
+	if err != nil && err != insolar.ErrNotFound {
+		return nil, errors.Wrap(err, "failed to fetch genesis")
+	}
+	if err == nil {
+		err = sw.write(kindGenesis, storage.GenesisPrefixKey(), genesis)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = sw.close()
+	if err != nil {
+		return nil, err
+	}
+	return &sw.stats, nil
+}
+
+// finalized checks that provided pulse exists and is not the latest one. For zero pulse it returns the pulse preceding
+// the latest one.
+func (m *Maker) finalized(ctx context.Context, pn insolar.PulseNumber) (insolar.PulseNumber, error) {
+	latest, err := m.PulseAccessor.Latest(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to fetch latest pulse")
+	}
+	if pn == 0 {
+		prev, err := m.PulseCalculator.Backwards(ctx, latest.PulseNumber, 1)
+		if err != nil {
+			return 0, errors.Wrap(err, "there is no finalized pulse")
+		}
+		return prev.PulseNumber, nil
+	}
+
+	if pn >= latest.PulseNumber {
+		return 0, errors.Errorf("pulse %v is not finalized", pn)
+	}
+	_, err = m.PulseAccessor.ForPulseNumber(ctx, pn)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to fetch pulse %v", pn)
+	}
+	return pn, nil
+}
+
+// pulses returns all stored pulses up to provided one in ascending order.
+func (m *Maker) pulses(ctx context.Context, pn insolar.PulseNumber) ([]insolar.Pulse, error) {
+	current, err := m.PulseAccessor.ForPulseNumber(ctx, pn)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch pulse %v", pn)
+	}
+
+	pulses := []insolar.Pulse{current}
+	for {
+		current, err = m.PulseCalculator.Backwards(ctx, current.PulseNumber, 1)
+		if err == pulse.ErrNotFound {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to fetch pulses")
+		}
+		pulses = append(pulses, current)
+	}
+
+	for i, j := 0, len(pulses)-1; i < j; i, j = i+1, j-1 {
+		pulses[i], pulses[j] = pulses[j], pulses[i]
+	}
+	return pulses, nil
+}
+
+// jetTree returns leaves of the jet tree in provided pulse. Jets having drops in the pulse are added to the tree, because
+// jet tree is kept only in memory of the running node.
+func (m *Maker) jetTree(ctx context.Context, pn insolar.PulseNumber, jets []insolar.JetID) []insolar.JetID {
+	tree := jet.NewTree(true)
+	for _, jetID := range append(m.JetAccessor.All(ctx, pn), jets...) {
+		tree.Update(jetID, true)
+	}
+	return tree.LeafIDs()
+}
+
+// rewindIndex returns object index as it was at the end of provided pulse. States, children and delegates added in
+// later pulses are skipped by following links of their records back.
+func (m *Maker) rewindIndex(ctx context.Context, idx object.Lifeline, pn insolar.PulseNumber) (object.Lifeline, error) {
+	if idx.LatestState != nil && idx.LatestState.Pulse() > pn {
+		id, state, err := m.stateAt(ctx, idx.LatestState, pn)
+		if err != nil {
+			return idx, err
+		}
+		idx.LatestState = id
+		if state != nil {
+			idx.State = state.ID()
+		}
+	}
+	if idx.LatestStateApproved != nil && idx.LatestStateApproved.Pulse() > pn {
+		id, _, err := m.stateAt(ctx, idx.LatestStateApproved, pn)
+		if err != nil {
+			return idx, err
+		}
+		idx.LatestStateApproved = id
+	}
+	for idx.ChildPointer != nil && idx.ChildPointer.Pulse() > pn {
+		rec, err := m.RecordAccessor.ForID(ctx, *idx.ChildPointer)
+		if err != nil {
+			return idx, errors.Wrapf(err, "failed to fetch child %v", idx.ChildPointer.DebugString())
+		}
+		child, ok := rec.Record.(*object.ChildRecord)
+		if !ok {
+			return idx, errors.Errorf("record %v is not a child", idx.ChildPointer.DebugString())
+		}
+		idx.ChildPointer = child.PrevChild
+	}
+	for key, delegate := range idx.Delegates {
+		if delegate.Record().Pulse() > pn {
+			delete(idx.Delegates, key)
+		}
+	}
+
+	if idx.LatestUpdate > pn {
+		idx.LatestUpdate = 0
+		for _, id := range []*insolar.ID{idx.LatestState, idx.ChildPointer} {
+			if id != nil && id.Pulse() > idx.LatestUpdate {
+				idx.LatestUpdate = id.Pulse()
+			}
+		}
+	}
+	return idx, nil
+}
+
+// stateAt follows object states back from provided one and returns the latest state created not later than provided
+// pulse.
+func (m *Maker) stateAt(
+	ctx context.Context, id *insolar.ID, pn insolar.PulseNumber,
+) (*insolar.ID, object.State, error) {
+	for id != nil {
+		rec, err := m.RecordAccessor.ForID(ctx, *id)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to fetch state %v", id.DebugString())
+		}
+		state, ok := rec.Record.(object.State)
+		if !ok {
+			return nil, nil, errors.Errorf("record %v is not a state", id.DebugString())
+		}
+		if id.Pulse() <= pn {
+			return id, state, nil
+		}
+		id = state.PrevStateID()
+	}
+	return nil, nil, nil
+}
+
+// drops returns drops of all jets up to provided pulse grouped by pulse.
+func (m *Maker) drops(pn insolar.PulseNumber) (map[insolar.PulseNumber][]drop.Drop, error) {
+	drops := map[insolar.PulseNumber][]drop.Drop{}
+	err := m.DB.Iterate(store.ScopeJetDrop, nil, func(_, value []byte) error {
+		d, err := drop.Decode(value)
+		if err != nil {
+			return err
+		}
+		if d.Pulse <= pn {
+			drops[d.Pulse] = append(drops[d.Pulse], *d)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch drops")
+	}
+
+	for _, list := range drops {
+		sort.Slice(list, func(i, j int) bool {
+			return string(list[i].JetID[:]) < string(list[j].JetID[:])
+		})
+	}
+	return drops, nil
+}
@@ -0,0 +1,228 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/ledger/retention"
+	"github.com/insolar/insolar/ledger/storage"
+	"github.com/insolar/insolar/ledger/storage/blob"
+	"github.com/insolar/insolar/ledger/storage/drop"
+	"github.com/insolar/insolar/ledger/storage/object"
+	"github.com/insolar/insolar/ledger/storage/pulse"
+)
+
+// kvBatchSize is a number of index key/value pairs stored in a single transaction.
+const kvBatchSize = 1000
+
+// Restorer loads snapshots into an empty storage.
+type Restorer struct {
+	PCS               insolar.PlatformCryptographyScheme `inject:""`
+	PulseAccessor     pulse.Accessor                     `inject:""`
+	PulseAppender     pulse.Appender                     `inject:""`
+	Records           object.RecordModifier              `inject:""`
+	RecordIterator    object.RecordPulseIterator         `inject:""`
+	Blobs             blob.Modifier                      `inject:""`
+	Tombstones        retention.TombstoneModifier        `inject:""`
+	Drops             drop.Modifier                      `inject:""`
+	DropAccessor      drop.Accessor                      `inject:""`
+	TombstoneAccessor retention.TombstoneAccessor        `inject:""`
+	DBContext         storage.DBContext                  `inject:""`
+	ReplicaStorage    storage.ReplicaStorage             `inject:""`
+	JetModifier       jet.Modifier                       `inject:""`
+}
+
+// NewRestorer creates new Restorer instance.
+func NewRestorer() *Restorer {
+	return &Restorer{}
+}
+
+// Restore loads snapshot from r. Drop hashes are verified against restored records, restoring stops on the first
+// mismatch. Jet tree of the snapshot pulse is restored and heavy synced pulse of every jet of the tree is set to the
+// snapshot pulse.
+func (r *Restorer) Restore(ctx context.Context, rd io.Reader) (*Result, error) {
+	_, err := r.PulseAccessor.Latest(ctx)
+	if err == nil {
+		return nil, errors.New("[ Restore ] storage is not empty")
+	}
+	if err != pulse.ErrNotFound {
+		return nil, errors.Wrap(err, "[ Restore ] failed to check storage")
+	}
+
+	sr, err := newSnapshotReader(rd, r.PCS.IntegrityHasher())
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Restore ]")
+	}
+
+	res := &Result{Pulse: sr.header.Pulse}
+	prevPulses := map[insolar.PulseNumber]insolar.PulseNumber{}
+	var lastPulse insolar.PulseNumber
+	var kvs []insolar.KV
+	for {
+		e, err := sr.next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "[ Restore ]")
+		}
+
+		switch e.Kind {
+		case kindPulse:
+			var p insolar.Pulse
+			err = decode(e.Value, &p)
+			if err == nil {
+				err = r.PulseAppender.Append(ctx, p)
+			}
+			if lastPulse != 0 {
+				prevPulses[p.PulseNumber] = lastPulse
+			}
+			lastPulse = p.PulseNumber
+			res.Pulses++
+		case kindRecord:
+			err = r.restoreRecord(ctx, e)
+			res.Records++
+		case kindBlob:
+			err = r.restoreBlob(ctx, e)
+			res.Blobs++
+		case kindTombstone:
+			err = r.restoreTombstone(ctx, e)
+			res.Tombstones++
+		case kindDrop:
+			err = r.restoreDrop(ctx, e, prevPulses)
+			res.Drops++
+		case kindIndex, kindGenesis:
+			kvs = append(kvs, insolar.KV{K: e.Key, V: e.Value})
+			if e.Kind == kindIndex {
+				res.Indexes++
+			}
+			if len(kvs) >= kvBatchSize {
+				err = r.DBContext.StoreKeyValues(ctx, kvs)
+				kvs = nil
+			}
+		default:
+			err = errors.Errorf("unknown entry kind %d", e.Kind)
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "[ Restore ]")
+		}
+	}
+
+	if len(kvs) > 0 {
+		err = r.DBContext.StoreKeyValues(ctx, kvs)
+		if err != nil {
+			return nil, errors.Wrap(err, "[ Restore ] failed to store indexes")
+		}
+	}
+	// Snapshots without jet tree have only jets with drops.
+	jets := sr.header.JetTree
+	if len(jets) == 0 {
+		jets = sr.header.Jets
+	}
+	r.JetModifier.Update(ctx, sr.header.Pulse, true, jets...)
+	for _, jetID := range jets {
+		err = r.ReplicaStorage.SetHeavySyncedPulse(ctx, insolar.ID(jetID), sr.header.Pulse)
+		if err != nil {
+			return nil, errors.Wrap(err, "[ Restore ] failed to set synced pulse")
+		}
+	}
+
+	return res, nil
+}
+
+func (r *Restorer) restoreRecord(ctx context.Context, e *entry) error {
+	id, err := entryID(e)
+	if err != nil {
+		return err
+	}
+	rec, err := object.DecodeMaterial(e.Value)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode record")
+	}
+	return r.Records.Set(ctx, id, rec)
+}
+
+func (r *Restorer) restoreBlob(ctx context.Context, e *entry) error {
+	id, err := entryID(e)
+	if err != nil {
+		return err
+	}
+	b, err := blob.Decode(e.Value)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode blob")
+	}
+	return r.Blobs.Set(ctx, id, *b)
+}
+
+func (r *Restorer) restoreTombstone(ctx context.Context, e *entry) error {
+	id, err := entryID(e)
+	if err != nil {
+		return err
+	}
+	t, err := retention.DecodeTombstone(e.Value)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode tombstone")
+	}
+	return r.Tombstones.Set(ctx, id, t)
+}
+
+func (r *Restorer) restoreDrop(ctx context.Context, e *entry, prevPulses map[insolar.PulseNumber]insolar.PulseNumber) error {
+	d, err := drop.Decode(e.Value)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode drop")
+	}
+
+	// Drops created before hashing was introduced are not verified.
+	if len(d.Hash) > 0 {
+		if prevPulse, ok := prevPulses[d.Pulse]; ok && len(d.PrevHash) > 0 {
+			prevHash, err := drop.PrevHash(ctx, r.DropAccessor, d.JetID, prevPulse)
+			if err != nil {
+				return errors.Wrap(err, "failed to fetch previous drop")
+			}
+			if !bytes.Equal(prevHash, d.PrevHash) {
+				return errors.Errorf("drop %v of pulse %v doesn't match previous drop", d.JetID.DebugString(), d.Pulse)
+			}
+		}
+
+		// Records removed by compaction are proved by their tombstones.
+		ids, err := retention.DropRecordIDs(ctx, r.RecordIterator, r.TombstoneAccessor, d.JetID, d.Pulse)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(drop.CalculateHash(r.PCS.IntegrityHasher(), d.PrevHash, ids), d.Hash) {
+			return errors.Errorf("hash mismatch for drop %v of pulse %v", d.JetID.DebugString(), d.Pulse)
+		}
+	}
+
+	return r.Drops.Set(ctx, *d)
+}
+
+func entryID(e *entry) (insolar.ID, error) {
+	var id insolar.ID
+	if len(e.Key) != len(id) {
+		return id, errors.Errorf("invalid id length %d", len(e.Key))
+	}
+	copy(id[:], e.Key)
+	return id, nil
+}
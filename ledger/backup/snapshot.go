@@ -0,0 +1,182 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package backup provides point-in-time snapshots of heavy material node storage and restoring storage from them.
+//
+// Snapshot is a stream of CBOR-encoded values: a header (including the jet tree) followed by entries (pulses, records, blobs, tombstones, drops, indexes)
+// and a trailing entry with a checksum of all previous entries.
+package backup
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/ugorji/go/codec"
+
+	"github.com/insolar/insolar/insolar"
+)
+
+const snapshotVersion = 1
+
+// Header describes snapshot contents.
+type Header struct {
+	Version uint32
+	// Pulse is the last pulse included in the snapshot.
+	Pulse insolar.PulseNumber
+	// Jets are the jets having drops in the snapshot pulse.
+	Jets []insolar.JetID
+	// JetTree contains the leaves of the jet tree in the snapshot pulse.
+	JetTree []insolar.JetID
+}
+
+// Stats holds numbers of snapshot entries.
+type Stats struct {
+	Pulses     int
+	Records    int
+	Blobs      int
+	Drops      int
+	Indexes    int
+	Tombstones int
+}
+
+type entryKind uint8
+
+const (
+	kindPulse entryKind = iota + 1
+	kindRecord
+	kindBlob
+	kindDrop
+	kindIndex
+	kindGenesis
+	kindEnd
+	// kindTombstone is added after kindEnd to keep kinds of existing snapshots.
+	kindTombstone
+)
+
+type entry struct {
+	Kind  entryKind
+	Key   []byte
+	Value []byte
+}
+
+type snapshotWriter struct {
+	enc    *codec.Encoder
+	hasher insolar.Hasher
+	stats  Stats
+}
+
+func newSnapshotWriter(w io.Writer, hasher insolar.Hasher, header Header) (*snapshotWriter, error) {
+	sw := &snapshotWriter{
+		enc:    codec.NewEncoder(w, &codec.CborHandle{}),
+		hasher: hasher,
+	}
+	err := sw.enc.Encode(&header)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to write header")
+	}
+	return sw, nil
+}
+
+func (w *snapshotWriter) write(kind entryKind, key, value []byte) error {
+	e := entry{Kind: kind, Key: key, Value: value}
+	hashEntry(w.hasher, e)
+	err := w.enc.Encode(&e)
+	if err != nil {
+		return errors.Wrap(err, "failed to write entry")
+	}
+
+	switch kind {
+	case kindPulse:
+		w.stats.Pulses++
+	case kindRecord:
+		w.stats.Records++
+	case kindBlob:
+		w.stats.Blobs++
+	case kindDrop:
+		w.stats.Drops++
+	case kindIndex:
+		w.stats.Indexes++
+	case kindTombstone:
+		w.stats.Tombstones++
+	}
+	return nil
+}
+
+func (w *snapshotWriter) close() error {
+	err := w.enc.Encode(&entry{Kind: kindEnd, Value: w.hasher.Sum(nil)})
+	return errors.Wrap(err, "failed to write checksum")
+}
+
+type snapshotReader struct {
+	dec    *codec.Decoder
+	hasher insolar.Hasher
+	header Header
+}
+
+func newSnapshotReader(r io.Reader, hasher insolar.Hasher) (*snapshotReader, error) {
+	sr := &snapshotReader{
+		dec:    codec.NewDecoder(r, &codec.CborHandle{}),
+		hasher: hasher,
+	}
+	err := sr.dec.Decode(&sr.header)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read header")
+	}
+	if sr.header.Version != snapshotVersion {
+		return nil, errors.Errorf("unsupported snapshot version %d", sr.header.Version)
+	}
+	return sr, nil
+}
+
+// next returns next snapshot entry. After the last entry checksum is verified and io.EOF is returned.
+func (r *snapshotReader) next() (*entry, error) {
+	var e entry
+	err := r.dec.Decode(&e)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read entry (snapshot is truncated or corrupted)")
+	}
+	if e.Kind == kindEnd {
+		if !bytes.Equal(e.Value, r.hasher.Sum(nil)) {
+			return nil, errors.New("snapshot checksum mismatch")
+		}
+		return nil, io.EOF
+	}
+	hashEntry(r.hasher, e)
+	return &e, nil
+}
+
+func hashEntry(hasher insolar.Hasher, e entry) {
+	var size [4]byte
+	_, _ = hasher.Write([]byte{byte(e.Kind)})
+	binary.BigEndian.PutUint32(size[:], uint32(len(e.Key)))
+	_, _ = hasher.Write(size[:])
+	_, _ = hasher.Write(e.Key)
+	binary.BigEndian.PutUint32(size[:], uint32(len(e.Value)))
+	_, _ = hasher.Write(size[:])
+	_, _ = hasher.Write(e.Value)
+}
+
+func encode(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	err := codec.NewEncoder(&buf, &codec.CborHandle{}).Encode(v)
+	return buf.Bytes(), err
+}
+
+func decode(buf []byte, v interface{}) error {
+	return codec.NewDecoder(bytes.NewReader(buf), &codec.CborHandle{}).Decode(v)
+}
@@ -0,0 +1,317 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package exporter
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/ledger/storage/blob"
+	"github.com/insolar/insolar/ledger/storage/drop"
+	"github.com/insolar/insolar/ledger/storage/object"
+)
+
+// PulseData is an exported view of a single finalized pulse.
+type PulseData struct {
+	Pulse   PulseInfo
+	Records []RecordData
+	Drops   []DropData
+	Indexes []IndexData
+}
+
+// PulseInfo is an exported view of insolar.Pulse.
+type PulseInfo struct {
+	PulseNumber      insolar.PulseNumber
+	PrevPulseNumber  insolar.PulseNumber
+	NextPulseNumber  insolar.PulseNumber
+	PulseTimestamp   int64
+	EpochPulseNumber int
+}
+
+func newPulseInfo(p insolar.Pulse) PulseInfo {
+	return PulseInfo{
+		PulseNumber:      p.PulseNumber,
+		PrevPulseNumber:  p.PrevPulseNumber,
+		NextPulseNumber:  p.NextPulseNumber,
+		PulseTimestamp:   p.PulseTimestamp,
+		EpochPulseNumber: p.EpochPulseNumber,
+	}
+}
+
+// RecordData is an exported view of record.MaterialRecord. Data holds type-specific fields of the record,
+// Payload holds data referenced by the record (e.g. blobs and message types).
+type RecordData struct {
+	ID      string
+	JetID   string
+	Type    string
+	Data    interface{}
+	Payload map[string]interface{} `json:",omitempty"`
+}
+
+// DropData is an exported view of drop.Drop.
+type DropData struct {
+	JetID    string
+	Pulse    insolar.PulseNumber
+	Hash     []byte
+	PrevHash []byte
+	Size     uint64
+}
+
+func newDropData(d drop.Drop) DropData {
+	return DropData{
+		JetID:    jetString(d.JetID),
+		Pulse:    d.Pulse,
+		Hash:     d.Hash,
+		PrevHash: d.PrevHash,
+		Size:     d.Size,
+	}
+}
+
+// IndexData is an exported view of object.Lifeline.
+type IndexData struct {
+	Object              string
+	LatestState         *string
+	LatestStateApproved *string
+	ChildPointer        *string
+	Parent              string
+	State               string
+	LatestUpdate        insolar.PulseNumber
+	JetID               string
+}
+
+func newIndexData(objID insolar.ID, idx object.Lifeline) IndexData {
+	return IndexData{
+		Object:              objID.String(),
+		LatestState:         idString(idx.LatestState),
+		LatestStateApproved: idString(idx.LatestStateApproved),
+		ChildPointer:        idString(idx.ChildPointer),
+		Parent:              idx.Parent.String(),
+		State:               stateString(idx.State),
+		LatestUpdate:        idx.LatestUpdate,
+		JetID:               jetString(idx.JetID),
+	}
+}
+
+// Record type-specific exported views.
+type (
+	// ChildData is an exported view of object.ChildRecord.
+	ChildData struct {
+		PrevChild *string
+		Ref       string
+	}
+
+	// RequestData is an exported view of object.RequestRecord.
+	RequestData struct {
+		Object      string
+		MessageHash []byte
+		Parcel      []byte
+	}
+
+	// ResultData is an exported view of object.ResultRecord.
+	ResultData struct {
+		Object  string
+		Request string
+		Payload []byte
+	}
+
+	// SideEffectData is an exported view of object.SideEffectRecord.
+	SideEffectData struct {
+		Domain  string
+		Request string
+	}
+
+	// TypeData is an exported view of object.TypeRecord.
+	TypeData struct {
+		SideEffectData
+		TypeDeclaration []byte
+	}
+
+	// CodeData is an exported view of object.CodeRecord.
+	CodeData struct {
+		SideEffectData
+		Code        *string
+		MachineType insolar.MachineType
+	}
+
+	// StateData is an exported view of object.StateRecord.
+	StateData struct {
+		Memory      *string
+		Image       string
+		IsPrototype bool
+	}
+
+	// ActivateData is an exported view of object.ActivateRecord.
+	ActivateData struct {
+		SideEffectData
+		StateData
+		Parent     string
+		IsDelegate bool
+	}
+
+	// AmendData is an exported view of object.AmendRecord.
+	AmendData struct {
+		SideEffectData
+		StateData
+		PrevState string
+	}
+
+	// DeactivationData is an exported view of object.DeactivationRecord.
+	DeactivationData struct {
+		SideEffectData
+		PrevState string
+	}
+)
+
+func (e *Exporter) newRecordData(ctx context.Context, id insolar.ID, rec record.MaterialRecord) (RecordData, error) {
+	res := RecordData{
+		ID:    id.String(),
+		JetID: jetString(rec.JetID),
+		Type:  object.TypeFromRecord(rec.Record).String(),
+	}
+
+	switch r := rec.Record.(type) {
+	case *object.GenesisRecord:
+		res.Data = struct{}{}
+	case *object.ChildRecord:
+		res.Data = ChildData{
+			PrevChild: idString(r.PrevChild),
+			Ref:       r.Ref.String(),
+		}
+	case *object.RequestRecord:
+		res.Data = RequestData{
+			Object:      r.Object.String(),
+			MessageHash: r.MessageHash,
+			Parcel:      r.Parcel,
+		}
+		parcel, err := message.DeserializeParcel(bytes.NewBuffer(r.Parcel))
+		if err == nil {
+			res.Payload = map[string]interface{}{"MessageType": parcel.Type().String()}
+		}
+	case *object.ResultRecord:
+		res.Data = ResultData{
+			Object:  r.Object.String(),
+			Request: r.Request.String(),
+			Payload: r.Payload,
+		}
+	case *object.TypeRecord:
+		res.Data = TypeData{
+			SideEffectData:  newSideEffectData(r.SideEffectRecord),
+			TypeDeclaration: r.TypeDeclaration,
+		}
+	case *object.CodeRecord:
+		res.Data = CodeData{
+			SideEffectData: newSideEffectData(r.SideEffectRecord),
+			Code:           idString(r.Code),
+			MachineType:    r.MachineType,
+		}
+		payload, err := e.blobPayload(ctx, "Code", r.Code)
+		if err != nil {
+			return RecordData{}, err
+		}
+		res.Payload = payload
+	case *object.ActivateRecord:
+		res.Data = ActivateData{
+			SideEffectData: newSideEffectData(r.SideEffectRecord),
+			StateData:      newStateData(r.StateRecord),
+			Parent:         r.Parent.String(),
+			IsDelegate:     r.IsDelegate,
+		}
+		payload, err := e.blobPayload(ctx, "Memory", r.Memory)
+		if err != nil {
+			return RecordData{}, err
+		}
+		res.Payload = payload
+	case *object.AmendRecord:
+		res.Data = AmendData{
+			SideEffectData: newSideEffectData(r.SideEffectRecord),
+			StateData:      newStateData(r.StateRecord),
+			PrevState:      r.PrevState.String(),
+		}
+		payload, err := e.blobPayload(ctx, "Memory", r.Memory)
+		if err != nil {
+			return RecordData{}, err
+		}
+		res.Payload = payload
+	case *object.DeactivationRecord:
+		res.Data = DeactivationData{
+			SideEffectData: newSideEffectData(r.SideEffectRecord),
+			PrevState:      r.PrevState.String(),
+		}
+	}
+
+	return res, nil
+}
+
+// blobPayload returns payload with blob value under provided key. Missing blobs are skipped.
+func (e *Exporter) blobPayload(ctx context.Context, key string, id *insolar.ID) (map[string]interface{}, error) {
+	if id == nil {
+		return nil, nil
+	}
+	b, err := e.BlobAccessor.ForID(ctx, *id)
+	if err == blob.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch blob %v", id.DebugString())
+	}
+	return map[string]interface{}{key: b.Value}, nil
+}
+
+func newSideEffectData(r object.SideEffectRecord) SideEffectData {
+	return SideEffectData{
+		Domain:  r.Domain.String(),
+		Request: r.Request.String(),
+	}
+}
+
+func newStateData(r object.StateRecord) StateData {
+	return StateData{
+		Memory:      idString(r.Memory),
+		Image:       r.Image.String(),
+		IsPrototype: r.IsPrototype,
+	}
+}
+
+func idString(id *insolar.ID) *string {
+	if id == nil {
+		return nil
+	}
+	s := id.String()
+	return &s
+}
+
+func stateString(state object.StateID) string {
+	switch state {
+	case object.StateActivation:
+		return "Activation"
+	case object.StateAmend:
+		return "Amend"
+	case object.StateDeactivation:
+		return "Deactivation"
+	}
+	return "Undefined"
+}
+
+func jetString(jetID insolar.JetID) string {
+	id := insolar.ID(jetID)
+	return id.String()
+}
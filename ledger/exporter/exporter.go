@@ -0,0 +1,220 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package exporter provides read-only access to finalized ledger data for external systems.
+package exporter
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/retention"
+	"github.com/insolar/insolar/ledger/storage"
+	"github.com/insolar/insolar/ledger/storage/blob"
+	"github.com/insolar/insolar/ledger/storage/drop"
+	"github.com/insolar/insolar/ledger/storage/object"
+	"github.com/insolar/insolar/ledger/storage/pulse"
+)
+
+// Exporter provides methods for fetching finalized pulses data and object history from storage.
+type Exporter struct {
+	PulseAccessor   pulse.Accessor              `inject:""`
+	PulseCalculator pulse.Calculator            `inject:""`
+	RecordIterator  object.RecordPulseIterator  `inject:""`
+	RecordAccessor  object.RecordAccessor       `inject:""`
+	BlobAccessor    blob.Accessor               `inject:""`
+	DropAccessor    drop.Accessor               `inject:""`
+	ObjectStorage   storage.ObjectStorage       `inject:""`
+	Tombstones      retention.TombstoneAccessor `inject:""`
+
+	cfg configuration.Exporter
+}
+
+// NewExporter creates new Exporter instance.
+func NewExporter(cfg configuration.Exporter) *Exporter {
+	return &Exporter{cfg: cfg}
+}
+
+// Export returns data of at most size finalized pulses starting from provided pulse.
+// Pulse is finalized when it's not the latest one and it's older than ExportLag.
+// Result is keyed by pulse number, NextFrom points to the pulse the next call should start from.
+func (e *Exporter) Export(ctx context.Context, fromPulse insolar.PulseNumber, size int) (*insolar.StorageExportResult, error) {
+	if size <= 0 {
+		return nil, errors.New("[ Export ] size should be positive")
+	}
+	if fromPulse < insolar.FirstPulseNumber {
+		fromPulse = insolar.FirstPulseNumber
+	}
+
+	latest, err := e.PulseAccessor.Latest(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Export ] failed to fetch latest pulse")
+	}
+	current, err := e.PulseAccessor.ForPulseNumber(ctx, fromPulse)
+	if err != nil {
+		return nil, errors.Wrapf(err, "[ Export ] failed to fetch pulse %v", fromPulse)
+	}
+
+	result := &insolar.StorageExportResult{Data: map[string]interface{}{}}
+	for result.Size < size && e.finalized(current, latest) {
+		data, err := e.exportPulse(ctx, current)
+		if err != nil {
+			return nil, errors.Wrapf(err, "[ Export ] failed to export pulse %v", current.PulseNumber)
+		}
+		result.Data[strconv.FormatUint(uint64(current.PulseNumber), 10)] = data
+		result.Size++
+
+		next, err := e.PulseCalculator.Forwards(ctx, current.PulseNumber, 1)
+		if err == pulse.ErrNotFound {
+			result.NextFrom = &current.NextPulseNumber
+			return result, nil
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "[ Export ] failed to fetch pulse after %v", current.PulseNumber)
+		}
+		current = next
+	}
+	result.NextFrom = &current.PulseNumber
+
+	return result, nil
+}
+
+func (e *Exporter) finalized(p, latest insolar.Pulse) bool {
+	if p.PulseNumber >= latest.PulseNumber {
+		return false
+	}
+	lag := time.Duration(e.cfg.ExportLag) * time.Second
+	return !time.Unix(p.PulseTimestamp, 0).Add(lag).After(time.Now())
+}
+
+func (e *Exporter) exportPulse(ctx context.Context, p insolar.Pulse) (*PulseData, error) {
+	data := &PulseData{
+		Pulse:   newPulseInfo(p),
+		Records: []RecordData{},
+		Drops:   []DropData{},
+		Indexes: []IndexData{},
+	}
+
+	jets := map[insolar.JetID]struct{}{}
+	objects := map[insolar.ID]insolar.JetID{}
+	err := e.RecordIterator.IterateOnPulse(ctx, p.PulseNumber, func(id insolar.ID, rec record.MaterialRecord) error {
+		recData, err := e.newRecordData(ctx, id, rec)
+		if err != nil {
+			return errors.Wrapf(err, "failed to export record %v", id.DebugString())
+		}
+		data.Records = append(data.Records, recData)
+		jets[rec.JetID] = struct{}{}
+		objID, err := e.objectOf(ctx, id, rec.Record)
+		if err != nil {
+			return errors.Wrapf(err, "failed to find object of record %v", id.DebugString())
+		}
+		if objID != nil {
+			objects[*objID] = rec.JetID
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to iterate records")
+	}
+
+	for _, jetID := range sortedJets(jets) {
+		d, err := e.DropAccessor.ForPulse(ctx, jetID, p.PulseNumber)
+		if err == drop.ErrNotFound || err == store.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to fetch drop for jet %v", jetID.DebugString())
+		}
+		data.Drops = append(data.Drops, newDropData(d))
+	}
+
+	for _, objID := range sortedIDs(objects) {
+		jetID := objects[objID]
+		idx, err := e.ObjectStorage.GetObjectIndex(ctx, insolar.ID(jetID), &objID)
+		if err == insolar.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to fetch index for object %v", objID.DebugString())
+		}
+		data.Indexes = append(data.Indexes, newIndexData(objID, *idx))
+	}
+
+	return data, nil
+}
+
+// objectOf returns id of the object provided record belongs to. Object of amend and deactivation records is taken
+// from their request. Nil is returned if the object is unknown.
+func (e *Exporter) objectOf(ctx context.Context, id insolar.ID, rec record.VirtualRecord) (*insolar.ID, error) {
+	var request insolar.Reference
+	switch r := rec.(type) {
+	case *object.ActivateRecord:
+		return &id, nil
+	case *object.RequestRecord:
+		return &r.Object, nil
+	case *object.ResultRecord:
+		return &r.Object, nil
+	case *object.AmendRecord:
+		request = r.Request
+	case *object.DeactivationRecord:
+		request = r.Request
+	default:
+		return nil, nil
+	}
+
+	reqRec, err := e.RecordAccessor.ForID(ctx, *request.Record())
+	if err == object.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch request %v", request.Record().DebugString())
+	}
+	req, ok := reqRec.Record.(*object.RequestRecord)
+	if !ok {
+		return nil, nil
+	}
+	return &req.Object, nil
+}
+
+func sortedJets(jets map[insolar.JetID]struct{}) []insolar.JetID {
+	res := make([]insolar.JetID, 0, len(jets))
+	for jetID := range jets {
+		res = append(res, jetID)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return string(res[i][:]) < string(res[j][:])
+	})
+	return res
+}
+
+func sortedIDs(ids map[insolar.ID]insolar.JetID) []insolar.ID {
+	res := make([]insolar.ID, 0, len(ids))
+	for id := range ids {
+		res = append(res, id)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return string(res[i][:]) < string(res[j][:])
+	})
+	return res
+}
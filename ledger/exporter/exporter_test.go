@@ -0,0 +1,248 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package exporter
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/storage"
+	"github.com/insolar/insolar/ledger/storage/blob"
+	"github.com/insolar/insolar/ledger/storage/drop"
+	"github.com/insolar/insolar/ledger/storage/object"
+	"github.com/insolar/insolar/ledger/storage/pulse"
+)
+
+func TestExporter_Export(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	pulses := pulse.NewStorageMem()
+	records := object.NewRecordMemory()
+	blobs := blob.NewStorageMemory()
+	drops := drop.NewStorageMemory()
+	objects := storage.NewObjectStorageMock(mc)
+
+	now := time.Now().Unix()
+	first := insolar.PulseNumber(insolar.FirstPulseNumber)
+	pns := []insolar.PulseNumber{first, first + 10, first + 20, first + 30}
+	timestamps := []int64{now - 100, now - 50, now - 1, now}
+	for i, pn := range pns {
+		p := insolar.Pulse{PulseNumber: pn, PulseTimestamp: timestamps[i]}
+		if i > 0 {
+			p.PrevPulseNumber = pns[i-1]
+		}
+		if i < len(pns)-1 {
+			p.NextPulseNumber = pns[i+1]
+		}
+		err := pulses.Append(ctx, p)
+		require.NoError(t, err)
+	}
+
+	jetID := gen.JetID()
+	memoryID := *insolar.NewID(first, []byte{1})
+	err := blobs.Set(ctx, memoryID, blob.Blob{Value: []byte{42}, JetID: jetID})
+	require.NoError(t, err)
+	objectID := *insolar.NewID(first, []byte{2})
+	err = records.Set(ctx, objectID, record.MaterialRecord{
+		Record: &object.ActivateRecord{StateRecord: object.StateRecord{Memory: &memoryID}},
+		JetID:  jetID,
+	})
+	require.NoError(t, err)
+	err = drops.Set(ctx, drop.Drop{Pulse: first, JetID: jetID, Size: 1})
+	require.NoError(t, err)
+	objects.GetObjectIndexMock.Expect(ctx, insolar.ID(jetID), &objectID).Return(&object.Lifeline{
+		LatestState: &objectID,
+		State:       object.StateActivation,
+		JetID:       jetID,
+	}, nil)
+
+	exporter := NewExporter(configuration.Exporter{ExportLag: 10})
+	exporter.PulseAccessor = pulses
+	exporter.PulseCalculator = pulses
+	exporter.RecordIterator = records
+	exporter.BlobAccessor = blobs
+	exporter.DropAccessor = drops
+	exporter.ObjectStorage = objects
+
+	t.Run("returns only finalized pulses", func(t *testing.T) {
+		res, err := exporter.Export(ctx, 0, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 2, res.Size)
+		require.NotNil(t, res.NextFrom)
+		assert.Equal(t, pns[2], *res.NextFrom)
+
+		data, ok := res.Data[strconv.FormatUint(uint64(first), 10)].(*PulseData)
+		require.True(t, ok)
+		assert.Equal(t, first, data.Pulse.PulseNumber)
+		require.Len(t, data.Records, 1)
+		assert.Equal(t, objectID.String(), data.Records[0].ID)
+		assert.Equal(t, "ActivateRecord", data.Records[0].Type)
+		assert.Equal(t, []byte{42}, data.Records[0].Payload["Memory"])
+		require.Len(t, data.Drops, 1)
+		assert.Equal(t, uint64(1), data.Drops[0].Size)
+		require.Len(t, data.Indexes, 1)
+		assert.Equal(t, "Activation", data.Indexes[0].State)
+
+		_, err = json.Marshal(res)
+		require.NoError(t, err)
+	})
+
+	t.Run("paginates by size", func(t *testing.T) {
+		res, err := exporter.Export(ctx, first, 1)
+		require.NoError(t, err)
+		assert.Equal(t, 1, res.Size)
+		require.NotNil(t, res.NextFrom)
+		assert.Equal(t, pns[1], *res.NextFrom)
+
+		res, err = exporter.Export(ctx, *res.NextFrom, 1)
+		require.NoError(t, err)
+		assert.Equal(t, 1, res.Size)
+		data := res.Data[strconv.FormatUint(uint64(pns[1]), 10)].(*PulseData)
+		assert.Empty(t, data.Records)
+	})
+
+	t.Run("fails on non-positive size", func(t *testing.T) {
+		_, err := exporter.Export(ctx, first, 0)
+		assert.Error(t, err)
+	})
+}
+
+func TestExporter_Export_AmendAndDeactivationIndexes(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	pulses := pulse.NewStorageMem()
+	records := object.NewRecordMemory()
+	objects := storage.NewObjectStorageMock(mc)
+
+	first := insolar.PulseNumber(insolar.FirstPulseNumber)
+	err := pulses.Append(ctx, insolar.Pulse{PulseNumber: first, NextPulseNumber: first + 10, PulseTimestamp: 0})
+	require.NoError(t, err)
+	err = pulses.Append(ctx, insolar.Pulse{PulseNumber: first + 10, PrevPulseNumber: first, PulseTimestamp: 0})
+	require.NoError(t, err)
+
+	jetID := gen.JetID()
+	amendedID := *insolar.NewID(first, []byte{1})
+	deactivatedID := *insolar.NewID(first, []byte{2})
+	for i, objID := range []insolar.ID{amendedID, deactivatedID} {
+		requestID := *insolar.NewID(first, []byte{byte(10 + i)})
+		err := records.Set(ctx, requestID, record.MaterialRecord{
+			Record: &object.RequestRecord{Object: objID},
+			JetID:  jetID,
+		})
+		require.NoError(t, err)
+
+		sideEffect := object.SideEffectRecord{Request: *insolar.NewReference(insolar.DomainID, requestID)}
+		var rec record.VirtualRecord = &object.AmendRecord{SideEffectRecord: sideEffect}
+		if objID == deactivatedID {
+			rec = &object.DeactivationRecord{SideEffectRecord: sideEffect}
+		}
+		err = records.Set(ctx, *insolar.NewID(first, []byte{byte(20 + i)}), record.MaterialRecord{
+			Record: rec,
+			JetID:  jetID,
+		})
+		require.NoError(t, err)
+	}
+	objects.GetObjectIndexFunc = func(
+		ctx context.Context, jet insolar.ID, id *insolar.ID,
+	) (*object.Lifeline, error) {
+		return &object.Lifeline{LatestState: id, State: object.StateAmend, JetID: jetID}, nil
+	}
+
+	exporter := NewExporter(configuration.Exporter{})
+	exporter.PulseAccessor = pulses
+	exporter.PulseCalculator = pulses
+	exporter.RecordIterator = records
+	exporter.RecordAccessor = records
+	exporter.DropAccessor = drop.NewStorageMemory()
+	exporter.ObjectStorage = objects
+
+	res, err := exporter.Export(ctx, first, 1)
+	require.NoError(t, err)
+	data := res.Data[strconv.FormatUint(uint64(first), 10)].(*PulseData)
+	require.Len(t, data.Records, 4)
+	require.Len(t, data.Indexes, 2)
+	assert.ElementsMatch(
+		t,
+		[]string{amendedID.String(), deactivatedID.String()},
+		[]string{data.Indexes[0].Object, data.Indexes[1].Object},
+	)
+}
+
+func TestExporter_Export_BlobError(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	pulses := pulse.NewStorageMem()
+	records := object.NewRecordMemory()
+	blobs := blob.NewAccessorMock(mc)
+
+	first := insolar.PulseNumber(insolar.FirstPulseNumber)
+	err := pulses.Append(ctx, insolar.Pulse{PulseNumber: first, NextPulseNumber: first + 10, PulseTimestamp: 0})
+	require.NoError(t, err)
+	err = pulses.Append(ctx, insolar.Pulse{PulseNumber: first + 10, PrevPulseNumber: first, PulseTimestamp: 0})
+	require.NoError(t, err)
+
+	codeID := *insolar.NewID(first, []byte{1})
+	err = records.Set(ctx, *insolar.NewID(first, []byte{2}), record.MaterialRecord{
+		Record: &object.CodeRecord{Code: &codeID},
+		JetID:  gen.JetID(),
+	})
+	require.NoError(t, err)
+
+	exporter := NewExporter(configuration.Exporter{})
+	exporter.PulseAccessor = pulses
+	exporter.PulseCalculator = pulses
+	exporter.RecordIterator = records
+	exporter.BlobAccessor = blobs
+
+	t.Run("skips missing blob", func(t *testing.T) {
+		blobs.ForIDMock.Return(blob.Blob{}, blob.ErrNotFound)
+		exporter.DropAccessor = drop.NewStorageMemory()
+
+		res, err := exporter.Export(ctx, first, 1)
+		require.NoError(t, err)
+		data := res.Data[strconv.FormatUint(uint64(first), 10)].(*PulseData)
+		require.Len(t, data.Records, 1)
+		assert.Nil(t, data.Records[0].Payload)
+	})
+
+	t.Run("returns blob storage error", func(t *testing.T) {
+		blobs.ForIDMock.Return(blob.Blob{}, errors.New("storage failure"))
+
+		_, err := exporter.Export(ctx, first, 1)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "storage failure")
+	})
+}
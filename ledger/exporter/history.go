@@ -0,0 +1,321 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package exporter
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/ugorji/go/codec"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/ledger/retention"
+	"github.com/insolar/insolar/ledger/storage/object"
+)
+
+// HistoryReader provides past states of objects.
+type HistoryReader interface {
+	// History returns all states of an object from the latest one to the activation. States removed by compaction
+	// are skipped.
+	History(ctx context.Context, head insolar.Reference) (*ObjectHistory, error)
+	// Memory returns decoded memory of an object at provided state. Nil state means the latest one.
+	Memory(ctx context.Context, head insolar.Reference, state *insolar.ID) (*ObjectMemory, error)
+}
+
+type (
+	// ObjectHistory is a lifeline of an object.
+	ObjectHistory struct {
+		Object string
+		// States are ordered from the latest one to the activation.
+		States []ObjectState
+	}
+
+	// ObjectState describes a single object state with request and result that produced it.
+	ObjectState struct {
+		ID      string
+		Pulse   insolar.PulseNumber
+		State   string
+		Memory  *string
+		Image   string
+		Request *RequestInfo
+		Result  *ResultInfo
+	}
+
+	// RequestInfo is an exported view of a request that produced object state.
+	RequestInfo struct {
+		ID          string
+		MessageType string      `json:",omitempty"`
+		Caller      string      `json:",omitempty"`
+		Method      string      `json:",omitempty"`
+		Arguments   interface{} `json:",omitempty"`
+	}
+
+	// ResultInfo is an exported view of a result of the request that produced object state.
+	ResultInfo struct {
+		ID      string
+		Payload interface{}
+	}
+
+	// ObjectMemory is a decoded memory of an object at some state.
+	ObjectMemory struct {
+		Object string
+		State  string
+		Pulse  insolar.PulseNumber
+		Memory interface{}
+	}
+)
+
+// History returns all states of an object from the latest one to the activation. Heavy material node keeps all
+// indexes in the root jet, so only it is able to provide full history. States removed by compaction are skipped.
+func (e *Exporter) History(ctx context.Context, head insolar.Reference) (*ObjectHistory, error) {
+	states, err := e.states(ctx, head)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ History ]")
+	}
+
+	history := &ObjectHistory{Object: head.String(), States: []ObjectState{}}
+	// Results are looked up among records of the state pulse and cached by pulse.
+	results := map[insolar.PulseNumber]map[insolar.Reference]insolar.ID{}
+	for _, s := range states {
+		state, err := e.newObjectState(ctx, s, results)
+		if err != nil {
+			return nil, errors.Wrapf(err, "[ History ] failed to export state %v", s.id.String())
+		}
+		history.States = append(history.States, *state)
+	}
+	return history, nil
+}
+
+// Memory returns decoded memory of an object at provided state. Nil state means the latest one.
+func (e *Exporter) Memory(ctx context.Context, head insolar.Reference, state *insolar.ID) (*ObjectMemory, error) {
+	states, err := e.states(ctx, head)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Memory ]")
+	}
+	if len(states) == 0 {
+		return nil, errors.New("[ Memory ] object has no states")
+	}
+
+	found := &states[0]
+	if state != nil {
+		found = nil
+		for i := range states {
+			if states[i].id == *state {
+				found = &states[i]
+				break
+			}
+		}
+		if found == nil {
+			return nil, errors.Errorf("[ Memory ] state %v doesn't belong to object %v", state.String(), head.String())
+		}
+	}
+
+	res := &ObjectMemory{Object: head.String(), State: found.id.String(), Pulse: found.id.Pulse()}
+	memoryID := found.state.GetMemory()
+	if memoryID == nil {
+		return res, nil
+	}
+	b, err := e.BlobAccessor.ForID(ctx, *memoryID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "[ Memory ] failed to fetch memory %v", memoryID.String())
+	}
+	res.Memory, err = decodeCBOR(b.Value)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Memory ] failed to decode memory")
+	}
+	return res, nil
+}
+
+type stateRecord struct {
+	id    insolar.ID
+	state object.State
+}
+
+// states returns states of an object from the latest one to the activation. States removed by compaction are
+// skipped using links to previous states from their tombstones.
+func (e *Exporter) states(ctx context.Context, head insolar.Reference) ([]stateRecord, error) {
+	rootJet := insolar.ID(*insolar.NewJetID(0, nil))
+	idx, err := e.ObjectStorage.GetObjectIndex(ctx, rootJet, head.Record())
+	if err == insolar.ErrNotFound {
+		return nil, errors.Errorf("object %v not found", head.String())
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch index of object %v", head.String())
+	}
+
+	var states []stateRecord
+	for id := idx.LatestState; id != nil; {
+		rec, err := e.RecordAccessor.ForID(ctx, *id)
+		if err == object.ErrNotFound {
+			t, tErr := e.Tombstones.ForID(ctx, *id)
+			if tErr == nil {
+				prev := t.PrevState
+				id = &prev
+				continue
+			}
+			if tErr != retention.ErrNotFound {
+				return nil, errors.Wrapf(tErr, "failed to fetch tombstone of state %v", id.String())
+			}
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to fetch state %v", id.String())
+		}
+		state, ok := rec.Record.(object.State)
+		if !ok {
+			return nil, errors.Errorf("record %v is not an object state", id.String())
+		}
+		states = append(states, stateRecord{id: *id, state: state})
+		id = state.PrevStateID()
+	}
+	return states, nil
+}
+
+func (e *Exporter) newObjectState(
+	ctx context.Context, s stateRecord, results map[insolar.PulseNumber]map[insolar.Reference]insolar.ID,
+) (*ObjectState, error) {
+	res := &ObjectState{
+		ID:     s.id.String(),
+		Pulse:  s.id.Pulse(),
+		State:  stateString(s.state.ID()),
+		Memory: idString(s.state.GetMemory()),
+	}
+	if image := s.state.GetImage(); image != nil {
+		res.Image = image.String()
+	}
+
+	request := sideEffectOf(s.state)
+	if request == nil || request.IsEmpty() {
+		return res, nil
+	}
+	info, err := e.newRequestInfo(ctx, *request)
+	if err != nil {
+		return nil, err
+	}
+	res.Request = info
+
+	pulseResults, ok := results[res.Pulse]
+	if !ok {
+		pulseResults = map[insolar.Reference]insolar.ID{}
+		err := e.RecordIterator.IterateOnPulse(ctx, res.Pulse, func(id insolar.ID, rec record.MaterialRecord) error {
+			if r, ok := rec.Record.(*object.ResultRecord); ok {
+				pulseResults[r.Request] = id
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to iterate records")
+		}
+		results[res.Pulse] = pulseResults
+	}
+	resultID, ok := pulseResults[*request]
+	if !ok {
+		return res, nil
+	}
+	rec, err := e.RecordAccessor.ForID(ctx, resultID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch result %v", resultID.String())
+	}
+	result := &ResultInfo{ID: resultID.String()}
+	if r, ok := rec.Record.(*object.ResultRecord); ok {
+		// Payload is left empty if it can't be decoded.
+		result.Payload, _ = decodeCBOR(r.Payload)
+	}
+	res.Result = result
+
+	return res, nil
+}
+
+func (e *Exporter) newRequestInfo(ctx context.Context, request insolar.Reference) (*RequestInfo, error) {
+	info := &RequestInfo{ID: request.Record().String()}
+	rec, err := e.RecordAccessor.ForID(ctx, *request.Record())
+	if err == object.ErrNotFound {
+		return info, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch request %v", request.String())
+	}
+	r, ok := rec.Record.(*object.RequestRecord)
+	if !ok {
+		return info, nil
+	}
+	parcel, err := message.DeserializeParcel(bytes.NewBuffer(r.Parcel))
+	if err != nil {
+		return info, nil
+	}
+
+	info.MessageType = parcel.Type().String()
+	var arguments insolar.Arguments
+	switch msg := parcel.Message().(type) {
+	case *message.CallMethod:
+		info.Caller = msg.Caller.String()
+		info.Method = msg.Method
+		arguments = msg.Arguments
+	case *message.CallConstructor:
+		info.Caller = msg.Caller.String()
+		info.Method = msg.Method
+		arguments = msg.Arguments
+	}
+	if len(arguments) > 0 {
+		// Arguments are left empty if they can't be decoded.
+		info.Arguments, _ = decodeCBOR(arguments)
+	}
+	return info, nil
+}
+
+// sideEffectOf returns reference to the request that produced provided state.
+func sideEffectOf(state object.State) *insolar.Reference {
+	switch r := state.(type) {
+	case *object.ActivateRecord:
+		return &r.Request
+	case *object.AmendRecord:
+		return &r.Request
+	case *object.DeactivationRecord:
+		return &r.Request
+	}
+	return nil
+}
+
+// decodeCBOR decodes contract data (memory, arguments, results) into a value that can be encoded to JSON.
+func decodeCBOR(buf []byte) (interface{}, error) {
+	var v interface{}
+	err := codec.NewDecoderBytes(buf, &codec.CborHandle{}).Decode(&v)
+	if err != nil {
+		return nil, err
+	}
+	return jsonValue(v), nil
+}
+
+func jsonValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		res := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			res[fmt.Sprint(key)] = jsonValue(val)
+		}
+		return res
+	case []interface{}:
+		for i := range v {
+			v[i] = jsonValue(v[i])
+		}
+		return v
+	}
+	return v
+}
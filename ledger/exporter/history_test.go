@@ -0,0 +1,185 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package exporter
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/gojuno/minimock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/retention"
+	"github.com/insolar/insolar/ledger/storage"
+	"github.com/insolar/insolar/ledger/storage/blob"
+	"github.com/insolar/insolar/ledger/storage/object"
+)
+
+func TestExporter_History(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	records := object.NewRecordMemory()
+	blobs := blob.NewStorageMemory()
+	objects := storage.NewObjectStorageMock(mc)
+
+	jetID := gen.JetID()
+	first := insolar.PulseNumber(insolar.FirstPulseNumber)
+	second := first + 10
+
+	setRecord := func(id insolar.ID, rec record.VirtualRecord) {
+		err := records.Set(ctx, id, record.MaterialRecord{Record: rec, JetID: jetID})
+		require.NoError(t, err)
+	}
+	setMemory := func(id insolar.ID, memory interface{}) {
+		buf, err := insolar.Serialize(memory)
+		require.NoError(t, err)
+		err = blobs.Set(ctx, id, blob.Blob{Value: buf, JetID: jetID})
+		require.NoError(t, err)
+	}
+	setRequest := func(id insolar.ID, msg insolar.Message) insolar.Reference {
+		setRecord(id, &object.RequestRecord{
+			Parcel: message.ParcelToBytes(&message.Parcel{Msg: msg}),
+		})
+		return *insolar.NewReference(id, id)
+	}
+
+	head := *insolar.NewID(first, []byte{1})
+	objRef := *insolar.NewReference(head, head)
+	caller := gen.Reference()
+
+	// Activation.
+	constructorRequest := setRequest(head, &message.CallConstructor{
+		BaseLogicMessage: message.BaseLogicMessage{Caller: caller},
+		Method:           "New",
+	})
+	activateMemory := *insolar.NewID(first, []byte{2})
+	setMemory(activateMemory, map[string]interface{}{"Balance": 100})
+	activateID := *insolar.NewID(first, []byte{3})
+	setRecord(activateID, &object.ActivateRecord{
+		SideEffectRecord: object.SideEffectRecord{Request: constructorRequest},
+		StateRecord:      object.StateRecord{Memory: &activateMemory},
+	})
+
+	// Amend with result.
+	args, err := insolar.MarshalArgs(uint64(50))
+	require.NoError(t, err)
+	methodRequest := setRequest(*insolar.NewID(second, []byte{4}), &message.CallMethod{
+		BaseLogicMessage: message.BaseLogicMessage{Caller: caller},
+		Method:           "Transfer",
+		Arguments:        args,
+	})
+	amendMemory := *insolar.NewID(second, []byte{5})
+	setMemory(amendMemory, map[string]interface{}{"Balance": 50})
+	amendID := *insolar.NewID(second, []byte{6})
+	setRecord(amendID, &object.AmendRecord{
+		SideEffectRecord: object.SideEffectRecord{Request: methodRequest},
+		StateRecord:      object.StateRecord{Memory: &amendMemory},
+		PrevState:        activateID,
+	})
+	resultPayload, err := insolar.Serialize([]interface{}{"ok"})
+	require.NoError(t, err)
+	resultID := *insolar.NewID(second, []byte{7})
+	setRecord(resultID, &object.ResultRecord{Object: head, Request: methodRequest, Payload: resultPayload})
+
+	objects.GetObjectIndexMock.Expect(ctx, insolar.ID(*insolar.NewJetID(0, nil)), &head).Return(&object.Lifeline{
+		LatestState: &amendID,
+		State:       object.StateAmend,
+		JetID:       jetID,
+	}, nil)
+
+	exporter := NewExporter(configuration.Exporter{})
+	exporter.RecordIterator = records
+	exporter.RecordAccessor = records
+	exporter.BlobAccessor = blobs
+	exporter.ObjectStorage = objects
+
+	t.Run("returns lifeline", func(t *testing.T) {
+		res, err := exporter.History(ctx, objRef)
+		require.NoError(t, err)
+		require.Len(t, res.States, 2)
+
+		amend := res.States[0]
+		assert.Equal(t, amendID.String(), amend.ID)
+		assert.Equal(t, second, amend.Pulse)
+		assert.Equal(t, "Amend", amend.State)
+		require.NotNil(t, amend.Request)
+		assert.Equal(t, "TypeCallMethod", amend.Request.MessageType)
+		assert.Equal(t, "Transfer", amend.Request.Method)
+		assert.Equal(t, caller.String(), amend.Request.Caller)
+		assert.Equal(t, []interface{}{uint64(50)}, amend.Request.Arguments)
+		require.NotNil(t, amend.Result)
+		assert.Equal(t, resultID.String(), amend.Result.ID)
+		assert.Equal(t, []interface{}{"ok"}, amend.Result.Payload)
+
+		activate := res.States[1]
+		assert.Equal(t, activateID.String(), activate.ID)
+		assert.Equal(t, "Activation", activate.State)
+		require.NotNil(t, activate.Request)
+		assert.Equal(t, "New", activate.Request.Method)
+		assert.Nil(t, activate.Result)
+
+		_, err = json.Marshal(res)
+		require.NoError(t, err)
+	})
+
+	t.Run("returns memory at state", func(t *testing.T) {
+		res, err := exporter.Memory(ctx, objRef, nil)
+		require.NoError(t, err)
+		assert.Equal(t, amendID.String(), res.State)
+		assert.Equal(t, map[string]interface{}{"Balance": uint64(50)}, res.Memory)
+
+		res, err = exporter.Memory(ctx, objRef, &activateID)
+		require.NoError(t, err)
+		assert.Equal(t, first, res.Pulse)
+		assert.Equal(t, map[string]interface{}{"Balance": uint64(100)}, res.Memory)
+
+		_, err = json.Marshal(res)
+		require.NoError(t, err)
+	})
+
+	t.Run("fails on foreign state", func(t *testing.T) {
+		_, err := exporter.Memory(ctx, objRef, &resultID)
+		assert.Error(t, err)
+	})
+
+	t.Run("skips compacted states", func(t *testing.T) {
+		compactedID := *insolar.NewID(second+10, []byte{8})
+		objects.GetObjectIndexMock.Expect(ctx, insolar.ID(*insolar.NewJetID(0, nil)), &head).Return(&object.Lifeline{
+			LatestState: &compactedID,
+			State:       object.StateAmend,
+			JetID:       jetID,
+		}, nil)
+		tombstones := retention.NewTombstoneAccessorMock(mc)
+		tombstones.ForIDMock.Expect(ctx, compactedID).Return(retention.Tombstone{JetID: jetID, PrevState: amendID}, nil)
+		exporter.Tombstones = tombstones
+
+		res, err := exporter.History(ctx, objRef)
+		require.NoError(t, err)
+		require.Len(t, res.States, 2)
+		assert.Equal(t, amendID.String(), res.States[0].ID)
+		assert.Equal(t, activateID.String(), res.States[1].ID)
+	})
+}
@@ -16,10 +16,64 @@
 
 package heavy
 
-import "github.com/insolar/insolar/ledger/heavy/internal/handler"
+import (
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/backup"
+	"github.com/insolar/insolar/ledger/exporter"
+	"github.com/insolar/insolar/ledger/heavy/internal/handler"
+	"github.com/insolar/insolar/ledger/heavy/internal/pulsemanager"
+	"github.com/insolar/insolar/ledger/heavyserver"
+	"github.com/insolar/insolar/ledger/jetcoordinator"
+	"github.com/insolar/insolar/ledger/retention"
+	"github.com/insolar/insolar/ledger/storage"
+	"github.com/insolar/insolar/ledger/storage/blob"
+	"github.com/insolar/insolar/ledger/storage/drop"
+	"github.com/insolar/insolar/ledger/storage/genesis"
+	"github.com/insolar/insolar/ledger/storage/node"
+	"github.com/insolar/insolar/ledger/storage/object"
+	"github.com/insolar/insolar/ledger/storage/pulse"
+	"github.com/insolar/insolar/ledger/storage/secondary"
+)
+
+// Components returns ledger components of heavy material node. Heavy node keeps all data on disk,
+// receives it from light nodes via heavy sync and serves reads. It has no hot data and jet split logic.
+func Components(conf configuration.Ledger) []interface{} {
+	legacyDB, err := storage.NewDB(conf, nil)
+	if err != nil {
+		panic(errors.Wrap(err, "failed to initialize DB"))
+	}
+
+	db, err := store.NewBackend(conf.Storage)
+	if err != nil {
+		panic(errors.Wrap(err, "failed to initialize DB"))
+	}
+
+	records := object.NewRecordDB(db)
 
-func Components() []interface{} {
 	return []interface{}{
+		legacyDB,
+		db,
+		pulse.NewStorageDB(db),
+		drop.NewStorageDB(db),
+		blob.NewStorageDB(db),
+		records,
+		secondary.NewStorageDB(db),
+		retention.NewTombstoneDB(db),
+		jet.NewStore(),
+		node.NewStorageDB(db),
+		storage.NewObjectStorage(),
+		storage.NewReplicaStorage(),
+		genesis.NewGenesisInitializer(),
+		jetcoordinator.NewJetCoordinator(conf.LightChainLimit),
+		heavyserver.NewSync(legacyDB, records),
+		exporter.NewExporter(conf.Exporter),
+		backup.NewMaker(conf.Backup),
+		retention.NewCompactor(conf.Retention, conf.LightChainLimit),
+		pulsemanager.New(),
 		handler.New(),
 	}
 }
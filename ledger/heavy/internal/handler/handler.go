@@ -28,6 +28,7 @@ import (
 	"github.com/insolar/insolar/insolar/reply"
 	"github.com/insolar/insolar/ledger/storage"
 	"github.com/insolar/insolar/ledger/storage/object"
+	"github.com/insolar/insolar/ledger/storage/secondary"
 )
 
 type Handler struct {
@@ -39,8 +40,12 @@ type Handler struct {
 	// TODO: @imarkin 27.03.2019 - remove it after all new storages integration (INS-2013, etc)
 	ObjectStorage storage.ObjectStorage `inject:""`
 
-	BlobAccessor blob.Accessor         `inject:""`
-	Records      object.RecordAccessor `inject:""`
+	BlobAccessor   blob.Accessor              `inject:""`
+	Records        object.RecordAccessor      `inject:""`
+	RecordIterator object.RecordPulseIterator `inject:""`
+
+	SecondaryIndex         secondary.Accessor `inject:""`
+	SecondaryIndexModifier secondary.Modifier `inject:""`
 
 	jetID insolar.JetID
 }
@@ -53,6 +58,12 @@ func New() *Handler {
 }
 
 func (h *Handler) Init(ctx context.Context) error {
+	// Genesis records are written directly to the storage, they are not received by replication.
+	err := secondary.IndexPulse(ctx, h.RecordIterator, h.SecondaryIndexModifier, insolar.GenesisPulse.PulseNumber)
+	if err != nil {
+		return errors.Wrap(err, "failed to index genesis objects")
+	}
+
 	h.Bus.MustRegister(insolar.TypeHeavyStartStop, h.handleHeavyStartStop)
 	h.Bus.MustRegister(insolar.TypeHeavyPayload, h.handleHeavyPayload)
 
@@ -62,6 +73,8 @@ func (h *Handler) Init(ctx context.Context) error {
 	h.Bus.MustRegister(insolar.TypeGetChildren, h.handleGetChildren)
 	h.Bus.MustRegister(insolar.TypeGetObjectIndex, h.handleGetObjectIndex)
 	h.Bus.MustRegister(insolar.TypeGetRequest, h.handleGetRequest)
+	h.Bus.MustRegister(insolar.TypeGetObjectsByPrototype, h.handleGetObjectsByPrototype)
+	h.Bus.MustRegister(insolar.TypeGetObjectsByParent, h.handleGetObjectsByParent)
 	return nil
 }
 
@@ -279,6 +292,28 @@ func (h *Handler) handleGetObjectIndex(ctx context.Context, parcel insolar.Parce
 	return &reply.ObjectIndex{Index: buf}, nil
 }
 
+func (h *Handler) handleGetObjectsByPrototype(ctx context.Context, parcel insolar.Parcel) (insolar.Reply, error) {
+	msg := parcel.Message().(*message.GetObjectsByPrototype)
+
+	refs, err := h.SecondaryIndex.ForPrototype(ctx, msg.Prototype, msg.FromPulse, msg.ToPulse)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch objects by prototype")
+	}
+
+	return &reply.ObjectHeads{Refs: refs}, nil
+}
+
+func (h *Handler) handleGetObjectsByParent(ctx context.Context, parcel insolar.Parcel) (insolar.Reply, error) {
+	msg := parcel.Message().(*message.GetObjectsByParent)
+
+	refs, err := h.SecondaryIndex.ForParent(ctx, msg.Parent, msg.FromPulse, msg.ToPulse)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch objects by parent")
+	}
+
+	return &reply.ObjectHeads{Refs: refs}, nil
+}
+
 func (h *Handler) getCode(ctx context.Context, id *insolar.ID) (*object.CodeRecord, error) {
 	rec, err := h.Records.ForID(ctx, *id)
 	if err != nil {
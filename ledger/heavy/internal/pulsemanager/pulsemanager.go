@@ -0,0 +1,133 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package pulsemanager
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/retention"
+	"github.com/insolar/insolar/ledger/storage/node"
+	"github.com/insolar/insolar/ledger/storage/pulse"
+)
+
+// ActiveListSwapper is required by network to swap active list.
+type ActiveListSwapper interface {
+	MoveSyncToActive(ctx context.Context) error
+}
+
+// PulseManager implements insolar.PulseManager for heavy material node. It only persists pulses and active nodes,
+// all jets processing happens on light material nodes.
+type PulseManager struct {
+	Bus               insolar.MessageBus        `inject:""`
+	NodeNet           insolar.NodeNetwork       `inject:""`
+	GIL               insolar.GlobalInsolarLock `inject:""`
+	ActiveListSwapper ActiveListSwapper         `inject:""`
+
+	PulseAppender pulse.Appender      `inject:""`
+	NodeSetter    node.Modifier       `inject:""`
+	Compactor     retention.Compactor `inject:""`
+
+	// setLock locks Set method call.
+	setLock sync.Mutex
+	// saves PM stopping mode
+	stopped bool
+}
+
+// New creates a new heavy pulse manager.
+func New() *PulseManager {
+	return &PulseManager{}
+}
+
+// Start registers origin node as active for the first pulse.
+func (m *PulseManager) Start(ctx context.Context) error {
+	origin := m.NodeNet.GetOrigin()
+	err := m.NodeSetter.Set(insolar.FirstPulseNumber, []insolar.Node{{ID: origin.ID(), Role: origin.Role()}})
+	if err != nil && err != node.ErrOverride {
+		return err
+	}
+	return nil
+}
+
+// Stop stops PulseManager. There should not be any Set calls after Stop.
+func (m *PulseManager) Stop(ctx context.Context) error {
+	m.setLock.Lock()
+	m.stopped = true
+	m.setLock.Unlock()
+	return nil
+}
+
+// Set saves new pulse and active nodes for it. If persist is false, nothing will be saved to storage.
+func (m *PulseManager) Set(ctx context.Context, newPulse insolar.Pulse, persist bool) error {
+	m.setLock.Lock()
+	defer m.setLock.Unlock()
+	if m.stopped {
+		return errors.New("can't call Set method on PulseManager after stop")
+	}
+
+	err := m.setUnderGilSection(ctx, newPulse, persist)
+	if err != nil {
+		return err
+	}
+	if persist {
+		m.Compactor.OnPulse(ctx, newPulse.PulseNumber)
+	}
+
+	err = m.Bus.OnPulse(ctx, newPulse)
+	if err != nil {
+		inslogger.FromContext(ctx).Error(errors.Wrap(err, "MessageBus OnPulse() returns error"))
+	}
+
+	return nil
+}
+
+func (m *PulseManager) setUnderGilSection(ctx context.Context, newPulse insolar.Pulse, persist bool) error {
+	m.GIL.Acquire(ctx)
+	defer m.GIL.Release(ctx)
+
+	inslogger.FromContext(ctx).WithFields(map[string]interface{}{
+		"new_pulse": newPulse.PulseNumber,
+		"persist":   persist,
+	}).Debugf("received pulse")
+
+	err := m.ActiveListSwapper.MoveSyncToActive(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to apply new active node list")
+	}
+	if !persist {
+		return nil
+	}
+
+	if err := m.PulseAppender.Append(ctx, newPulse); err != nil {
+		return errors.Wrap(err, "call of AddPulse failed")
+	}
+	fromNetwork := m.NodeNet.GetWorkingNodes()
+	toSet := make([]insolar.Node, 0, len(fromNetwork))
+	for _, n := range fromNetwork {
+		toSet = append(toSet, insolar.Node{ID: n.ID(), Role: n.Role()})
+	}
+	err = m.NodeSetter.Set(newPulse.PulseNumber, toSet)
+	if err != nil {
+		return errors.Wrap(err, "call of SetActiveNodes failed")
+	}
+
+	return nil
+}
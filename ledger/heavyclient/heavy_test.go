@@ -46,6 +46,7 @@ import (
 	"github.com/insolar/insolar/ledger/storage/drop"
 	"github.com/insolar/insolar/ledger/storage/node"
 	"github.com/insolar/insolar/ledger/storage/object"
+	"github.com/insolar/insolar/ledger/storage/secondary"
 	"github.com/insolar/insolar/ledger/storage/storagetest"
 	"github.com/insolar/insolar/testutils"
 	"github.com/insolar/insolar/testutils/network"
@@ -73,6 +74,7 @@ type heavySuite struct {
 	recordModifier  object.RecordModifier
 	recordCleaner   object.RecordCleaner
 	recSyncAccessor object.RecordCollectionAccessor
+	recordIterator  object.RecordPulseIterator
 	storageCleaner  storage.Cleaner
 	pulseStorage    *pulse.StorageMem
 }
@@ -112,6 +114,7 @@ func (s *heavySuite) BeforeTest(suiteName, testName string) {
 	s.recordModifier = recordStorage
 	s.recordCleaner = recordStorage
 	s.recSyncAccessor = recordStorage
+	s.recordIterator = recordStorage
 
 	s.storageCleaner = storage.NewCleaner()
 
@@ -281,6 +284,7 @@ func sendToHeavy(s *heavySuite, withretry bool) {
 		s.pulseStorage,
 		s.recordCleaner,
 		s.recSyncAccessor,
+		secondary.NewStorageMemory(),
 	)
 	pm.NodeNet = nodenetMock
 	pm.Bus = busMock
@@ -296,6 +300,7 @@ func sendToHeavy(s *heavySuite, withretry bool) {
 	pm.ObjectStorage = s.objectStorage
 	pm.DropAccessor = s.dropAccessor
 	pm.DropModifier = s.dropModifier
+	pm.RecordIterator = s.recordIterator
 	pm.PulseAppender = s.pulseStorage
 	pm.PulseAccessor = s.pulseStorage
 	pm.PulseCalculator = s.pulseStorage
@@ -25,6 +25,7 @@ import (
 	"github.com/insolar/insolar/ledger/storage/blob"
 	"github.com/insolar/insolar/ledger/storage/drop"
 	"github.com/insolar/insolar/ledger/storage/object"
+	"github.com/insolar/insolar/ledger/storage/secondary"
 	"github.com/pkg/errors"
 	"go.opencensus.io/stats"
 
@@ -84,6 +85,7 @@ type Sync struct {
 	DropModifier               drop.Modifier                      `inject:""`
 	BlobModifier               blob.Modifier                      `inject:""`
 	ReplicaStorage             storage.ReplicaStorage             `inject:""`
+	SecondaryIndex             secondary.Modifier                 `inject:""`
 	DBContext                  storage.DBContext
 
 	RecordModifier object.RecordModifier
@@ -276,6 +278,13 @@ func (s *Sync) StoreRecords(ctx context.Context, jetID insolar.ID, pn insolar.Pu
 			inslog.Error(err, "heavyserver: store record failed")
 			continue
 		}
+
+		if activate, ok := virtRec.(*object.ActivateRecord); ok {
+			err = secondary.AddActivation(ctx, s.SecondaryIndex, activate)
+			if err != nil {
+				inslog.Error(err, "heavyserver: secondary index update failed")
+			}
+		}
 	}
 }
 
@@ -25,20 +25,23 @@ import (
 	"github.com/insolar/insolar/insolar/jet"
 	"github.com/insolar/insolar/internal/ledger/store"
 	"github.com/insolar/insolar/ledger/artifactmanager"
-	"github.com/insolar/insolar/ledger/heavy"
+	"github.com/insolar/insolar/ledger/backup"
+	"github.com/insolar/insolar/ledger/exporter"
 	"github.com/insolar/insolar/ledger/heavyserver"
 	"github.com/insolar/insolar/ledger/jetcoordinator"
 	"github.com/insolar/insolar/ledger/pulsemanager"
 	"github.com/insolar/insolar/ledger/recentstorage"
+	"github.com/insolar/insolar/ledger/retention"
 	"github.com/insolar/insolar/ledger/storage"
 	"github.com/insolar/insolar/ledger/storage/blob"
 	"github.com/insolar/insolar/ledger/storage/drop"
 	"github.com/insolar/insolar/ledger/storage/genesis"
 	"github.com/insolar/insolar/ledger/storage/node"
 	"github.com/insolar/insolar/ledger/storage/object"
+	"github.com/insolar/insolar/ledger/storage/secondary"
 )
 
-// GetLedgerComponents returns ledger components.
+// GetLedgerComponents returns ledger components. Heavy material node has its own set of components (see heavy.Components).
 func GetLedgerComponents(conf configuration.Ledger, certificate insolar.Certificate) []interface{} {
 	idLocker := storage.NewIDLocker()
 
@@ -47,7 +50,7 @@ func GetLedgerComponents(conf configuration.Ledger, certificate insolar.Certific
 		panic(errors.Wrap(err, "failed to initialize DB"))
 	}
 
-	db, err := store.NewBadgerDB(conf.Storage.DataDirectoryNewDB)
+	db, err := store.NewBackend(conf.Storage)
 	if err != nil {
 		panic(errors.Wrap(err, "failed to initialize DB"))
 	}
@@ -70,9 +73,15 @@ func GetLedgerComponents(conf configuration.Ledger, certificate insolar.Certific
 	var recordAccessor object.RecordAccessor
 	var recSyncAccessor object.RecordCollectionAccessor
 	var recordCleaner object.RecordCleaner
+
+	var secondaryIndex interface {
+		secondary.Accessor
+		secondary.Modifier
+	}
+	var secondaryCleaner secondary.Cleaner
 	// Comparision with insolar.StaticRoleUnknown is a hack for genesis pulse (INS-1537)
 	switch certificate.GetRole() {
-	case insolar.StaticRoleUnknown, insolar.StaticRoleHeavyMaterial:
+	case insolar.StaticRoleUnknown:
 		ps := pulse.NewStorageDB(db)
 		pulseAccessor = ps
 		pulseAppender = ps
@@ -90,6 +99,9 @@ func GetLedgerComponents(conf configuration.Ledger, certificate insolar.Certific
 		records := object.NewRecordDB(db)
 		recordModifier = records
 		recordAccessor = records
+
+		// Genesis objects are indexed in persistent storage as other genesis data.
+		secondaryIndex = secondary.NewStorageDB(db)
 	default:
 		ps := pulse.NewStorageMem()
 		pulseAccessor = ps
@@ -113,9 +125,13 @@ func GetLedgerComponents(conf configuration.Ledger, certificate insolar.Certific
 		recordAccessor = records
 		recSyncAccessor = records
 		recordCleaner = records
+
+		indexes := secondary.NewStorageMemory()
+		secondaryIndex = indexes
+		secondaryCleaner = indexes
 	}
 
-	pm := pulsemanager.NewPulseManager(conf, dropCleaner, blobCleaner, blobCollectionAccessor, pulseShifter, recordCleaner, recSyncAccessor)
+	pm := pulsemanager.NewPulseManager(conf, dropCleaner, blobCleaner, blobCollectionAccessor, pulseShifter, recordCleaner, recSyncAccessor, secondaryCleaner)
 
 	components := []interface{}{
 		legacyDB,
@@ -130,9 +146,10 @@ func GetLedgerComponents(conf configuration.Ledger, certificate insolar.Certific
 		pulseCalculator,
 		recordModifier,
 		recordAccessor,
+		secondaryIndex,
 		storage.NewCleaner(),
 		jet.NewStore(),
-		node.NewStorage(),
+		node.NewStorageDB(db),
 		storage.NewObjectStorage(),
 		storage.NewReplicaStorage(),
 		genesis.NewGenesisInitializer(),
@@ -140,15 +157,15 @@ func GetLedgerComponents(conf configuration.Ledger, certificate insolar.Certific
 		artifactmanager.NewHotDataWaiterConcrete(),
 		jetcoordinator.NewJetCoordinator(conf.LightChainLimit),
 		heavyserver.NewSync(legacyDB, recordModifier),
+		retention.NewTombstoneDB(db),
+		exporter.NewExporter(conf.Exporter),
+		backup.NewMaker(conf.Backup),
 	}
 
 	switch certificate.GetRole() {
 	case insolar.StaticRoleUnknown, insolar.StaticRoleLightMaterial:
 		components = append(components, artifactmanager.NewMessageHandler(&conf))
 		components = append(components, pm)
-	case insolar.StaticRoleHeavyMaterial:
-		components = append(components, pm)
-		components = append(components, heavy.Components()...)
 	}
 
 	return components
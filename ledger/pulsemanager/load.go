@@ -0,0 +1,92 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package pulsemanager
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/storage/object"
+)
+
+// jetLoad describes amount of data written to jet during the pulse.
+type jetLoad struct {
+	size    uint64
+	records int
+}
+
+// measureLoad calculates size of records and blobs saved in jet for provided pulse.
+func (m *PulseManager) measureLoad(ctx context.Context, jetID insolar.JetID, pn insolar.PulseNumber) jetLoad {
+	var load jetLoad
+	for _, rec := range m.RecSyncAccessor.ForPulse(ctx, jetID, pn) {
+		load.size += uint64(len(object.EncodeMaterial(rec)))
+		load.records++
+	}
+	for _, b := range m.BlobSyncAccessor.ForPulse(ctx, jetID, pn) {
+		load.size += uint64(len(b.Value))
+	}
+	return load
+}
+
+// needSplit returns true if jet load exceeds split thresholds.
+func (m *PulseManager) needSplit(load jetLoad) bool {
+	if m.options.splitThreshold > 0 && load.size >= m.options.splitThreshold {
+		return true
+	}
+	return m.options.splitRecordsThreshold > 0 && load.records >= m.options.splitRecordsThreshold
+}
+
+// canJoin returns true if jet load is low enough to join the jet with its sibling.
+func (m *PulseManager) canJoin(load jetLoad) bool {
+	return m.options.mergeThreshold > 0 && load.size < m.options.mergeThreshold && !m.needSplit(load)
+}
+
+// mergeHotData adds hot indexes and pending requests of one jet to another one.
+// Unlike rewriteHotData it keeps data already stored for the target jet.
+func (m *PulseManager) mergeHotData(ctx context.Context, fromJetID, toJetID insolar.ID) error {
+	logger := inslogger.FromContext(ctx).WithFields(map[string]interface{}{
+		"from_jet": fromJetID.DebugString(),
+		"to_jet":   toJetID.DebugString(),
+	})
+
+	toIndexes := m.RecentStorageProvider.GetIndexStorage(ctx, toJetID)
+	for id, ttl := range m.RecentStorageProvider.GetIndexStorage(ctx, fromJetID).GetObjects() {
+		idx, err := m.ObjectStorage.GetObjectIndex(ctx, fromJetID, &id)
+		if err != nil {
+			if err == insolar.ErrNotFound {
+				logger.WithField("id", id.DebugString()).Error("merge index not found")
+				continue
+			}
+			return errors.Wrap(err, "failed to merge index")
+		}
+		err = m.ObjectStorage.SetObjectIndex(ctx, toJetID, &id, idx)
+		if err != nil {
+			return errors.Wrap(err, "failed to merge index")
+		}
+		toIndexes.AddObjectWithTLL(ctx, id, ttl)
+	}
+
+	toPendings := m.RecentStorageProvider.GetPendingStorage(ctx, toJetID)
+	for id, pending := range m.RecentStorageProvider.GetPendingStorage(ctx, fromJetID).GetRequests() {
+		toPendings.SetContextToObject(ctx, id, pending)
+	}
+
+	return nil
+}
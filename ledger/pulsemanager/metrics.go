@@ -25,6 +25,8 @@ var (
 	statCleanLatencyTotal = stats.Int64("lightcleanup/latency/total", "Light storage cleanup time in milliseconds", stats.UnitMilliseconds)
 	statHotObjectsSent    = stats.Int64("hotdata/objects/total", "Amount of hot objects sent to the next executor", stats.UnitDimensionless)
 	statPendingSent       = stats.Int64("hotdata/pending/total", "Amount of pending requests sent to the next executor", stats.UnitDimensionless)
+	statJetSplits         = stats.Int64("jets/split/total", "Amount of jet splits performed", stats.UnitDimensionless)
+	statJetJoins          = stats.Int64("jets/join/total", "Amount of jet joins performed", stats.UnitDimensionless)
 )
 
 func init() {
@@ -50,6 +52,20 @@ func init() {
 			Measure:     statPendingSent,
 			Aggregation: view.Sum(),
 		},
+
+		&view.View{
+			Name:        statJetSplits.Name(),
+			Description: statJetSplits.Description(),
+			Measure:     statJetSplits,
+			Aggregation: view.Count(),
+		},
+
+		&view.View{
+			Name:        statJetJoins.Name(),
+			Description: statJetJoins.Description(),
+			Measure:     statJetJoins,
+			Aggregation: view.Count(),
+		},
 	)
 	if err != nil {
 		panic(err)
@@ -19,7 +19,6 @@ package pulsemanager
 import (
 	"context"
 	"fmt"
-	"math/rand"
 	"sync"
 	"time"
 
@@ -44,6 +43,7 @@ import (
 	"github.com/insolar/insolar/ledger/storage/drop"
 	"github.com/insolar/insolar/ledger/storage/node"
 	"github.com/insolar/insolar/ledger/storage/object"
+	"github.com/insolar/insolar/ledger/storage/secondary"
 )
 
 //go:generate minimock -i github.com/insolar/insolar/ledger/pulsemanager.ActiveListSwapper -o ../../testutils -s _mock.go
@@ -89,8 +89,11 @@ type PulseManager struct {
 	BlobCleaner      blob.Cleaner
 
 	RecSyncAccessor object.RecordCollectionAccessor
+	RecordIterator  object.RecordPulseIterator `inject:""`
 	RecCleaner      object.RecordCleaner
 
+	SecondaryCleaner secondary.Cleaner
+
 	syncClientsPool *heavyclient.Pool
 
 	currentPulse insolar.Pulse
@@ -107,14 +110,19 @@ type PulseManager struct {
 type jetInfo struct {
 	id       insolar.JetID
 	mineNext bool
+	size     uint64
 	left     *jetInfo
 	right    *jetInfo
+	// parent is set for both jets joined into it.
+	parent *jetInfo
 }
 
 // Just store ledger configuration in PM. This is not required.
 type pmOptions struct {
 	enableSync            bool
 	splitThreshold        uint64
+	splitRecordsThreshold int
+	mergeThreshold        uint64
 	dropHistorySize       int
 	storeLightPulses      int
 	heavySyncMessageLimit int
@@ -130,6 +138,7 @@ func NewPulseManager(
 	pulseShifter pulse.Shifter,
 	recCleaner object.RecordCleaner,
 	recSyncAccessor object.RecordCollectionAccessor,
+	secondaryCleaner secondary.Cleaner,
 ) *PulseManager {
 	pmconf := conf.PulseManager
 
@@ -138,6 +147,8 @@ func NewPulseManager(
 		options: pmOptions{
 			enableSync:            pmconf.HeavySyncEnabled,
 			splitThreshold:        pmconf.SplitThreshold,
+			splitRecordsThreshold: pmconf.SplitRecordsThreshold,
+			mergeThreshold:        pmconf.MergeThreshold,
 			storeLightPulses:      conf.LightChainLimit,
 			heavySyncMessageLimit: pmconf.HeavySyncMessageLimit,
 			lightChainLimit:       conf.LightChainLimit,
@@ -148,6 +159,7 @@ func NewPulseManager(
 		PulseShifter:     pulseShifter,
 		RecCleaner:       recCleaner,
 		RecSyncAccessor:  recSyncAccessor,
+		SecondaryCleaner: secondaryCleaner,
 	}
 	return pm
 }
@@ -163,34 +175,59 @@ func (m *PulseManager) processEndPulse(
 	defer span.End()
 
 	logger := inslogger.FromContext(ctx)
+	sender := func(msg message.HotData, jetID insolar.JetID) {
+		ctx, span := instracer.StartSpan(ctx, "pulse.send_hot")
+		defer span.End()
+		msg.Jet = *insolar.NewReference(insolar.DomainID, insolar.ID(jetID))
+		genericRep, err := m.Bus.Send(ctx, &msg, nil)
+		if err != nil {
+			logger.WithField("err", err).Error("failed to send hot data")
+			return
+		}
+		if _, ok := genericRep.(*reply.OK); !ok {
+			logger.WithField(
+				"err",
+				fmt.Sprintf("unexpected reply: %T", genericRep),
+			).Error("failed to send hot data")
+			return
+		}
+	}
+
+	// Hot data of joined jets is collected by parent and sent as a single message after all drops are created.
+	var joinedLock sync.Mutex
+	joined := map[*jetInfo][]*message.HotData{}
+
+	// Records are grouped by jet in a single pass over the pulse.
+	jetRecords, err := object.RecordIDsByJet(ctx, m.RecordIterator, currentPulse.PulseNumber)
+	if err != nil {
+		return errors.Wrap(err, "can't fetch drop records")
+	}
+
 	for _, i := range jets {
 		info := i
 
 		g.Go(func() error {
-			drop, dropSerialized, _, err := m.createDrop(ctx, insolar.ID(info.id), prevPulseNumber, currentPulse.PulseNumber)
+			drop, dropSerialized, _, err := m.createDrop(
+				ctx, insolar.ID(info.id), info.size, jetRecords[info.id], prevPulseNumber, currentPulse.PulseNumber,
+			)
 			if err != nil {
 				return errors.Wrapf(err, "create drop on pulse %v failed", currentPulse.PulseNumber)
 			}
 
-			sender := func(msg message.HotData, jetID insolar.JetID) {
-				ctx, span := instracer.StartSpan(ctx, "pulse.send_hot")
-				defer span.End()
-				msg.Jet = *insolar.NewReference(insolar.DomainID, insolar.ID(jetID))
-				genericRep, err := m.Bus.Send(ctx, &msg, nil)
+			if info.parent != nil {
+				msg, err := m.getExecutorHotData(
+					ctx, insolar.ID(info.id), newPulse.PulseNumber, drop, dropSerialized,
+				)
 				if err != nil {
-					logger.WithField("err", err).Error("failed to send hot data")
-					return
+					return errors.Wrapf(err, "getExecutorData failed for jet id %v", info.id)
 				}
-				if _, ok := genericRep.(*reply.OK); !ok {
-					logger.WithField(
-						"err",
-						fmt.Sprintf("unexpected reply: %T", genericRep),
-					).Error("failed to send hot data")
-					return
+				// Join happened.
+				if !info.parent.mineNext {
+					joinedLock.Lock()
+					joined[info.parent] = append(joined[info.parent], msg)
+					joinedLock.Unlock()
 				}
-			}
-
-			if info.left == nil && info.right == nil {
+			} else if info.left == nil && info.right == nil {
 				msg, err := m.getExecutorHotData(
 					ctx, insolar.ID(info.id), newPulse.PulseNumber, drop, dropSerialized,
 				)
@@ -222,17 +259,47 @@ func (m *PulseManager) processEndPulse(
 			return nil
 		})
 	}
-	err := g.Wait()
+	err = g.Wait()
 	if err != nil {
 		return errors.Wrap(err, "got error on jets sync")
 	}
 
+	for parent, msgs := range joined {
+		go sender(*mergeJoinedHotData(msgs), parent.id)
+	}
+
 	return nil
 }
 
+// mergeJoinedHotData merges hot data of joined jets into one message, so the parent jet receives it at once.
+func mergeJoinedHotData(msgs []*message.HotData) *message.HotData {
+	merged := *msgs[0]
+	if len(msgs) == 1 {
+		return &merged
+	}
+
+	sibling := msgs[1]
+	merged.SiblingDrop = &sibling.Drop
+	merged.RecentObjects = make(map[insolar.ID]message.HotIndex, len(msgs[0].RecentObjects)+len(sibling.RecentObjects))
+	merged.PendingRequests = make(
+		map[insolar.ID]recentstorage.PendingObjectContext, len(msgs[0].PendingRequests)+len(sibling.PendingRequests),
+	)
+	for _, msg := range msgs {
+		for id, index := range msg.RecentObjects {
+			merged.RecentObjects[id] = index
+		}
+		for id, pending := range msg.PendingRequests {
+			merged.PendingRequests[id] = pending
+		}
+	}
+	return &merged
+}
+
 func (m *PulseManager) createDrop(
 	ctx context.Context,
 	jetID insolar.ID,
+	size uint64,
+	ids []insolar.ID,
 	prevPulse, currentPulse insolar.PulseNumber,
 ) (
 	block *drop.Drop,
@@ -240,9 +307,17 @@ func (m *PulseManager) createDrop(
 	messages [][]byte,
 	err error,
 ) {
+	prevHash, err := drop.PrevHash(ctx, m.DropAccessor, insolar.JetID(jetID), prevPulse)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "[ createDrop ] Can't fetch previous drop")
+	}
+
 	block = &drop.Drop{
-		Pulse: currentPulse,
-		JetID: insolar.JetID(jetID),
+		Pulse:    currentPulse,
+		JetID:    insolar.JetID(jetID),
+		Size:     size,
+		PrevHash: prevHash,
+		Hash:     drop.CalculateHash(m.PlatformCryptographyScheme.IntegrityHasher(), prevHash, ids),
 	}
 
 	err = m.DropModifier.Set(ctx, *block)
@@ -308,8 +383,6 @@ func (m *PulseManager) getExecutorHotData(
 	return msg, nil
 }
 
-var splitCount = 5
-
 func (m *PulseManager) processJets(ctx context.Context, currentPulse, newPulse insolar.PulseNumber) ([]jetInfo, error) {
 	ctx, span := instracer.StartSpan(ctx, "jets.process")
 	defer span.End()
@@ -327,16 +400,23 @@ func (m *PulseManager) processJets(ctx context.Context, currentPulse, newPulse i
 		"current_pulse": currentPulse,
 		"new_pulse":     newPulse,
 	})
-	indexToSplit := rand.Intn(len(jetIDs))
-	for i, jetID := range jetIDs {
-		wasExecutor := false
+
+	// Measure load of jets we were executor for. Only these jets can be split or joined,
+	// because we have their drops and hot data.
+	loads := map[insolar.JetID]jetLoad{}
+	for _, jetID := range jetIDs {
 		executor, err := m.JetCoordinator.LightExecutorForJet(ctx, insolar.ID(jetID), currentPulse)
 		if err != nil && err != node.ErrNoNodes {
 			return nil, err
 		}
-		if err == nil {
-			wasExecutor = *executor == me
+		if err == nil && *executor == me {
+			loads[jetID] = m.measureLoad(ctx, jetID, currentPulse)
 		}
+	}
+
+	joined := map[insolar.JetID]*jetInfo{}
+	for _, jetID := range jetIDs {
+		load, wasExecutor := loads[jetID]
 
 		logger = logger.WithField("jetid", jetID.DebugString())
 		inslogger.SetLogger(ctx, logger)
@@ -345,10 +425,18 @@ func (m *PulseManager) processJets(ctx context.Context, currentPulse, newPulse i
 			continue
 		}
 
-		info := jetInfo{id: jetID}
-		if indexToSplit == i && splitCount > 0 {
-			splitCount--
+		info := jetInfo{id: jetID, size: load.size}
+		if parent, ok := joined[jetID]; ok {
+			// Already joined with sibling.
+			info.parent = parent
+			results = append(results, info)
+			continue
+		}
 
+		siblingID := jet.Sibling(jetID)
+		siblingLoad, siblingExecuted := loads[siblingID]
+		switch {
+		case m.needSplit(load):
 			leftJetID, rightJetID, err := m.JetModifier.Split(
 				ctx,
 				newPulse,
@@ -386,11 +474,44 @@ func (m *PulseManager) processJets(ctx context.Context, currentPulse, newPulse i
 				}
 			}
 
+			stats.Record(ctx, statJetSplits.M(1))
 			logger.WithFields(map[string]interface{}{
 				"left_child":  leftJetID.DebugString(),
 				"right_child": rightJetID.DebugString(),
+				"drop_size":   load.size,
+				"records":     load.records,
 			}).Info("jet split performed")
-		} else {
+		case siblingID != jetID && siblingExecuted && m.canJoin(load) && m.canJoin(siblingLoad):
+			parentID, err := m.JetModifier.Join(ctx, newPulse, jetID)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to join jet tree")
+			}
+
+			// Set actual because we are the last executor for both jets.
+			m.JetModifier.Update(ctx, newPulse, true, parentID)
+
+			info.parent = &jetInfo{id: parentID}
+			joined[siblingID] = info.parent
+			nextExecutor, err := m.JetCoordinator.LightExecutorForJet(ctx, insolar.ID(parentID), newPulse)
+			if err != nil {
+				return nil, err
+			}
+			if *nextExecutor == me {
+				info.parent.mineNext = true
+				for _, childID := range []insolar.JetID{jetID, siblingID} {
+					err := m.mergeHotData(ctx, insolar.ID(childID), insolar.ID(parentID))
+					if err != nil {
+						return nil, err
+					}
+				}
+			}
+
+			stats.Record(ctx, statJetJoins.M(1))
+			logger.WithFields(map[string]interface{}{
+				"sibling": siblingID.DebugString(),
+				"parent":  parentID.DebugString(),
+			}).Info("jet join performed")
+		default:
 			// Set actual because we are the last executor for jet.
 			m.JetModifier.Update(ctx, newPulse, true, jetID)
 			nextExecutor, err := m.JetCoordinator.LightExecutorForJet(ctx, insolar.ID(jetID), newPulse)
@@ -649,6 +770,7 @@ func (m *PulseManager) cleanLightData(ctx context.Context, newPulse insolar.Puls
 	m.DropCleaner.Delete(p.PulseNumber)
 	m.BlobCleaner.Delete(ctx, p.PulseNumber)
 	m.RecCleaner.Remove(ctx, p.PulseNumber)
+	m.SecondaryCleaner.Delete(ctx, p.PulseNumber)
 	err = m.PulseShifter.Shift(ctx, p.PulseNumber)
 	if err != nil {
 		inslogger.FromContext(ctx).Errorf("Can't clean pulse-tracker from pulse: %s", err)
@@ -662,8 +784,18 @@ func (m *PulseManager) prepareArtifactManagerMessageHandlerForNextPulse(ctx cont
 	m.HotDataWaiter.ThrowTimeout(ctx)
 
 	logger := inslogger.FromContext(ctx)
+	unlockedParents := map[insolar.JetID]struct{}{}
 	for _, jetInfo := range jets {
-		if jetInfo.left == nil && jetInfo.right == nil {
+		if jetInfo.parent != nil {
+			// Join happened. Both joined jets share the parent, so it's unlocked once.
+			if _, ok := unlockedParents[jetInfo.parent.id]; jetInfo.parent.mineNext && !ok {
+				unlockedParents[jetInfo.parent.id] = struct{}{}
+				err := m.HotDataWaiter.Unlock(ctx, insolar.ID(jetInfo.parent.id))
+				if err != nil {
+					logger.Error(err)
+				}
+			}
+		} else if jetInfo.left == nil && jetInfo.right == nil {
 			// No split happened.
 			if jetInfo.mineNext {
 				err := m.HotDataWaiter.Unlock(ctx, insolar.ID(jetInfo.id))
@@ -693,7 +825,7 @@ func (m *PulseManager) prepareArtifactManagerMessageHandlerForNextPulse(ctx cont
 func (m *PulseManager) Start(ctx context.Context) error {
 	origin := m.NodeNet.GetOrigin()
 	err := m.NodeSetter.Set(insolar.FirstPulseNumber, []insolar.Node{{ID: origin.ID(), Role: origin.Role()}})
-	if err != nil && err != storage.ErrOverride {
+	if err != nil && err != node.ErrOverride {
 		return err
 	}
 
@@ -0,0 +1,198 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package pulsemanager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/ledger/recentstorage"
+	"github.com/insolar/insolar/ledger/storage/blob"
+	"github.com/insolar/insolar/ledger/storage/drop"
+	"github.com/insolar/insolar/ledger/storage/object"
+	"github.com/insolar/insolar/testutils"
+	"github.com/insolar/insolar/testutils/network"
+)
+
+const (
+	currentPulse = insolar.FirstPulseNumber + insolar.PulseNumber(10)
+	newPulse     = currentPulse + insolar.PulseNumber(10)
+)
+
+// newJetsPulseManager returns light pulse manager which was executor of provided jets in current pulse and is not
+// executor of any jet in the new pulse. Provided records count is written into every jet.
+func newJetsPulseManager(
+	mc *minimock.Controller, jets *jet.Store, executed map[insolar.JetID]bool, records int,
+) *PulseManager {
+	me := testutils.RandomRef()
+	other := testutils.RandomRef()
+
+	origin := network.NewNetworkNodeMock(mc)
+	origin.RoleMock.Return(insolar.StaticRoleLightMaterial)
+	nodeNet := network.NewNodeNetworkMock(mc)
+	nodeNet.GetOriginMock.Return(origin)
+
+	jc := testutils.NewJetCoordinatorMock(mc)
+	jc.MeMock.Return(me)
+	jc.LightExecutorForJetFunc = func(
+		ctx context.Context, id insolar.ID, pn insolar.PulseNumber,
+	) (*insolar.Reference, error) {
+		if pn == currentPulse && executed[insolar.JetID(id)] {
+			return &me, nil
+		}
+		return &other, nil
+	}
+
+	recs := object.NewRecordCollectionAccessorMock(mc)
+	recs.ForPulseFunc = func(ctx context.Context, jetID insolar.JetID, pn insolar.PulseNumber) []record.MaterialRecord {
+		var res []record.MaterialRecord
+		for i := 0; i < records; i++ {
+			res = append(res, record.MaterialRecord{Record: &object.ResultRecord{}, JetID: jetID})
+		}
+		return res
+	}
+	blobs := blob.NewCollectionAccessorMock(mc)
+	blobs.ForPulseMock.Return(nil)
+
+	return &PulseManager{
+		NodeNet:          nodeNet,
+		JetCoordinator:   jc,
+		JetAccessor:      jets,
+		JetModifier:      jets,
+		RecSyncAccessor:  recs,
+		BlobSyncAccessor: blobs,
+		options: pmOptions{
+			splitRecordsThreshold: 2,
+			mergeThreshold:        1000,
+		},
+	}
+}
+
+func TestPulseManager_processJets_Split(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	jets := jet.NewStore()
+	jets.Update(ctx, currentPulse, true, insolar.ZeroJetID)
+	m := newJetsPulseManager(mc, jets, map[insolar.JetID]bool{insolar.ZeroJetID: true}, 2)
+
+	infos, err := m.processJets(ctx, currentPulse, newPulse)
+	require.NoError(t, err)
+
+	left, right := jet.Children(insolar.ZeroJetID)
+	require.Len(t, infos, 1)
+	require.Equal(t, insolar.ZeroJetID, infos[0].id)
+	require.Nil(t, infos[0].parent)
+	require.Equal(t, left, infos[0].left.id)
+	require.Equal(t, right, infos[0].right.id)
+	require.False(t, infos[0].left.mineNext)
+	require.False(t, infos[0].right.mineNext)
+	require.ElementsMatch(t, []insolar.JetID{left, right}, jets.All(ctx, newPulse))
+}
+
+func TestPulseManager_processJets_Join(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	left, right := jet.Children(insolar.ZeroJetID)
+	jets := jet.NewStore()
+	jets.Update(ctx, currentPulse, true, left, right)
+	m := newJetsPulseManager(mc, jets, map[insolar.JetID]bool{left: true, right: true}, 1)
+
+	infos, err := m.processJets(ctx, currentPulse, newPulse)
+	require.NoError(t, err)
+
+	require.Len(t, infos, 2)
+	require.ElementsMatch(t, []insolar.JetID{left, right}, []insolar.JetID{infos[0].id, infos[1].id})
+	require.NotNil(t, infos[0].parent)
+	require.True(t, infos[0].parent == infos[1].parent, "both children share the same parent")
+	require.Equal(t, insolar.ZeroJetID, infos[0].parent.id)
+	require.False(t, infos[0].parent.mineNext)
+	require.Equal(t, []insolar.JetID{insolar.ZeroJetID}, jets.All(ctx, newPulse))
+}
+
+func TestPulseManager_processJets_NoJoinWithoutSibling(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	mc := minimock.NewController(t)
+	defer mc.Finish()
+
+	left, right := jet.Children(insolar.ZeroJetID)
+	jets := jet.NewStore()
+	jets.Update(ctx, currentPulse, true, left, right)
+	m := newJetsPulseManager(mc, jets, map[insolar.JetID]bool{left: true}, 1)
+
+	infos, err := m.processJets(ctx, currentPulse, newPulse)
+	require.NoError(t, err)
+
+	require.Len(t, infos, 1)
+	require.Equal(t, left, infos[0].id)
+	require.Nil(t, infos[0].parent)
+	require.Nil(t, infos[0].left)
+	require.Nil(t, infos[0].right)
+	require.ElementsMatch(t, []insolar.JetID{left, right}, jets.All(ctx, newPulse))
+}
+
+func TestPulseManager_needSplit_canJoin(t *testing.T) {
+	m := &PulseManager{options: pmOptions{splitThreshold: 100, splitRecordsThreshold: 10, mergeThreshold: 50}}
+
+	require.True(t, m.needSplit(jetLoad{size: 100}))
+	require.True(t, m.needSplit(jetLoad{records: 10}))
+	require.False(t, m.needSplit(jetLoad{size: 99, records: 9}))
+
+	require.True(t, m.canJoin(jetLoad{size: 49, records: 9}))
+	require.False(t, m.canJoin(jetLoad{size: 50}))
+	require.False(t, m.canJoin(jetLoad{size: 10, records: 10}), "jet to be split can't be joined")
+
+	m.options.mergeThreshold = 0
+	require.False(t, m.canJoin(jetLoad{}), "join is disabled without threshold")
+}
+
+func TestMergeJoinedHotData(t *testing.T) {
+	left, right := jet.Children(insolar.ZeroJetID)
+	leftObj, rightObj := testutils.RandomID(), testutils.RandomID()
+	leftMsg := &message.HotData{
+		Drop:            drop.Drop{JetID: left, Pulse: currentPulse},
+		PulseNumber:     newPulse,
+		RecentObjects:   map[insolar.ID]message.HotIndex{leftObj: {TTL: 1}},
+		PendingRequests: map[insolar.ID]recentstorage.PendingObjectContext{leftObj: {Active: true}},
+	}
+	rightMsg := &message.HotData{
+		Drop:            drop.Drop{JetID: right, Pulse: currentPulse},
+		PulseNumber:     newPulse,
+		RecentObjects:   map[insolar.ID]message.HotIndex{rightObj: {TTL: 2}},
+		PendingRequests: map[insolar.ID]recentstorage.PendingObjectContext{},
+	}
+
+	merged := mergeJoinedHotData([]*message.HotData{leftMsg, rightMsg})
+
+	require.Equal(t, leftMsg.Drop, merged.Drop)
+	require.Equal(t, &rightMsg.Drop, merged.SiblingDrop)
+	require.Equal(t, newPulse, merged.PulseNumber)
+	require.Equal(t, map[insolar.ID]message.HotIndex{leftObj: {TTL: 1}, rightObj: {TTL: 2}}, merged.RecentObjects)
+	require.Equal(t, leftMsg.PendingRequests, merged.PendingRequests)
+	require.Len(t, leftMsg.RecentObjects, 1, "source message is not modified")
+}
@@ -0,0 +1,289 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package retention
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/storage"
+	"github.com/insolar/insolar/ledger/storage/object"
+)
+
+//go:generate minimock -i github.com/insolar/insolar/ledger/retention.Compactor -o ./ -s _mock.go
+
+// maxBatchLen limits number of writes in a single batch, so it fits into a DB transaction.
+const maxBatchLen = 1000
+
+// Compactor compacts storage according to retention policy.
+type Compactor interface {
+	// OnPulse starts compaction of object history which became older than retention depth.
+	OnPulse(ctx context.Context, pn insolar.PulseNumber)
+}
+
+// Stats describes results of a single compaction.
+type Stats struct {
+	// Objects is a number of objects with compacted history.
+	Objects int
+	// Records is a number of removed records.
+	Records int
+	// Blobs is a number of removed blobs.
+	Blobs int
+}
+
+// CompactorDB compacts heavy material node DB.
+type CompactorDB struct {
+	DB             store.DB                   `inject:""`
+	DBContext      storage.DBContext          `inject:""`
+	RecordAccessor object.RecordAccessor      `inject:""`
+	RecordIterator object.RecordPulseIterator `inject:""`
+
+	conf configuration.Retention
+
+	running int32
+	wg      sync.WaitGroup
+}
+
+// NewCompactor creates new compactor. Retention depth less than lightChainLimit is increased to it, because light
+// material nodes may still replicate data of such pulses.
+func NewCompactor(conf configuration.Retention, lightChainLimit int) *CompactorDB {
+	if conf.Depth != 0 && conf.Depth < lightChainLimit {
+		conf.Depth = lightChainLimit
+	}
+	return &CompactorDB{conf: conf}
+}
+
+// OnPulse starts compaction in background if it's enabled and the previous compaction is finished.
+func (c *CompactorDB) OnPulse(ctx context.Context, pn insolar.PulseNumber) {
+	if c.conf.Depth == 0 || pn < insolar.FirstPulseNumber+insolar.PulseNumber(c.conf.Depth) {
+		return
+	}
+	if !atomic.CompareAndSwapInt32(&c.running, 0, 1) {
+		return
+	}
+
+	horizon := pn - insolar.PulseNumber(c.conf.Depth)
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		defer atomic.StoreInt32(&c.running, 0)
+
+		logger := inslogger.FromContext(ctx)
+		stats, err := c.Compact(ctx, horizon)
+		if err != nil {
+			logger.Error(errors.Wrapf(err, "failed to compact history older than pulse %v", horizon))
+			return
+		}
+		logger.Infof(
+			"compacted history older than pulse %v: %v objects, %v records, %v blobs removed",
+			horizon, stats.Objects, stats.Records, stats.Blobs,
+		)
+	}()
+}
+
+// Stop waits for running compaction to finish.
+func (c *CompactorDB) Stop(ctx context.Context) error {
+	c.wg.Wait()
+	return nil
+}
+
+// Compact removes amend records of pulses not later than horizon except checkpoints and saves tombstones for them.
+// Latest and approved states of objects are never removed. Memory of removed records is removed if no other record
+// references it.
+func (c *CompactorDB) Compact(ctx context.Context, horizon insolar.PulseNumber) (Stats, error) {
+	var stats Stats
+	blobs := map[insolar.ID]struct{}{}
+	err := storage.IterateIndexKVs(ctx, c.DBContext, horizon, func(kv insolar.KV) error {
+		var objID insolar.ID
+		copy(objID[:], kv.K[len(kv.K)-insolar.RecordIDSize:])
+		records, memory, err := c.compactObject(ctx, object.DecodeIndex(kv.V), horizon)
+		if err != nil {
+			return errors.Wrapf(err, "failed to compact object %v", objID.String())
+		}
+		if records > 0 {
+			stats.Objects++
+			stats.Records += records
+		}
+		for _, id := range memory {
+			blobs[id] = struct{}{}
+		}
+		return nil
+	})
+	if err != nil {
+		return stats, errors.Wrap(err, "[ Compact ]")
+	}
+
+	stats.Blobs, err = c.removeBlobs(ctx, blobs)
+	if err != nil {
+		return stats, errors.Wrap(err, "[ Compact ]")
+	}
+	return stats, nil
+}
+
+// compactObject walks states of an object from the latest one and replaces compacted amend records with tombstones.
+// Walk stops on a removed record, because older history was compacted before.
+func (c *CompactorDB) compactObject(
+	ctx context.Context, idx object.Lifeline, horizon insolar.PulseNumber,
+) (int, []insolar.ID, error) {
+	var (
+		removed    []compactedRecord
+		memory     []insolar.ID
+		period     uint32
+		checkpoint bool
+	)
+	for id := idx.LatestState; id != nil; {
+		rec, err := c.RecordAccessor.ForID(ctx, *id)
+		if err == object.ErrNotFound {
+			break
+		}
+		if err != nil {
+			return 0, nil, errors.Wrapf(err, "failed to fetch state %v", id.String())
+		}
+		state, ok := rec.Record.(object.State)
+		if !ok {
+			return 0, nil, errors.Errorf("record %v is not an object state", id.String())
+		}
+
+		amend, ok := rec.Record.(*object.AmendRecord)
+		if ok && id.Pulse() <= horizon && !isApproved(idx, *id) {
+			p := c.period(id.Pulse())
+			if checkpoint && p == period {
+				removed = append(removed, compactedRecord{
+					id:        *id,
+					tombstone: MustEncodeTombstone(Tombstone{JetID: rec.JetID, PrevState: amend.PrevState}),
+				})
+				if amend.Memory != nil {
+					memory = append(memory, *amend.Memory)
+				}
+			} else {
+				period, checkpoint = p, true
+			}
+		}
+		id = state.PrevStateID()
+	}
+
+	if len(removed) == 0 {
+		return 0, nil, nil
+	}
+	return len(removed), memory, c.removeRecords(removed)
+}
+
+type compactedRecord struct {
+	id        insolar.ID
+	tombstone []byte
+}
+
+// removeRecords replaces records with tombstones. Tombstone is always written before removal of its record in the same
+// batch. Long histories are written by several batches starting from the oldest records, so interrupted compaction
+// leaves the newer part of history reachable from the latest state and it's compacted next time.
+func (c *CompactorDB) removeRecords(records []compactedRecord) error {
+	batch := &store.Batch{}
+	for i := len(records) - 1; i >= 0; i-- {
+		batch.Set(tombstoneKey(records[i].id), records[i].tombstone)
+		batch.Delete(dbKey{scope: store.ScopeRecord, id: records[i].id})
+		if batch.Len() < maxBatchLen && i > 0 {
+			continue
+		}
+		if err := c.DB.Write(batch); err != nil {
+			return err
+		}
+		batch = &store.Batch{}
+	}
+	return nil
+}
+
+// period returns number of checkpoint interval the pulse belongs to.
+func (c *CompactorDB) period(pn insolar.PulseNumber) uint32 {
+	if c.conf.CheckpointInterval == 0 {
+		return 0
+	}
+	return uint32(pn-insolar.FirstPulseNumber) / uint32(c.conf.CheckpointInterval)
+}
+
+// removeBlobs removes blobs that are not referenced by remaining records. Blob id is calculated from pulse and
+// content, so only records of the blob pulse can reference it.
+func (c *CompactorDB) removeBlobs(ctx context.Context, blobs map[insolar.ID]struct{}) (int, error) {
+	byPulse := map[insolar.PulseNumber]map[insolar.ID]struct{}{}
+	for id := range blobs {
+		if byPulse[id.Pulse()] == nil {
+			byPulse[id.Pulse()] = map[insolar.ID]struct{}{}
+		}
+		byPulse[id.Pulse()][id] = struct{}{}
+	}
+
+	removed := 0
+	for pn, unused := range byPulse {
+		err := c.RecordIterator.IterateOnPulse(ctx, pn, func(_ insolar.ID, rec record.MaterialRecord) error {
+			if id := referencedBlob(rec); id != nil {
+				delete(unused, *id)
+			}
+			return nil
+		})
+		if err != nil {
+			return removed, errors.Wrapf(err, "failed to fetch records of pulse %v", pn)
+		}
+		if len(unused) == 0 {
+			continue
+		}
+
+		batch := &store.Batch{}
+		for id := range unused {
+			batch.Delete(dbKey{scope: store.ScopeBlob, id: id})
+			if batch.Len() < maxBatchLen {
+				continue
+			}
+			err = c.DB.Write(batch)
+			if err != nil {
+				return removed, errors.Wrapf(err, "failed to remove blobs of pulse %v", pn)
+			}
+			removed += batch.Len()
+			batch = &store.Batch{}
+		}
+		if batch.Len() == 0 {
+			continue
+		}
+		err = c.DB.Write(batch)
+		if err != nil {
+			return removed, errors.Wrapf(err, "failed to remove blobs of pulse %v", pn)
+		}
+		removed += batch.Len()
+	}
+	return removed, nil
+}
+
+func isApproved(idx object.Lifeline, id insolar.ID) bool {
+	return idx.LatestStateApproved != nil && *idx.LatestStateApproved == id
+}
+
+// referencedBlob returns id of blob referenced by record or nil.
+func referencedBlob(rec record.MaterialRecord) *insolar.ID {
+	switch r := rec.Record.(type) {
+	case *object.CodeRecord:
+		return r.Code
+	case object.State:
+		return r.GetMemory()
+	}
+	return nil
+}
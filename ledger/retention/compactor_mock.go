@@ -0,0 +1,239 @@
+package retention
+
+/*
+DO NOT EDIT!
+This code was generated automatically using github.com/gojuno/minimock v1.9
+The original interface "Compactor" can be found in github.com/insolar/insolar/ledger/retention
+*/
+import (
+	context "context"
+	"sync/atomic"
+	"time"
+
+	"github.com/gojuno/minimock"
+	insolar "github.com/insolar/insolar/insolar"
+
+	testify_assert "github.com/stretchr/testify/assert"
+)
+
+//CompactorMock implements github.com/insolar/insolar/ledger/retention.Compactor
+type CompactorMock struct {
+	t minimock.Tester
+
+	OnPulseFunc       func(p context.Context, p1 insolar.PulseNumber)
+	OnPulseCounter    uint64
+	OnPulsePreCounter uint64
+	OnPulseMock       mCompactorMockOnPulse
+}
+
+//NewCompactorMock returns a mock for github.com/insolar/insolar/ledger/retention.Compactor
+func NewCompactorMock(t minimock.Tester) *CompactorMock {
+	m := &CompactorMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.OnPulseMock = mCompactorMockOnPulse{mock: m}
+
+	return m
+}
+
+type mCompactorMockOnPulse struct {
+	mock              *CompactorMock
+	mainExpectation   *CompactorMockOnPulseExpectation
+	expectationSeries []*CompactorMockOnPulseExpectation
+}
+
+type CompactorMockOnPulseExpectation struct {
+	input *CompactorMockOnPulseInput
+}
+
+type CompactorMockOnPulseInput struct {
+	p  context.Context
+	p1 insolar.PulseNumber
+}
+
+//Expect specifies that invocation of Compactor.OnPulse is expected from 1 to Infinity times
+func (m *mCompactorMockOnPulse) Expect(p context.Context, p1 insolar.PulseNumber) *mCompactorMockOnPulse {
+	m.mock.OnPulseFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &CompactorMockOnPulseExpectation{}
+	}
+	m.mainExpectation.input = &CompactorMockOnPulseInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of Compactor.OnPulse
+func (m *mCompactorMockOnPulse) Return() *CompactorMock {
+	m.mock.OnPulseFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &CompactorMockOnPulseExpectation{}
+	}
+
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Compactor.OnPulse is expected once
+func (m *mCompactorMockOnPulse) ExpectOnce(p context.Context, p1 insolar.PulseNumber) *CompactorMockOnPulseExpectation {
+	m.mock.OnPulseFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &CompactorMockOnPulseExpectation{}
+	expectation.input = &CompactorMockOnPulseInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+//Set uses given function f as a mock of Compactor.OnPulse method
+func (m *mCompactorMockOnPulse) Set(f func(p context.Context, p1 insolar.PulseNumber)) *CompactorMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.OnPulseFunc = f
+	return m.mock
+}
+
+//OnPulse implements github.com/insolar/insolar/ledger/retention.Compactor interface
+func (m *CompactorMock) OnPulse(p context.Context, p1 insolar.PulseNumber) {
+	counter := atomic.AddUint64(&m.OnPulsePreCounter, 1)
+	defer atomic.AddUint64(&m.OnPulseCounter, 1)
+
+	if len(m.OnPulseMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.OnPulseMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to CompactorMock.OnPulse. %v %v", p, p1)
+			return
+		}
+
+		input := m.OnPulseMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, CompactorMockOnPulseInput{p, p1}, "Compactor.OnPulse got unexpected parameters")
+
+		return
+	}
+
+	if m.OnPulseMock.mainExpectation != nil {
+
+		input := m.OnPulseMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, CompactorMockOnPulseInput{p, p1}, "Compactor.OnPulse got unexpected parameters")
+		}
+
+		return
+	}
+
+	if m.OnPulseFunc == nil {
+		m.t.Fatalf("Unexpected call to CompactorMock.OnPulse. %v %v", p, p1)
+		return
+	}
+
+	m.OnPulseFunc(p, p1)
+}
+
+//OnPulseMinimockCounter returns a count of CompactorMock.OnPulseFunc invocations
+func (m *CompactorMock) OnPulseMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.OnPulseCounter)
+}
+
+//OnPulseMinimockPreCounter returns the value of CompactorMock.OnPulse invocations
+func (m *CompactorMock) OnPulseMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.OnPulsePreCounter)
+}
+
+//OnPulseFinished returns true if mock invocations count is ok
+func (m *CompactorMock) OnPulseFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.OnPulseMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.OnPulseCounter) == uint64(len(m.OnPulseMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.OnPulseMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.OnPulseCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.OnPulseFunc != nil {
+		return atomic.LoadUint64(&m.OnPulseCounter) > 0
+	}
+
+	return true
+}
+
+//ValidateCallCounters checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *CompactorMock) ValidateCallCounters() {
+
+	if !m.OnPulseFinished() {
+		m.t.Fatal("Expected call to CompactorMock.OnPulse")
+	}
+
+}
+
+//CheckMocksCalled checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *CompactorMock) CheckMocksCalled() {
+	m.Finish()
+}
+
+//Finish checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish or use Finish method of minimock.Controller
+func (m *CompactorMock) Finish() {
+	m.MinimockFinish()
+}
+
+//MinimockFinish checks that all mocked methods of the interface have been called at least once
+func (m *CompactorMock) MinimockFinish() {
+
+	if !m.OnPulseFinished() {
+		m.t.Fatal("Expected call to CompactorMock.OnPulse")
+	}
+
+}
+
+//Wait waits for all mocked methods to be called at least once
+//Deprecated: please use MinimockWait or use Wait method of minimock.Controller
+func (m *CompactorMock) Wait(timeout time.Duration) {
+	m.MinimockWait(timeout)
+}
+
+//MinimockWait waits for all mocked methods to be called at least once
+//this method is called by minimock.Controller
+func (m *CompactorMock) MinimockWait(timeout time.Duration) {
+	timeoutCh := time.After(timeout)
+	for {
+		ok := true
+		ok = ok && m.OnPulseFinished()
+
+		if ok {
+			return
+		}
+
+		select {
+		case <-timeoutCh:
+
+			if !m.OnPulseFinished() {
+				m.t.Error("Expected call to CompactorMock.OnPulse")
+			}
+
+			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+//AllMocksCalled returns true if all mocked methods were called before the execution of AllMocksCalled,
+//it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
+func (m *CompactorMock) AllMocksCalled() bool {
+
+	if !m.OnPulseFinished() {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,184 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package retention
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/component"
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/storage"
+	"github.com/insolar/insolar/ledger/storage/blob"
+	"github.com/insolar/insolar/ledger/storage/object"
+	"github.com/insolar/insolar/platformpolicy"
+)
+
+func TestCompactorDB_Compact(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+
+	tmpdir, err := ioutil.TempDir("", "retention-test-")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpdir)
+	conf := configuration.NewLedger()
+	conf.Storage.DataDirectory = filepath.Join(tmpdir, "data")
+	conf.Storage.DataDirectoryNewDB = filepath.Join(tmpdir, "new-data")
+
+	legacyDB, err := storage.NewDB(conf, nil)
+	require.NoError(t, err)
+	defer legacyDB.Close()
+	db, err := store.NewBadgerDB(conf.Storage.DataDirectoryNewDB)
+	require.NoError(t, err)
+	defer db.Stop(ctx)
+
+	objects := storage.NewObjectStorage()
+	records := object.NewRecordDB(db)
+	blobs := blob.NewStorageDB(db)
+	tombstones := NewTombstoneDB(db)
+	compactor := NewCompactor(configuration.Retention{Depth: 30, CheckpointInterval: 30}, 5)
+	cm := component.Manager{}
+	cm.Inject(platformpolicy.NewPlatformCryptographyScheme(), legacyDB, db, objects, records, compactor)
+
+	// Object is activated in the first pulse and amended in every of the next nine pulses. Pulses are split into
+	// checkpoint intervals: [0, 30), [30, 60), [60, 90), [90, 120) from the first pulse.
+	first := insolar.PulseNumber(insolar.FirstPulseNumber)
+	jetID := *insolar.NewJetID(0, nil)
+	var states []insolar.ID
+	for i := 0; i < 10; i++ {
+		pn := first + insolar.PulseNumber(i*10)
+		memory := *insolar.NewID(pn, []byte{1})
+		require.NoError(t, blobs.Set(ctx, memory, blob.Blob{Value: []byte{byte(i)}, JetID: jetID}))
+
+		var rec record.VirtualRecord = &object.ActivateRecord{StateRecord: object.StateRecord{Memory: &memory}}
+		if i > 0 {
+			rec = &object.AmendRecord{StateRecord: object.StateRecord{Memory: &memory}, PrevState: states[i-1]}
+		}
+		id := *insolar.NewID(pn, []byte{2})
+		require.NoError(t, records.Set(ctx, id, record.MaterialRecord{Record: rec, JetID: jetID}))
+		states = append(states, id)
+	}
+	objID := states[0]
+	err = objects.SetObjectIndex(ctx, insolar.ID(jetID), &objID, &object.Lifeline{
+		LatestState:         &states[9],
+		LatestStateApproved: &states[4],
+		State:               object.StateAmend,
+		JetID:               jetID,
+	})
+	require.NoError(t, err)
+
+	checkRemoved := func(t *testing.T, removed ...int) {
+		for i, id := range states {
+			_, recErr := records.ForID(ctx, id)
+			_, blobErr := blobs.ForID(ctx, *insolar.NewID(id.Pulse(), []byte{1}))
+			tombstone, tErr := tombstones.ForID(ctx, id)
+			if !contains(removed, i) {
+				assert.NoError(t, recErr, "state %v", i)
+				assert.NoError(t, blobErr, "state %v", i)
+				assert.Equal(t, ErrNotFound, tErr, "state %v", i)
+				continue
+			}
+			assert.Equal(t, object.ErrNotFound, recErr, "state %v", i)
+			assert.Equal(t, blob.ErrNotFound, blobErr, "state %v", i)
+			require.NoError(t, tErr, "state %v", i)
+			assert.Equal(t, Tombstone{JetID: jetID, PrevState: states[i-1]}, tombstone)
+		}
+	}
+
+	t.Run("keeps checkpoints and approved state", func(t *testing.T) {
+		stats, err := compactor.Compact(ctx, first+60)
+		require.NoError(t, err)
+		assert.Equal(t, Stats{Objects: 1, Records: 2, Blobs: 2}, stats)
+		checkRemoved(t, 1, 3)
+	})
+
+	t.Run("continues from compacted history", func(t *testing.T) {
+		stats, err := compactor.Compact(ctx, first+90)
+		require.NoError(t, err)
+		assert.Equal(t, Stats{Objects: 1, Records: 2, Blobs: 2}, stats)
+		checkRemoved(t, 1, 3, 6, 7)
+	})
+
+	t.Run("tombstones prove drop records", func(t *testing.T) {
+		ids, err := JetTombstoneIDs(ctx, tombstones, jetID, first+60)
+		require.NoError(t, err)
+		assert.Equal(t, []insolar.ID{states[6]}, ids)
+		ids, err = JetTombstoneIDs(ctx, tombstones, *insolar.NewJetID(1, nil), first+60)
+		require.NoError(t, err)
+		assert.Empty(t, ids)
+	})
+}
+
+// failingDB fails writes after the limit is reached.
+type failingDB struct {
+	store.DB
+	writes int
+}
+
+func (db *failingDB) Write(batch *store.Batch) error {
+	if db.writes == 0 {
+		return errors.New("write failed")
+	}
+	db.writes--
+	return db.DB.Write(batch)
+}
+
+func TestCompactorDB_removeRecords_Interrupted(t *testing.T) {
+	db := &failingDB{DB: store.NewMemoryMockDB(), writes: 1}
+	compactor := &CompactorDB{DB: db}
+
+	// Records go from the newest to the oldest one like compactObject collects them. They don't fit into a single batch.
+	var records []compactedRecord
+	for i := 0; i < maxBatchLen; i++ {
+		id := *insolar.NewID(insolar.FirstPulseNumber+insolar.PulseNumber(maxBatchLen-i), nil)
+		require.NoError(t, db.DB.Set(dbKey{scope: store.ScopeRecord, id: id}, []byte{1}))
+		records = append(records, compactedRecord{id: id, tombstone: []byte{2}})
+	}
+
+	require.Error(t, compactor.removeRecords(records))
+
+	// The oldest half of history is replaced with tombstones, the newest half is left untouched.
+	for i, r := range records {
+		_, recErr := db.DB.Get(dbKey{scope: store.ScopeRecord, id: r.id})
+		_, tErr := db.DB.Get(tombstoneKey(r.id))
+		if i < maxBatchLen/2 {
+			assert.NoError(t, recErr, "record %v", i)
+			assert.Equal(t, store.ErrNotFound, tErr, "record %v", i)
+			continue
+		}
+		assert.Equal(t, store.ErrNotFound, recErr, "record %v", i)
+		assert.NoError(t, tErr, "record %v", i)
+	}
+}
+
+func contains(list []int, n int) bool {
+	for _, i := range list {
+		if i == n {
+			return true
+		}
+	}
+	return false
+}
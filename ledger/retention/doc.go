@@ -0,0 +1,24 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package retention implements retention policy of heavy material node storage.
+//
+// Full history of objects is kept for a configured number of pulses. Older amend records of objects are compacted:
+// only checkpoints (the latest state of every checkpoint interval) are kept, other amend records and their memory
+// are removed. A tombstone is saved for every removed record. Record id is a hash of record content, so tombstones
+// together with drop hashes prove that removed records existed. Tombstones also keep links to previous states, so
+// object history can be walked through compacted states down to the activation.
+package retention
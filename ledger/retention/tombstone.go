@@ -0,0 +1,178 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package retention
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/ugorji/go/codec"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/storage/object"
+)
+
+// ErrNotFound is returned when tombstone is not found.
+var ErrNotFound = errors.New("tombstone not found")
+
+// Tombstone is a proof of a record removed by compaction.
+type Tombstone struct {
+	// JetID is a jet of the removed record. It's required to calculate drop hash.
+	JetID insolar.JetID
+	// PrevState is a previous state of the object the removed record is a state of.
+	PrevState insolar.ID
+}
+
+//go:generate minimock -i github.com/insolar/insolar/ledger/retention.TombstoneAccessor -o ./ -s _mock.go
+
+// TombstoneAccessor provides methods for fetching tombstones of compacted records.
+type TombstoneAccessor interface {
+	// ForID returns tombstone of removed record with provided id.
+	ForID(ctx context.Context, id insolar.ID) (Tombstone, error)
+	// IterateOnPulse calls handler for every tombstone of records of provided pulse in ascending order of record ids.
+	IterateOnPulse(ctx context.Context, pn insolar.PulseNumber, handler func(id insolar.ID, t Tombstone) error) error
+}
+
+//go:generate minimock -i github.com/insolar/insolar/ledger/retention.TombstoneModifier -o ./ -s _mock.go
+
+// TombstoneModifier provides methods for saving tombstones of compacted records.
+type TombstoneModifier interface {
+	// Set saves tombstone of removed record with provided id.
+	Set(ctx context.Context, id insolar.ID, t Tombstone) error
+}
+
+// JetTombstoneIDs returns ids of removed records of provided jet and pulse in ascending order.
+func JetTombstoneIDs(
+	ctx context.Context, tombstones TombstoneAccessor, jetID insolar.JetID, pn insolar.PulseNumber,
+) ([]insolar.ID, error) {
+	var ids []insolar.ID
+	err := tombstones.IterateOnPulse(ctx, pn, func(id insolar.ID, t Tombstone) error {
+		if t.JetID == jetID {
+			ids = append(ids, id)
+		}
+		return nil
+	})
+	return ids, err
+}
+
+// DropRecordIDs returns ids of records of provided jet and pulse including records removed by compaction in ascending
+// order. Drop hash is calculated from them.
+func DropRecordIDs(
+	ctx context.Context,
+	records object.RecordPulseIterator,
+	tombstones TombstoneAccessor,
+	jetID insolar.JetID,
+	pn insolar.PulseNumber,
+) ([]insolar.ID, error) {
+	ids, err := object.JetRecordIDs(ctx, records, jetID, pn)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch drop records")
+	}
+	compacted, err := JetTombstoneIDs(ctx, tombstones, jetID, pn)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch drop tombstones")
+	}
+	if len(compacted) > 0 {
+		ids = append(ids, compacted...)
+		sort.Slice(ids, func(i, j int) bool {
+			return bytes.Compare(ids[i][:], ids[j][:]) < 0
+		})
+	}
+	return ids, nil
+}
+
+// TombstoneDB is a DB storage of tombstones.
+type TombstoneDB struct {
+	db store.DB
+}
+
+// NewTombstoneDB creates new DB storage of tombstones.
+func NewTombstoneDB(db store.DB) *TombstoneDB {
+	return &TombstoneDB{db: db}
+}
+
+// ForID returns tombstone of removed record with provided id.
+func (s *TombstoneDB) ForID(ctx context.Context, id insolar.ID) (Tombstone, error) {
+	buf, err := s.db.Get(tombstoneKey(id))
+	if err == store.ErrNotFound {
+		return Tombstone{}, ErrNotFound
+	}
+	if err != nil {
+		return Tombstone{}, err
+	}
+	return DecodeTombstone(buf)
+}
+
+// Set saves tombstone of removed record with provided id.
+func (s *TombstoneDB) Set(ctx context.Context, id insolar.ID, t Tombstone) error {
+	return s.db.Set(tombstoneKey(id), MustEncodeTombstone(t))
+}
+
+// IterateOnPulse calls handler for every tombstone of records of provided pulse in ascending order of record ids.
+func (s *TombstoneDB) IterateOnPulse(
+	ctx context.Context, pn insolar.PulseNumber, handler func(id insolar.ID, t Tombstone) error,
+) error {
+	return s.db.Iterate(store.ScopeTombstone, pn.Bytes(), func(k, v []byte) error {
+		var id insolar.ID
+		copy(id[:], k)
+		t, err := DecodeTombstone(v)
+		if err != nil {
+			return err
+		}
+		return handler(id, t)
+	})
+}
+
+// dbKey is a key of other storages data. Records and blobs are stored under their ids.
+type dbKey struct {
+	scope store.Scope
+	id    insolar.ID
+}
+
+func (k dbKey) Scope() store.Scope {
+	return k.scope
+}
+
+func (k dbKey) ID() []byte {
+	return k.id[:]
+}
+
+func tombstoneKey(id insolar.ID) dbKey {
+	return dbKey{scope: store.ScopeTombstone, id: id}
+}
+
+// MustEncodeTombstone serializes tombstone. It panics on encoding error.
+func MustEncodeTombstone(t Tombstone) []byte {
+	var buf bytes.Buffer
+	enc := codec.NewEncoder(&buf, &codec.CborHandle{})
+	err := enc.Encode(t)
+	if err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+// DecodeTombstone deserializes tombstone.
+func DecodeTombstone(buf []byte) (Tombstone, error) {
+	dec := codec.NewDecoder(bytes.NewReader(buf), &codec.CborHandle{})
+	var t Tombstone
+	err := dec.Decode(&t)
+	return t, err
+}
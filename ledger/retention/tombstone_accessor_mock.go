@@ -0,0 +1,438 @@
+package retention
+
+/*
+DO NOT EDIT!
+This code was generated automatically using github.com/gojuno/minimock v1.9
+The original interface "TombstoneAccessor" can be found in github.com/insolar/insolar/ledger/retention
+*/
+import (
+	context "context"
+	"sync/atomic"
+	"time"
+
+	"github.com/gojuno/minimock"
+	insolar "github.com/insolar/insolar/insolar"
+
+	testify_assert "github.com/stretchr/testify/assert"
+)
+
+//TombstoneAccessorMock implements github.com/insolar/insolar/ledger/retention.TombstoneAccessor
+type TombstoneAccessorMock struct {
+	t minimock.Tester
+
+	ForIDFunc       func(p context.Context, p1 insolar.ID) (r Tombstone, r1 error)
+	ForIDCounter    uint64
+	ForIDPreCounter uint64
+	ForIDMock       mTombstoneAccessorMockForID
+
+	IterateOnPulseFunc       func(p context.Context, p1 insolar.PulseNumber, p2 func(id insolar.ID, t Tombstone) error) (r error)
+	IterateOnPulseCounter    uint64
+	IterateOnPulsePreCounter uint64
+	IterateOnPulseMock       mTombstoneAccessorMockIterateOnPulse
+}
+
+//NewTombstoneAccessorMock returns a mock for github.com/insolar/insolar/ledger/retention.TombstoneAccessor
+func NewTombstoneAccessorMock(t minimock.Tester) *TombstoneAccessorMock {
+	m := &TombstoneAccessorMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ForIDMock = mTombstoneAccessorMockForID{mock: m}
+	m.IterateOnPulseMock = mTombstoneAccessorMockIterateOnPulse{mock: m}
+
+	return m
+}
+
+type mTombstoneAccessorMockForID struct {
+	mock              *TombstoneAccessorMock
+	mainExpectation   *TombstoneAccessorMockForIDExpectation
+	expectationSeries []*TombstoneAccessorMockForIDExpectation
+}
+
+type TombstoneAccessorMockForIDExpectation struct {
+	input  *TombstoneAccessorMockForIDInput
+	result *TombstoneAccessorMockForIDResult
+}
+
+type TombstoneAccessorMockForIDInput struct {
+	p  context.Context
+	p1 insolar.ID
+}
+
+type TombstoneAccessorMockForIDResult struct {
+	r  Tombstone
+	r1 error
+}
+
+//Expect specifies that invocation of TombstoneAccessor.ForID is expected from 1 to Infinity times
+func (m *mTombstoneAccessorMockForID) Expect(p context.Context, p1 insolar.ID) *mTombstoneAccessorMockForID {
+	m.mock.ForIDFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &TombstoneAccessorMockForIDExpectation{}
+	}
+	m.mainExpectation.input = &TombstoneAccessorMockForIDInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of TombstoneAccessor.ForID
+func (m *mTombstoneAccessorMockForID) Return(r Tombstone, r1 error) *TombstoneAccessorMock {
+	m.mock.ForIDFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &TombstoneAccessorMockForIDExpectation{}
+	}
+	m.mainExpectation.result = &TombstoneAccessorMockForIDResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of TombstoneAccessor.ForID is expected once
+func (m *mTombstoneAccessorMockForID) ExpectOnce(p context.Context, p1 insolar.ID) *TombstoneAccessorMockForIDExpectation {
+	m.mock.ForIDFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &TombstoneAccessorMockForIDExpectation{}
+	expectation.input = &TombstoneAccessorMockForIDInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *TombstoneAccessorMockForIDExpectation) Return(r Tombstone, r1 error) {
+	e.result = &TombstoneAccessorMockForIDResult{r, r1}
+}
+
+//Set uses given function f as a mock of TombstoneAccessor.ForID method
+func (m *mTombstoneAccessorMockForID) Set(f func(p context.Context, p1 insolar.ID) (r Tombstone, r1 error)) *TombstoneAccessorMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.ForIDFunc = f
+	return m.mock
+}
+
+//ForID implements github.com/insolar/insolar/ledger/retention.TombstoneAccessor interface
+func (m *TombstoneAccessorMock) ForID(p context.Context, p1 insolar.ID) (r Tombstone, r1 error) {
+	counter := atomic.AddUint64(&m.ForIDPreCounter, 1)
+	defer atomic.AddUint64(&m.ForIDCounter, 1)
+
+	if len(m.ForIDMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.ForIDMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to TombstoneAccessorMock.ForID. %v %v", p, p1)
+			return
+		}
+
+		input := m.ForIDMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, TombstoneAccessorMockForIDInput{p, p1}, "TombstoneAccessor.ForID got unexpected parameters")
+
+		result := m.ForIDMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the TombstoneAccessorMock.ForID")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.ForIDMock.mainExpectation != nil {
+
+		input := m.ForIDMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, TombstoneAccessorMockForIDInput{p, p1}, "TombstoneAccessor.ForID got unexpected parameters")
+		}
+
+		result := m.ForIDMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the TombstoneAccessorMock.ForID")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.ForIDFunc == nil {
+		m.t.Fatalf("Unexpected call to TombstoneAccessorMock.ForID. %v %v", p, p1)
+		return
+	}
+
+	return m.ForIDFunc(p, p1)
+}
+
+//ForIDMinimockCounter returns a count of TombstoneAccessorMock.ForIDFunc invocations
+func (m *TombstoneAccessorMock) ForIDMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.ForIDCounter)
+}
+
+//ForIDMinimockPreCounter returns the value of TombstoneAccessorMock.ForID invocations
+func (m *TombstoneAccessorMock) ForIDMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.ForIDPreCounter)
+}
+
+//ForIDFinished returns true if mock invocations count is ok
+func (m *TombstoneAccessorMock) ForIDFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.ForIDMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.ForIDCounter) == uint64(len(m.ForIDMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.ForIDMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.ForIDCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.ForIDFunc != nil {
+		return atomic.LoadUint64(&m.ForIDCounter) > 0
+	}
+
+	return true
+}
+
+type mTombstoneAccessorMockIterateOnPulse struct {
+	mock              *TombstoneAccessorMock
+	mainExpectation   *TombstoneAccessorMockIterateOnPulseExpectation
+	expectationSeries []*TombstoneAccessorMockIterateOnPulseExpectation
+}
+
+type TombstoneAccessorMockIterateOnPulseExpectation struct {
+	input  *TombstoneAccessorMockIterateOnPulseInput
+	result *TombstoneAccessorMockIterateOnPulseResult
+}
+
+type TombstoneAccessorMockIterateOnPulseInput struct {
+	p  context.Context
+	p1 insolar.PulseNumber
+	p2 func(id insolar.ID, t Tombstone) error
+}
+
+type TombstoneAccessorMockIterateOnPulseResult struct {
+	r error
+}
+
+//Expect specifies that invocation of TombstoneAccessor.IterateOnPulse is expected from 1 to Infinity times
+func (m *mTombstoneAccessorMockIterateOnPulse) Expect(p context.Context, p1 insolar.PulseNumber, p2 func(id insolar.ID, t Tombstone) error) *mTombstoneAccessorMockIterateOnPulse {
+	m.mock.IterateOnPulseFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &TombstoneAccessorMockIterateOnPulseExpectation{}
+	}
+	m.mainExpectation.input = &TombstoneAccessorMockIterateOnPulseInput{p, p1, p2}
+	return m
+}
+
+//Return specifies results of invocation of TombstoneAccessor.IterateOnPulse
+func (m *mTombstoneAccessorMockIterateOnPulse) Return(r error) *TombstoneAccessorMock {
+	m.mock.IterateOnPulseFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &TombstoneAccessorMockIterateOnPulseExpectation{}
+	}
+	m.mainExpectation.result = &TombstoneAccessorMockIterateOnPulseResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of TombstoneAccessor.IterateOnPulse is expected once
+func (m *mTombstoneAccessorMockIterateOnPulse) ExpectOnce(p context.Context, p1 insolar.PulseNumber, p2 func(id insolar.ID, t Tombstone) error) *TombstoneAccessorMockIterateOnPulseExpectation {
+	m.mock.IterateOnPulseFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &TombstoneAccessorMockIterateOnPulseExpectation{}
+	expectation.input = &TombstoneAccessorMockIterateOnPulseInput{p, p1, p2}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *TombstoneAccessorMockIterateOnPulseExpectation) Return(r error) {
+	e.result = &TombstoneAccessorMockIterateOnPulseResult{r}
+}
+
+//Set uses given function f as a mock of TombstoneAccessor.IterateOnPulse method
+func (m *mTombstoneAccessorMockIterateOnPulse) Set(f func(p context.Context, p1 insolar.PulseNumber, p2 func(id insolar.ID, t Tombstone) error) (r error)) *TombstoneAccessorMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.IterateOnPulseFunc = f
+	return m.mock
+}
+
+//IterateOnPulse implements github.com/insolar/insolar/ledger/retention.TombstoneAccessor interface
+func (m *TombstoneAccessorMock) IterateOnPulse(p context.Context, p1 insolar.PulseNumber, p2 func(id insolar.ID, t Tombstone) error) (r error) {
+	counter := atomic.AddUint64(&m.IterateOnPulsePreCounter, 1)
+	defer atomic.AddUint64(&m.IterateOnPulseCounter, 1)
+
+	if len(m.IterateOnPulseMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.IterateOnPulseMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to TombstoneAccessorMock.IterateOnPulse. %v %v %v", p, p1, p2)
+			return
+		}
+
+		input := m.IterateOnPulseMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, TombstoneAccessorMockIterateOnPulseInput{p, p1, p2}, "TombstoneAccessor.IterateOnPulse got unexpected parameters")
+
+		result := m.IterateOnPulseMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the TombstoneAccessorMock.IterateOnPulse")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.IterateOnPulseMock.mainExpectation != nil {
+
+		input := m.IterateOnPulseMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, TombstoneAccessorMockIterateOnPulseInput{p, p1, p2}, "TombstoneAccessor.IterateOnPulse got unexpected parameters")
+		}
+
+		result := m.IterateOnPulseMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the TombstoneAccessorMock.IterateOnPulse")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.IterateOnPulseFunc == nil {
+		m.t.Fatalf("Unexpected call to TombstoneAccessorMock.IterateOnPulse. %v %v %v", p, p1, p2)
+		return
+	}
+
+	return m.IterateOnPulseFunc(p, p1, p2)
+}
+
+//IterateOnPulseMinimockCounter returns a count of TombstoneAccessorMock.IterateOnPulseFunc invocations
+func (m *TombstoneAccessorMock) IterateOnPulseMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.IterateOnPulseCounter)
+}
+
+//IterateOnPulseMinimockPreCounter returns the value of TombstoneAccessorMock.IterateOnPulse invocations
+func (m *TombstoneAccessorMock) IterateOnPulseMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.IterateOnPulsePreCounter)
+}
+
+//IterateOnPulseFinished returns true if mock invocations count is ok
+func (m *TombstoneAccessorMock) IterateOnPulseFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.IterateOnPulseMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.IterateOnPulseCounter) == uint64(len(m.IterateOnPulseMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.IterateOnPulseMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.IterateOnPulseCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.IterateOnPulseFunc != nil {
+		return atomic.LoadUint64(&m.IterateOnPulseCounter) > 0
+	}
+
+	return true
+}
+
+//ValidateCallCounters checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *TombstoneAccessorMock) ValidateCallCounters() {
+
+	if !m.ForIDFinished() {
+		m.t.Fatal("Expected call to TombstoneAccessorMock.ForID")
+	}
+
+	if !m.IterateOnPulseFinished() {
+		m.t.Fatal("Expected call to TombstoneAccessorMock.IterateOnPulse")
+	}
+
+}
+
+//CheckMocksCalled checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *TombstoneAccessorMock) CheckMocksCalled() {
+	m.Finish()
+}
+
+//Finish checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish or use Finish method of minimock.Controller
+func (m *TombstoneAccessorMock) Finish() {
+	m.MinimockFinish()
+}
+
+//MinimockFinish checks that all mocked methods of the interface have been called at least once
+func (m *TombstoneAccessorMock) MinimockFinish() {
+
+	if !m.ForIDFinished() {
+		m.t.Fatal("Expected call to TombstoneAccessorMock.ForID")
+	}
+
+	if !m.IterateOnPulseFinished() {
+		m.t.Fatal("Expected call to TombstoneAccessorMock.IterateOnPulse")
+	}
+
+}
+
+//Wait waits for all mocked methods to be called at least once
+//Deprecated: please use MinimockWait or use Wait method of minimock.Controller
+func (m *TombstoneAccessorMock) Wait(timeout time.Duration) {
+	m.MinimockWait(timeout)
+}
+
+//MinimockWait waits for all mocked methods to be called at least once
+//this method is called by minimock.Controller
+func (m *TombstoneAccessorMock) MinimockWait(timeout time.Duration) {
+	timeoutCh := time.After(timeout)
+	for {
+		ok := true
+		ok = ok && m.ForIDFinished()
+		ok = ok && m.IterateOnPulseFinished()
+
+		if ok {
+			return
+		}
+
+		select {
+		case <-timeoutCh:
+
+			if !m.ForIDFinished() {
+				m.t.Error("Expected call to TombstoneAccessorMock.ForID")
+			}
+
+			if !m.IterateOnPulseFinished() {
+				m.t.Error("Expected call to TombstoneAccessorMock.IterateOnPulse")
+			}
+
+			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+//AllMocksCalled returns true if all mocked methods were called before the execution of AllMocksCalled,
+//it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
+func (m *TombstoneAccessorMock) AllMocksCalled() bool {
+
+	if !m.ForIDFinished() {
+		return false
+	}
+
+	if !m.IterateOnPulseFinished() {
+		return false
+	}
+
+	return true
+}
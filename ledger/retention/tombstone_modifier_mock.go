@@ -0,0 +1,264 @@
+package retention
+
+/*
+DO NOT EDIT!
+This code was generated automatically using github.com/gojuno/minimock v1.9
+The original interface "TombstoneModifier" can be found in github.com/insolar/insolar/ledger/retention
+*/
+import (
+	context "context"
+	"sync/atomic"
+	"time"
+
+	"github.com/gojuno/minimock"
+	insolar "github.com/insolar/insolar/insolar"
+
+	testify_assert "github.com/stretchr/testify/assert"
+)
+
+//TombstoneModifierMock implements github.com/insolar/insolar/ledger/retention.TombstoneModifier
+type TombstoneModifierMock struct {
+	t minimock.Tester
+
+	SetFunc       func(p context.Context, p1 insolar.ID, p2 Tombstone) (r error)
+	SetCounter    uint64
+	SetPreCounter uint64
+	SetMock       mTombstoneModifierMockSet
+}
+
+//NewTombstoneModifierMock returns a mock for github.com/insolar/insolar/ledger/retention.TombstoneModifier
+func NewTombstoneModifierMock(t minimock.Tester) *TombstoneModifierMock {
+	m := &TombstoneModifierMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.SetMock = mTombstoneModifierMockSet{mock: m}
+
+	return m
+}
+
+type mTombstoneModifierMockSet struct {
+	mock              *TombstoneModifierMock
+	mainExpectation   *TombstoneModifierMockSetExpectation
+	expectationSeries []*TombstoneModifierMockSetExpectation
+}
+
+type TombstoneModifierMockSetExpectation struct {
+	input  *TombstoneModifierMockSetInput
+	result *TombstoneModifierMockSetResult
+}
+
+type TombstoneModifierMockSetInput struct {
+	p  context.Context
+	p1 insolar.ID
+	p2 Tombstone
+}
+
+type TombstoneModifierMockSetResult struct {
+	r error
+}
+
+//Expect specifies that invocation of TombstoneModifier.Set is expected from 1 to Infinity times
+func (m *mTombstoneModifierMockSet) Expect(p context.Context, p1 insolar.ID, p2 Tombstone) *mTombstoneModifierMockSet {
+	m.mock.SetFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &TombstoneModifierMockSetExpectation{}
+	}
+	m.mainExpectation.input = &TombstoneModifierMockSetInput{p, p1, p2}
+	return m
+}
+
+//Return specifies results of invocation of TombstoneModifier.Set
+func (m *mTombstoneModifierMockSet) Return(r error) *TombstoneModifierMock {
+	m.mock.SetFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &TombstoneModifierMockSetExpectation{}
+	}
+	m.mainExpectation.result = &TombstoneModifierMockSetResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of TombstoneModifier.Set is expected once
+func (m *mTombstoneModifierMockSet) ExpectOnce(p context.Context, p1 insolar.ID, p2 Tombstone) *TombstoneModifierMockSetExpectation {
+	m.mock.SetFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &TombstoneModifierMockSetExpectation{}
+	expectation.input = &TombstoneModifierMockSetInput{p, p1, p2}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *TombstoneModifierMockSetExpectation) Return(r error) {
+	e.result = &TombstoneModifierMockSetResult{r}
+}
+
+//Set uses given function f as a mock of TombstoneModifier.Set method
+func (m *mTombstoneModifierMockSet) Set(f func(p context.Context, p1 insolar.ID, p2 Tombstone) (r error)) *TombstoneModifierMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.SetFunc = f
+	return m.mock
+}
+
+//Set implements github.com/insolar/insolar/ledger/retention.TombstoneModifier interface
+func (m *TombstoneModifierMock) Set(p context.Context, p1 insolar.ID, p2 Tombstone) (r error) {
+	counter := atomic.AddUint64(&m.SetPreCounter, 1)
+	defer atomic.AddUint64(&m.SetCounter, 1)
+
+	if len(m.SetMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.SetMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to TombstoneModifierMock.Set. %v %v %v", p, p1, p2)
+			return
+		}
+
+		input := m.SetMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, TombstoneModifierMockSetInput{p, p1, p2}, "TombstoneModifier.Set got unexpected parameters")
+
+		result := m.SetMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the TombstoneModifierMock.Set")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.SetMock.mainExpectation != nil {
+
+		input := m.SetMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, TombstoneModifierMockSetInput{p, p1, p2}, "TombstoneModifier.Set got unexpected parameters")
+		}
+
+		result := m.SetMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the TombstoneModifierMock.Set")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.SetFunc == nil {
+		m.t.Fatalf("Unexpected call to TombstoneModifierMock.Set. %v %v %v", p, p1, p2)
+		return
+	}
+
+	return m.SetFunc(p, p1, p2)
+}
+
+//SetMinimockCounter returns a count of TombstoneModifierMock.SetFunc invocations
+func (m *TombstoneModifierMock) SetMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.SetCounter)
+}
+
+//SetMinimockPreCounter returns the value of TombstoneModifierMock.Set invocations
+func (m *TombstoneModifierMock) SetMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.SetPreCounter)
+}
+
+//SetFinished returns true if mock invocations count is ok
+func (m *TombstoneModifierMock) SetFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.SetMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.SetCounter) == uint64(len(m.SetMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.SetMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.SetCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.SetFunc != nil {
+		return atomic.LoadUint64(&m.SetCounter) > 0
+	}
+
+	return true
+}
+
+//ValidateCallCounters checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *TombstoneModifierMock) ValidateCallCounters() {
+
+	if !m.SetFinished() {
+		m.t.Fatal("Expected call to TombstoneModifierMock.Set")
+	}
+
+}
+
+//CheckMocksCalled checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *TombstoneModifierMock) CheckMocksCalled() {
+	m.Finish()
+}
+
+//Finish checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish or use Finish method of minimock.Controller
+func (m *TombstoneModifierMock) Finish() {
+	m.MinimockFinish()
+}
+
+//MinimockFinish checks that all mocked methods of the interface have been called at least once
+func (m *TombstoneModifierMock) MinimockFinish() {
+
+	if !m.SetFinished() {
+		m.t.Fatal("Expected call to TombstoneModifierMock.Set")
+	}
+
+}
+
+//Wait waits for all mocked methods to be called at least once
+//Deprecated: please use MinimockWait or use Wait method of minimock.Controller
+func (m *TombstoneModifierMock) Wait(timeout time.Duration) {
+	m.MinimockWait(timeout)
+}
+
+//MinimockWait waits for all mocked methods to be called at least once
+//this method is called by minimock.Controller
+func (m *TombstoneModifierMock) MinimockWait(timeout time.Duration) {
+	timeoutCh := time.After(timeout)
+	for {
+		ok := true
+		ok = ok && m.SetFinished()
+
+		if ok {
+			return
+		}
+
+		select {
+		case <-timeoutCh:
+
+			if !m.SetFinished() {
+				m.t.Error("Expected call to TombstoneModifierMock.Set")
+			}
+
+			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+//AllMocksCalled returns true if all mocked methods were called before the execution of AllMocksCalled,
+//it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
+func (m *TombstoneModifierMock) AllMocksCalled() bool {
+
+	if !m.SetFinished() {
+		return false
+	}
+
+	return true
+}
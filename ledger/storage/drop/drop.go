@@ -55,7 +55,7 @@ type Drop struct {
 	// PrevHash is a hash of all record hashes belongs to previous pulse.
 	PrevHash []byte
 
-	// Hash is a hash of all record hashes belongs to one pulse and previous drop hash.
+	// Hash is a hash of all record hashes belongs to one pulse and previous drop hash (see CalculateHash).
 	Hash []byte
 
 	// Size represents data about physical size of the current jet.Drop.
@@ -0,0 +1,72 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package drop
+
+import (
+	"context"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/internal/ledger/store"
+)
+
+// CalculateHash returns hash of a drop. It's a hash of the previous drop hash followed by ids of the drop records.
+// Provided ids should be sorted in ascending order.
+func CalculateHash(hasher insolar.Hasher, prevHash []byte, ids []insolar.ID) []byte {
+	_, _ = hasher.Write(prevHash)
+	for _, id := range ids {
+		_, _ = hasher.Write(id[:])
+	}
+	return hasher.Sum(nil)
+}
+
+// PrevHash returns hash of the drop preceding a drop of provided jet. Drop of the same jet in the previous pulse is
+// looked up first, then drop of the parent jet (the jet was split). If the jet was joined from its children, hashes of
+// children drops are concatenated. If there are no such drops, nil is returned.
+func PrevHash(
+	ctx context.Context, drops Accessor, jetID insolar.JetID, prevPulse insolar.PulseNumber,
+) ([]byte, error) {
+	candidates := []insolar.JetID{jetID}
+	if jetID.Depth() > 0 {
+		candidates = append(candidates, jet.Parent(jetID))
+	}
+
+	for _, candidate := range candidates {
+		d, err := drops.ForPulse(ctx, candidate, prevPulse)
+		if err == ErrNotFound || err == store.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return d.Hash, nil
+	}
+
+	var joined []byte
+	left, right := jet.Children(jetID)
+	for _, child := range []insolar.JetID{left, right} {
+		d, err := drops.ForPulse(ctx, child, prevPulse)
+		if err == ErrNotFound || err == store.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		joined = append(joined, d.Hash...)
+	}
+	return joined, nil
+}
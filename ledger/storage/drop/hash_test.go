@@ -0,0 +1,72 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package drop
+
+import (
+	"testing"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/jet"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrevHash(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	prevPulse := insolar.FirstPulseNumber + insolar.PulseNumber(10)
+
+	t.Run("same jet", func(t *testing.T) {
+		ms := NewStorageMemory()
+		jetID := jet.NewIDFromString("01")
+		err := ms.Set(ctx, Drop{JetID: jetID, Pulse: prevPulse, Hash: []byte{1}})
+		require.NoError(t, err)
+
+		hash, err := PrevHash(ctx, ms, jetID, prevPulse)
+		require.NoError(t, err)
+		require.Equal(t, []byte{1}, hash)
+	})
+
+	t.Run("split jet", func(t *testing.T) {
+		ms := NewStorageMemory()
+		err := ms.Set(ctx, Drop{JetID: jet.NewIDFromString("01"), Pulse: prevPulse, Hash: []byte{1}})
+		require.NoError(t, err)
+
+		hash, err := PrevHash(ctx, ms, jet.NewIDFromString("011"), prevPulse)
+		require.NoError(t, err)
+		require.Equal(t, []byte{1}, hash)
+	})
+
+	t.Run("joined jet", func(t *testing.T) {
+		ms := NewStorageMemory()
+		err := ms.Set(ctx, Drop{JetID: jet.NewIDFromString("010"), Pulse: prevPulse, Hash: []byte{1}})
+		require.NoError(t, err)
+		err = ms.Set(ctx, Drop{JetID: jet.NewIDFromString("011"), Pulse: prevPulse, Hash: []byte{2}})
+		require.NoError(t, err)
+
+		hash, err := PrevHash(ctx, ms, jet.NewIDFromString("01"), prevPulse)
+		require.NoError(t, err)
+		require.Equal(t, []byte{1, 2}, hash)
+	})
+
+	t.Run("no previous drop", func(t *testing.T) {
+		ms := NewStorageMemory()
+
+		hash, err := PrevHash(ctx, ms, jet.NewIDFromString("01"), prevPulse)
+		require.NoError(t, err)
+		require.Nil(t, hash)
+	})
+}
@@ -0,0 +1,134 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package node
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/ugorji/go/codec"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/log"
+)
+
+// StorageDB is a DB active node storage for each pulse. Unlike Storage it keeps node history across restarts.
+type StorageDB struct {
+	lock sync.RWMutex
+	db   store.DB
+}
+
+type nodeKey insolar.PulseNumber
+
+func (k nodeKey) Scope() store.Scope {
+	return store.ScopeNode
+}
+
+func (k nodeKey) ID() []byte {
+	return insolar.PulseNumber(k).Bytes()
+}
+
+// NewStorageDB creates new instance of StorageDB.
+func NewStorageDB(db store.DB) *StorageDB {
+	return &StorageDB{db: db}
+}
+
+// Set saves active nodes for pulse in DB.
+func (s *StorageDB) Set(pulse insolar.PulseNumber, nodes []insolar.Node) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	_, err := s.db.Get(nodeKey(pulse))
+	if err == nil {
+		return ErrOverride
+	}
+	if err != store.ErrNotFound {
+		return err
+	}
+
+	return s.db.Set(nodeKey(pulse), encode(nodes))
+}
+
+// All return active nodes for specified pulse.
+func (s *StorageDB) All(pulse insolar.PulseNumber) ([]insolar.Node, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.get(pulse)
+}
+
+// InRole return active nodes for specified pulse and role.
+func (s *StorageDB) InRole(pulse insolar.PulseNumber, role insolar.StaticRole) ([]insolar.Node, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	nodes, err := s.get(pulse)
+	if err != nil {
+		return nil, err
+	}
+	var inRole []insolar.Node
+	for _, node := range nodes {
+		if node.Role == role {
+			inRole = append(inRole, node)
+		}
+	}
+
+	return inRole, nil
+}
+
+// Delete erases nodes for specified pulse.
+func (s *StorageDB) Delete(pulse insolar.PulseNumber) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	err := s.db.Delete(nodeKey(pulse))
+	if err != nil {
+		log.Errorf("failed to delete nodes of pulse %v: %s", pulse, err)
+	}
+}
+
+func (s *StorageDB) get(pulse insolar.PulseNumber) ([]insolar.Node, error) {
+	buf, err := s.db.Get(nodeKey(pulse))
+	if err == store.ErrNotFound {
+		return nil, ErrNoNodes
+	}
+	if err != nil {
+		return nil, err
+	}
+	return decode(buf)
+}
+
+func encode(nodes []insolar.Node) []byte {
+	var buf bytes.Buffer
+	enc := codec.NewEncoder(&buf, &codec.CborHandle{})
+	enc.MustEncode(nodes)
+	return buf.Bytes()
+}
+
+func decode(buf []byte) ([]insolar.Node, error) {
+	var nodes []insolar.Node
+	dec := codec.NewDecoderBytes(buf, &codec.CborHandle{})
+	err := dec.Decode(&nodes)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	return nodes, nil
+}
@@ -0,0 +1,62 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package node
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/internal/ledger/store"
+)
+
+func TestStorageDB_KeepsNodesAfterRestart(t *testing.T) {
+	t.Parallel()
+
+	tmpdir, err := ioutil.TempDir("", "bdb-test-")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpdir)
+
+	pulse := gen.PulseNumber()
+	nodes := []insolar.Node{
+		{ID: gen.Reference(), Role: insolar.StaticRoleHeavyMaterial},
+		{ID: gen.Reference(), Role: insolar.StaticRoleVirtual},
+	}
+
+	db, err := store.NewBadgerDB(tmpdir)
+	require.NoError(t, err)
+	err = NewStorageDB(db).Set(pulse, nodes)
+	require.NoError(t, err)
+	require.NoError(t, db.Stop(context.Background()))
+
+	db, err = store.NewBadgerDB(tmpdir)
+	require.NoError(t, err)
+	defer db.Stop(context.Background())
+	storage := NewStorageDB(db)
+
+	result, err := storage.InRole(pulse, insolar.StaticRoleHeavyMaterial)
+	require.NoError(t, err)
+	assert.Equal(t, nodes[:1], result)
+	_, err = storage.All(pulse + 1)
+	assert.Equal(t, ErrNoNodes, err)
+}
@@ -38,7 +38,7 @@ type Modifier interface {
 
 // Storage is an in-memory active node storage for each pulse. It's required to calculate node roles
 // for past pulses to locate data.
-// It should only contain previous N pulses. It should be stored on disk.
+// It should only contain previous N pulses. StorageDB keeps nodes on disk.
 type Storage struct {
 	lock  sync.RWMutex
 	nodes map[insolar.PulseNumber][]insolar.Node
@@ -22,6 +22,7 @@ import (
 	"github.com/google/gofuzz"
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/internal/ledger/store"
 	"github.com/insolar/insolar/ledger/storage/node"
 	"github.com/stretchr/testify/assert"
 )
@@ -48,30 +49,50 @@ func TestNode(t *testing.T) {
 	}
 	all = append(virtuals, materials...)
 	pulse := gen.PulseNumber()
-	storage := node.NewStorage()
 
-	// Saves nodes.
-	{
-		err := storage.Set(pulse, all)
-		assert.NoError(t, err)
-	}
-	// Returns all nodes.
-	{
-		result, err := storage.All(pulse)
-		assert.NoError(t, err)
-		assert.Equal(t, all, result)
-	}
-	// Returns in role nodes.
-	{
-		result, err := storage.InRole(pulse, insolar.StaticRoleVirtual)
-		assert.NoError(t, err)
-		assert.Equal(t, virtuals, result)
-	}
-	// Deletes nodes.
-	{
-		storage.Delete(pulse)
-		result, err := storage.All(pulse)
-		assert.Equal(t, node.ErrNoNodes, err)
-		assert.Nil(t, result)
+	for name, storage := range map[string]interface {
+		node.Accessor
+		node.Modifier
+	}{
+		"memory": node.NewStorage(),
+		"db":     node.NewStorageDB(store.NewMemoryMockDB()),
+	} {
+		// Saves nodes.
+		{
+			err := storage.Set(pulse, all)
+			assert.NoError(t, err, name)
+		}
+		// Doesn't override nodes.
+		{
+			err := storage.Set(pulse, virtuals)
+			assert.Equal(t, node.ErrOverride, err, name)
+		}
+		// Returns all nodes.
+		{
+			result, err := storage.All(pulse)
+			assert.NoError(t, err, name)
+			assert.Equal(t, all, result, name)
+		}
+		// Returns in role nodes.
+		{
+			result, err := storage.InRole(pulse, insolar.StaticRoleVirtual)
+			assert.NoError(t, err, name)
+			assert.Equal(t, virtuals, result, name)
+		}
+		// Returns nil for empty nodes.
+		{
+			err := storage.Set(pulse+1, nil)
+			assert.NoError(t, err, name)
+			result, err := storage.All(pulse + 1)
+			assert.NoError(t, err, name)
+			assert.Nil(t, result, name)
+		}
+		// Deletes nodes.
+		{
+			storage.Delete(pulse)
+			result, err := storage.All(pulse)
+			assert.Equal(t, node.ErrNoNodes, err, name)
+			assert.Nil(t, result, name)
+		}
 	}
 }
@@ -17,7 +17,9 @@
 package object
 
 import (
+	"bytes"
 	"context"
+	"sort"
 	"sync"
 
 	"go.opencensus.io/stats"
@@ -67,6 +69,45 @@ type RecordCollectionAccessor interface {
 	ForPulse(ctx context.Context, jetID insolar.JetID, pn insolar.PulseNumber) []record.MaterialRecord
 }
 
+//go:generate minimock -i github.com/insolar/insolar/ledger/storage/object.RecordPulseIterator -o ./ -s _mock.go
+
+// RecordPulseIterator provides methods for iterating over all records of a pulse.
+type RecordPulseIterator interface {
+	// IterateOnPulse calls handler for every record of provided pulse in ascending order of record ids.
+	// Iteration stops on the first handler error, which is returned.
+	IterateOnPulse(
+		ctx context.Context,
+		pn insolar.PulseNumber,
+		handler func(id insolar.ID, rec record.MaterialRecord) error,
+	) error
+}
+
+// JetRecordIDs returns ids of records of provided jet and pulse in ascending order.
+func JetRecordIDs(
+	ctx context.Context, iterator RecordPulseIterator, jetID insolar.JetID, pn insolar.PulseNumber,
+) ([]insolar.ID, error) {
+	var ids []insolar.ID
+	err := iterator.IterateOnPulse(ctx, pn, func(id insolar.ID, rec record.MaterialRecord) error {
+		if rec.JetID == jetID {
+			ids = append(ids, id)
+		}
+		return nil
+	})
+	return ids, err
+}
+
+// RecordIDsByJet returns ids of records of provided pulse grouped by jet. Ids of every jet are in ascending order.
+func RecordIDsByJet(
+	ctx context.Context, iterator RecordPulseIterator, pn insolar.PulseNumber,
+) (map[insolar.JetID][]insolar.ID, error) {
+	ids := map[insolar.JetID][]insolar.ID{}
+	err := iterator.IterateOnPulse(ctx, pn, func(id insolar.ID, rec record.MaterialRecord) error {
+		ids[rec.JetID] = append(ids[rec.JetID], id)
+		return nil
+	})
+	return ids, err
+}
+
 //go:generate minimock -i github.com/insolar/insolar/ledger/storage/object.RecordModifier -o ./ -s _mock.go
 
 // RecordModifier provides methods for setting record-values to storage.
@@ -153,6 +194,37 @@ func (m *RecordMemory) ForPulse(
 	return res
 }
 
+// IterateOnPulse calls handler for every record of provided pulse in ascending order of record ids.
+func (m *RecordMemory) IterateOnPulse(
+	ctx context.Context,
+	pn insolar.PulseNumber,
+	handler func(id insolar.ID, rec record.MaterialRecord) error,
+) error {
+	m.lock.RLock()
+	var ids []insolar.ID
+	for id := range m.memory {
+		if id.Pulse() == pn {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return bytes.Compare(ids[i][:], ids[j][:]) < 0
+	})
+	recs := make([]record.MaterialRecord, 0, len(ids))
+	for _, id := range ids {
+		recs = append(recs, m.memory[id])
+	}
+	m.lock.RUnlock()
+
+	for i, id := range ids {
+		err := handler(id, recs[i])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Remove method removes records from a storage for all pulses until pulse (pulse included)
 func (m *RecordMemory) Remove(ctx context.Context, pulse insolar.PulseNumber) {
 	m.lock.Lock()
@@ -206,6 +278,23 @@ func (r *RecordDB) ForID(ctx context.Context, id insolar.ID) (record.MaterialRec
 	return r.get(id)
 }
 
+// IterateOnPulse calls handler for every record of provided pulse in ascending order of record ids.
+func (r *RecordDB) IterateOnPulse(
+	ctx context.Context,
+	pn insolar.PulseNumber,
+	handler func(id insolar.ID, rec record.MaterialRecord) error,
+) error {
+	return r.db.Iterate(store.ScopeRecord, pn.Bytes(), func(k, v []byte) error {
+		var id insolar.ID
+		copy(id[:], k)
+		rec, err := DecodeMaterial(v)
+		if err != nil {
+			return err
+		}
+		return handler(id, rec)
+	})
+}
+
 func (r *RecordDB) set(id insolar.ID, rec record.MaterialRecord) error {
 	key := recordKey(id)
 
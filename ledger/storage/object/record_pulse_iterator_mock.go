@@ -0,0 +1,265 @@
+package object
+
+/*
+DO NOT EDIT!
+This code was generated automatically using github.com/gojuno/minimock v1.9
+The original interface "RecordPulseIterator" can be found in github.com/insolar/insolar/ledger/storage/object
+*/
+import (
+	context "context"
+	"sync/atomic"
+	"time"
+
+	"github.com/gojuno/minimock"
+	insolar "github.com/insolar/insolar/insolar"
+	record "github.com/insolar/insolar/insolar/record"
+
+	testify_assert "github.com/stretchr/testify/assert"
+)
+
+//RecordPulseIteratorMock implements github.com/insolar/insolar/ledger/storage/object.RecordPulseIterator
+type RecordPulseIteratorMock struct {
+	t minimock.Tester
+
+	IterateOnPulseFunc       func(p context.Context, p1 insolar.PulseNumber, p2 func(id insolar.ID, rec record.MaterialRecord) error) (r error)
+	IterateOnPulseCounter    uint64
+	IterateOnPulsePreCounter uint64
+	IterateOnPulseMock       mRecordPulseIteratorMockIterateOnPulse
+}
+
+//NewRecordPulseIteratorMock returns a mock for github.com/insolar/insolar/ledger/storage/object.RecordPulseIterator
+func NewRecordPulseIteratorMock(t minimock.Tester) *RecordPulseIteratorMock {
+	m := &RecordPulseIteratorMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.IterateOnPulseMock = mRecordPulseIteratorMockIterateOnPulse{mock: m}
+
+	return m
+}
+
+type mRecordPulseIteratorMockIterateOnPulse struct {
+	mock              *RecordPulseIteratorMock
+	mainExpectation   *RecordPulseIteratorMockIterateOnPulseExpectation
+	expectationSeries []*RecordPulseIteratorMockIterateOnPulseExpectation
+}
+
+type RecordPulseIteratorMockIterateOnPulseExpectation struct {
+	input  *RecordPulseIteratorMockIterateOnPulseInput
+	result *RecordPulseIteratorMockIterateOnPulseResult
+}
+
+type RecordPulseIteratorMockIterateOnPulseInput struct {
+	p  context.Context
+	p1 insolar.PulseNumber
+	p2 func(id insolar.ID, rec record.MaterialRecord) error
+}
+
+type RecordPulseIteratorMockIterateOnPulseResult struct {
+	r error
+}
+
+//Expect specifies that invocation of RecordPulseIterator.IterateOnPulse is expected from 1 to Infinity times
+func (m *mRecordPulseIteratorMockIterateOnPulse) Expect(p context.Context, p1 insolar.PulseNumber, p2 func(id insolar.ID, rec record.MaterialRecord) error) *mRecordPulseIteratorMockIterateOnPulse {
+	m.mock.IterateOnPulseFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &RecordPulseIteratorMockIterateOnPulseExpectation{}
+	}
+	m.mainExpectation.input = &RecordPulseIteratorMockIterateOnPulseInput{p, p1, p2}
+	return m
+}
+
+//Return specifies results of invocation of RecordPulseIterator.IterateOnPulse
+func (m *mRecordPulseIteratorMockIterateOnPulse) Return(r error) *RecordPulseIteratorMock {
+	m.mock.IterateOnPulseFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &RecordPulseIteratorMockIterateOnPulseExpectation{}
+	}
+	m.mainExpectation.result = &RecordPulseIteratorMockIterateOnPulseResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of RecordPulseIterator.IterateOnPulse is expected once
+func (m *mRecordPulseIteratorMockIterateOnPulse) ExpectOnce(p context.Context, p1 insolar.PulseNumber, p2 func(id insolar.ID, rec record.MaterialRecord) error) *RecordPulseIteratorMockIterateOnPulseExpectation {
+	m.mock.IterateOnPulseFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &RecordPulseIteratorMockIterateOnPulseExpectation{}
+	expectation.input = &RecordPulseIteratorMockIterateOnPulseInput{p, p1, p2}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *RecordPulseIteratorMockIterateOnPulseExpectation) Return(r error) {
+	e.result = &RecordPulseIteratorMockIterateOnPulseResult{r}
+}
+
+//Set uses given function f as a mock of RecordPulseIterator.IterateOnPulse method
+func (m *mRecordPulseIteratorMockIterateOnPulse) Set(f func(p context.Context, p1 insolar.PulseNumber, p2 func(id insolar.ID, rec record.MaterialRecord) error) (r error)) *RecordPulseIteratorMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.IterateOnPulseFunc = f
+	return m.mock
+}
+
+//IterateOnPulse implements github.com/insolar/insolar/ledger/storage/object.RecordPulseIterator interface
+func (m *RecordPulseIteratorMock) IterateOnPulse(p context.Context, p1 insolar.PulseNumber, p2 func(id insolar.ID, rec record.MaterialRecord) error) (r error) {
+	counter := atomic.AddUint64(&m.IterateOnPulsePreCounter, 1)
+	defer atomic.AddUint64(&m.IterateOnPulseCounter, 1)
+
+	if len(m.IterateOnPulseMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.IterateOnPulseMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to RecordPulseIteratorMock.IterateOnPulse. %v %v %v", p, p1, p2)
+			return
+		}
+
+		input := m.IterateOnPulseMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, RecordPulseIteratorMockIterateOnPulseInput{p, p1, p2}, "RecordPulseIterator.IterateOnPulse got unexpected parameters")
+
+		result := m.IterateOnPulseMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the RecordPulseIteratorMock.IterateOnPulse")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.IterateOnPulseMock.mainExpectation != nil {
+
+		input := m.IterateOnPulseMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, RecordPulseIteratorMockIterateOnPulseInput{p, p1, p2}, "RecordPulseIterator.IterateOnPulse got unexpected parameters")
+		}
+
+		result := m.IterateOnPulseMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the RecordPulseIteratorMock.IterateOnPulse")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.IterateOnPulseFunc == nil {
+		m.t.Fatalf("Unexpected call to RecordPulseIteratorMock.IterateOnPulse. %v %v %v", p, p1, p2)
+		return
+	}
+
+	return m.IterateOnPulseFunc(p, p1, p2)
+}
+
+//IterateOnPulseMinimockCounter returns a count of RecordPulseIteratorMock.IterateOnPulseFunc invocations
+func (m *RecordPulseIteratorMock) IterateOnPulseMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.IterateOnPulseCounter)
+}
+
+//IterateOnPulseMinimockPreCounter returns the value of RecordPulseIteratorMock.IterateOnPulse invocations
+func (m *RecordPulseIteratorMock) IterateOnPulseMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.IterateOnPulsePreCounter)
+}
+
+//IterateOnPulseFinished returns true if mock invocations count is ok
+func (m *RecordPulseIteratorMock) IterateOnPulseFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.IterateOnPulseMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.IterateOnPulseCounter) == uint64(len(m.IterateOnPulseMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.IterateOnPulseMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.IterateOnPulseCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.IterateOnPulseFunc != nil {
+		return atomic.LoadUint64(&m.IterateOnPulseCounter) > 0
+	}
+
+	return true
+}
+
+//ValidateCallCounters checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *RecordPulseIteratorMock) ValidateCallCounters() {
+
+	if !m.IterateOnPulseFinished() {
+		m.t.Fatal("Expected call to RecordPulseIteratorMock.IterateOnPulse")
+	}
+
+}
+
+//CheckMocksCalled checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *RecordPulseIteratorMock) CheckMocksCalled() {
+	m.Finish()
+}
+
+//Finish checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish or use Finish method of minimock.Controller
+func (m *RecordPulseIteratorMock) Finish() {
+	m.MinimockFinish()
+}
+
+//MinimockFinish checks that all mocked methods of the interface have been called at least once
+func (m *RecordPulseIteratorMock) MinimockFinish() {
+
+	if !m.IterateOnPulseFinished() {
+		m.t.Fatal("Expected call to RecordPulseIteratorMock.IterateOnPulse")
+	}
+
+}
+
+//Wait waits for all mocked methods to be called at least once
+//Deprecated: please use MinimockWait or use Wait method of minimock.Controller
+func (m *RecordPulseIteratorMock) Wait(timeout time.Duration) {
+	m.MinimockWait(timeout)
+}
+
+//MinimockWait waits for all mocked methods to be called at least once
+//this method is called by minimock.Controller
+func (m *RecordPulseIteratorMock) MinimockWait(timeout time.Duration) {
+	timeoutCh := time.After(timeout)
+	for {
+		ok := true
+		ok = ok && m.IterateOnPulseFinished()
+
+		if ok {
+			return
+		}
+
+		select {
+		case <-timeoutCh:
+
+			if !m.IterateOnPulseFinished() {
+				m.t.Error("Expected call to RecordPulseIteratorMock.IterateOnPulse")
+			}
+
+			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+//AllMocksCalled returns true if all mocked methods were called before the execution of AllMocksCalled,
+//it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
+func (m *RecordPulseIteratorMock) AllMocksCalled() bool {
+
+	if !m.IterateOnPulseFinished() {
+		return false
+	}
+
+	return true
+}
@@ -17,7 +17,9 @@
 package object
 
 import (
+	"bytes"
 	"math/rand"
+	"sort"
 	"testing"
 
 	fuzz "github.com/google/gofuzz"
@@ -25,6 +27,7 @@ import (
 	"github.com/insolar/insolar/insolar/gen"
 	"github.com/insolar/insolar/insolar/record"
 	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -192,3 +195,54 @@ func TestRecordStorage_ForPulse(t *testing.T) {
 		require.Equal(t, true, ok)
 	}
 }
+
+func TestRecordStorage_IterateOnPulse(t *testing.T) {
+	t.Parallel()
+
+	ctx := inslogger.TestContext(t)
+	searchPN := gen.PulseNumber()
+
+	var expected []insolar.ID
+	recs := map[insolar.ID]record.MaterialRecord{}
+	for i := 0; i < 10; i++ {
+		randID := gen.ID()
+		id := insolar.NewID(searchPN, randID.Hash())
+		rec := record.MaterialRecord{
+			Record: &ResultRecord{Payload: id.Hash()},
+			JetID:  gen.JetID(),
+		}
+		recs[*id] = rec
+		expected = append(expected, *id)
+	}
+	sort.Slice(expected, func(i, j int) bool {
+		return bytes.Compare(expected[i][:], expected[j][:]) < 0
+	})
+	otherID := gen.ID()
+	if otherID.Pulse() != searchPN {
+		recs[otherID] = record.MaterialRecord{Record: &ResultRecord{}}
+	}
+
+	for name, s := range map[string]interface {
+		RecordModifier
+		RecordPulseIterator
+	}{
+		"memory": NewRecordMemory(),
+		"db":     NewRecordDB(store.NewMemoryMockDB()),
+	} {
+		t.Run(name, func(t *testing.T) {
+			for id, rec := range recs {
+				err := s.Set(ctx, id, rec)
+				require.NoError(t, err)
+			}
+
+			var ids []insolar.ID
+			err := s.IterateOnPulse(ctx, searchPN, func(id insolar.ID, rec record.MaterialRecord) error {
+				ids = append(ids, id)
+				assert.Equal(t, recs[id].JetID, rec.JetID)
+				return nil
+			})
+			require.NoError(t, err)
+			assert.Equal(t, expected, ids)
+		})
+	}
+}
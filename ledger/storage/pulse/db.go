@@ -103,6 +103,7 @@ func (s *StorageDB) Append(ctx context.Context, pulse insolar.Pulse) error {
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
+	// Pulse node, previous node and head are written atomically, so the list is never left broken.
 	var insertWithHead = func(head insolar.PulseNumber) error {
 		oldHead, err := s.get(head)
 		if err != nil {
@@ -110,32 +111,27 @@ func (s *StorageDB) Append(ctx context.Context, pulse insolar.Pulse) error {
 		}
 		oldHead.Next = &pulse.PulseNumber
 
+		batch := &store.Batch{}
 		// Set new pulse.
-		err = s.set(pulse.PulseNumber, dbNode{
+		batch.Set(pulseKey(pulse.PulseNumber), serialize(dbNode{
 			Prev:  &oldHead.Pulse.PulseNumber,
 			Pulse: pulse,
-		})
-		if err != nil {
-			return err
-		}
+		}))
 		// Set old updated tail.
-		err = s.set(oldHead.Pulse.PulseNumber, oldHead)
-		if err != nil {
-			return err
-		}
+		batch.Set(pulseKey(oldHead.Pulse.PulseNumber), serialize(oldHead))
 		// Set head meta record.
-		return s.setHead(pulse.PulseNumber)
+		batch.Set(keyHead, pulse.PulseNumber.Bytes())
+		return s.db.Write(batch)
 	}
 	var insertWithoutHead = func() error {
+		batch := &store.Batch{}
 		// Set new pulse.
-		err := s.set(pulse.PulseNumber, dbNode{
+		batch.Set(pulseKey(pulse.PulseNumber), serialize(dbNode{
 			Pulse: pulse,
-		})
-		if err != nil {
-			return err
-		}
+		}))
 		// Set head meta record.
-		return s.setHead(pulse.PulseNumber)
+		batch.Set(keyHead, pulse.PulseNumber.Bytes())
+		return s.db.Write(batch)
 	}
 
 	head, err := s.head()
@@ -214,10 +210,6 @@ func (s *StorageDB) get(pn insolar.PulseNumber) (nd dbNode, err error) {
 	return
 }
 
-func (s *StorageDB) set(pn insolar.PulseNumber, nd dbNode) error {
-	return s.db.Set(pulseKey(pn), serialize(nd))
-}
-
 func (s *StorageDB) head() (pn insolar.PulseNumber, err error) {
 	buf, err := s.db.Get(keyHead)
 	if err == store.ErrNotFound {
@@ -231,10 +223,6 @@ func (s *StorageDB) head() (pn insolar.PulseNumber, err error) {
 	return
 }
 
-func (s *StorageDB) setHead(pn insolar.PulseNumber) error {
-	return s.db.Set(keyHead, pn.Bytes())
-}
-
 func serialize(nd dbNode) []byte {
 	buff := bytes.NewBuffer(nil)
 	enc := codec.NewEncoder(buff, &codec.CborHandle{})
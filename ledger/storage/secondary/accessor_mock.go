@@ -0,0 +1,444 @@
+package secondary
+
+/*
+DO NOT EDIT!
+This code was generated automatically using github.com/gojuno/minimock v1.9
+The original interface "Accessor" can be found in github.com/insolar/insolar/ledger/storage/secondary
+*/
+import (
+	context "context"
+	"sync/atomic"
+	"time"
+
+	"github.com/gojuno/minimock"
+	insolar "github.com/insolar/insolar/insolar"
+
+	testify_assert "github.com/stretchr/testify/assert"
+)
+
+//AccessorMock implements github.com/insolar/insolar/ledger/storage/secondary.Accessor
+type AccessorMock struct {
+	t minimock.Tester
+
+	ForParentFunc       func(p context.Context, p1 insolar.Reference, p2 insolar.PulseNumber, p3 insolar.PulseNumber) (r []insolar.Reference, r1 error)
+	ForParentCounter    uint64
+	ForParentPreCounter uint64
+	ForParentMock       mAccessorMockForParent
+
+	ForPrototypeFunc       func(p context.Context, p1 insolar.Reference, p2 insolar.PulseNumber, p3 insolar.PulseNumber) (r []insolar.Reference, r1 error)
+	ForPrototypeCounter    uint64
+	ForPrototypePreCounter uint64
+	ForPrototypeMock       mAccessorMockForPrototype
+}
+
+//NewAccessorMock returns a mock for github.com/insolar/insolar/ledger/storage/secondary.Accessor
+func NewAccessorMock(t minimock.Tester) *AccessorMock {
+	m := &AccessorMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ForParentMock = mAccessorMockForParent{mock: m}
+	m.ForPrototypeMock = mAccessorMockForPrototype{mock: m}
+
+	return m
+}
+
+type mAccessorMockForParent struct {
+	mock              *AccessorMock
+	mainExpectation   *AccessorMockForParentExpectation
+	expectationSeries []*AccessorMockForParentExpectation
+}
+
+type AccessorMockForParentExpectation struct {
+	input  *AccessorMockForParentInput
+	result *AccessorMockForParentResult
+}
+
+type AccessorMockForParentInput struct {
+	p  context.Context
+	p1 insolar.Reference
+	p2 insolar.PulseNumber
+	p3 insolar.PulseNumber
+}
+
+type AccessorMockForParentResult struct {
+	r  []insolar.Reference
+	r1 error
+}
+
+//Expect specifies that invocation of Accessor.ForParent is expected from 1 to Infinity times
+func (m *mAccessorMockForParent) Expect(p context.Context, p1 insolar.Reference, p2 insolar.PulseNumber, p3 insolar.PulseNumber) *mAccessorMockForParent {
+	m.mock.ForParentFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &AccessorMockForParentExpectation{}
+	}
+	m.mainExpectation.input = &AccessorMockForParentInput{p, p1, p2, p3}
+	return m
+}
+
+//Return specifies results of invocation of Accessor.ForParent
+func (m *mAccessorMockForParent) Return(r []insolar.Reference, r1 error) *AccessorMock {
+	m.mock.ForParentFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &AccessorMockForParentExpectation{}
+	}
+	m.mainExpectation.result = &AccessorMockForParentResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Accessor.ForParent is expected once
+func (m *mAccessorMockForParent) ExpectOnce(p context.Context, p1 insolar.Reference, p2 insolar.PulseNumber, p3 insolar.PulseNumber) *AccessorMockForParentExpectation {
+	m.mock.ForParentFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &AccessorMockForParentExpectation{}
+	expectation.input = &AccessorMockForParentInput{p, p1, p2, p3}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *AccessorMockForParentExpectation) Return(r []insolar.Reference, r1 error) {
+	e.result = &AccessorMockForParentResult{r, r1}
+}
+
+//Set uses given function f as a mock of Accessor.ForParent method
+func (m *mAccessorMockForParent) Set(f func(p context.Context, p1 insolar.Reference, p2 insolar.PulseNumber, p3 insolar.PulseNumber) (r []insolar.Reference, r1 error)) *AccessorMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.ForParentFunc = f
+	return m.mock
+}
+
+//ForParent implements github.com/insolar/insolar/ledger/storage/secondary.Accessor interface
+func (m *AccessorMock) ForParent(p context.Context, p1 insolar.Reference, p2 insolar.PulseNumber, p3 insolar.PulseNumber) (r []insolar.Reference, r1 error) {
+	counter := atomic.AddUint64(&m.ForParentPreCounter, 1)
+	defer atomic.AddUint64(&m.ForParentCounter, 1)
+
+	if len(m.ForParentMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.ForParentMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to AccessorMock.ForParent. %v %v %v %v", p, p1, p2, p3)
+			return
+		}
+
+		input := m.ForParentMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, AccessorMockForParentInput{p, p1, p2, p3}, "Accessor.ForParent got unexpected parameters")
+
+		result := m.ForParentMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the AccessorMock.ForParent")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.ForParentMock.mainExpectation != nil {
+
+		input := m.ForParentMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, AccessorMockForParentInput{p, p1, p2, p3}, "Accessor.ForParent got unexpected parameters")
+		}
+
+		result := m.ForParentMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the AccessorMock.ForParent")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.ForParentFunc == nil {
+		m.t.Fatalf("Unexpected call to AccessorMock.ForParent. %v %v %v %v", p, p1, p2, p3)
+		return
+	}
+
+	return m.ForParentFunc(p, p1, p2, p3)
+}
+
+//ForParentMinimockCounter returns a count of AccessorMock.ForParentFunc invocations
+func (m *AccessorMock) ForParentMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.ForParentCounter)
+}
+
+//ForParentMinimockPreCounter returns the value of AccessorMock.ForParent invocations
+func (m *AccessorMock) ForParentMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.ForParentPreCounter)
+}
+
+//ForParentFinished returns true if mock invocations count is ok
+func (m *AccessorMock) ForParentFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.ForParentMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.ForParentCounter) == uint64(len(m.ForParentMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.ForParentMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.ForParentCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.ForParentFunc != nil {
+		return atomic.LoadUint64(&m.ForParentCounter) > 0
+	}
+
+	return true
+}
+
+type mAccessorMockForPrototype struct {
+	mock              *AccessorMock
+	mainExpectation   *AccessorMockForPrototypeExpectation
+	expectationSeries []*AccessorMockForPrototypeExpectation
+}
+
+type AccessorMockForPrototypeExpectation struct {
+	input  *AccessorMockForPrototypeInput
+	result *AccessorMockForPrototypeResult
+}
+
+type AccessorMockForPrototypeInput struct {
+	p  context.Context
+	p1 insolar.Reference
+	p2 insolar.PulseNumber
+	p3 insolar.PulseNumber
+}
+
+type AccessorMockForPrototypeResult struct {
+	r  []insolar.Reference
+	r1 error
+}
+
+//Expect specifies that invocation of Accessor.ForPrototype is expected from 1 to Infinity times
+func (m *mAccessorMockForPrototype) Expect(p context.Context, p1 insolar.Reference, p2 insolar.PulseNumber, p3 insolar.PulseNumber) *mAccessorMockForPrototype {
+	m.mock.ForPrototypeFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &AccessorMockForPrototypeExpectation{}
+	}
+	m.mainExpectation.input = &AccessorMockForPrototypeInput{p, p1, p2, p3}
+	return m
+}
+
+//Return specifies results of invocation of Accessor.ForPrototype
+func (m *mAccessorMockForPrototype) Return(r []insolar.Reference, r1 error) *AccessorMock {
+	m.mock.ForPrototypeFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &AccessorMockForPrototypeExpectation{}
+	}
+	m.mainExpectation.result = &AccessorMockForPrototypeResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Accessor.ForPrototype is expected once
+func (m *mAccessorMockForPrototype) ExpectOnce(p context.Context, p1 insolar.Reference, p2 insolar.PulseNumber, p3 insolar.PulseNumber) *AccessorMockForPrototypeExpectation {
+	m.mock.ForPrototypeFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &AccessorMockForPrototypeExpectation{}
+	expectation.input = &AccessorMockForPrototypeInput{p, p1, p2, p3}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *AccessorMockForPrototypeExpectation) Return(r []insolar.Reference, r1 error) {
+	e.result = &AccessorMockForPrototypeResult{r, r1}
+}
+
+//Set uses given function f as a mock of Accessor.ForPrototype method
+func (m *mAccessorMockForPrototype) Set(f func(p context.Context, p1 insolar.Reference, p2 insolar.PulseNumber, p3 insolar.PulseNumber) (r []insolar.Reference, r1 error)) *AccessorMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.ForPrototypeFunc = f
+	return m.mock
+}
+
+//ForPrototype implements github.com/insolar/insolar/ledger/storage/secondary.Accessor interface
+func (m *AccessorMock) ForPrototype(p context.Context, p1 insolar.Reference, p2 insolar.PulseNumber, p3 insolar.PulseNumber) (r []insolar.Reference, r1 error) {
+	counter := atomic.AddUint64(&m.ForPrototypePreCounter, 1)
+	defer atomic.AddUint64(&m.ForPrototypeCounter, 1)
+
+	if len(m.ForPrototypeMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.ForPrototypeMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to AccessorMock.ForPrototype. %v %v %v %v", p, p1, p2, p3)
+			return
+		}
+
+		input := m.ForPrototypeMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, AccessorMockForPrototypeInput{p, p1, p2, p3}, "Accessor.ForPrototype got unexpected parameters")
+
+		result := m.ForPrototypeMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the AccessorMock.ForPrototype")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.ForPrototypeMock.mainExpectation != nil {
+
+		input := m.ForPrototypeMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, AccessorMockForPrototypeInput{p, p1, p2, p3}, "Accessor.ForPrototype got unexpected parameters")
+		}
+
+		result := m.ForPrototypeMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the AccessorMock.ForPrototype")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.ForPrototypeFunc == nil {
+		m.t.Fatalf("Unexpected call to AccessorMock.ForPrototype. %v %v %v %v", p, p1, p2, p3)
+		return
+	}
+
+	return m.ForPrototypeFunc(p, p1, p2, p3)
+}
+
+//ForPrototypeMinimockCounter returns a count of AccessorMock.ForPrototypeFunc invocations
+func (m *AccessorMock) ForPrototypeMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.ForPrototypeCounter)
+}
+
+//ForPrototypeMinimockPreCounter returns the value of AccessorMock.ForPrototype invocations
+func (m *AccessorMock) ForPrototypeMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.ForPrototypePreCounter)
+}
+
+//ForPrototypeFinished returns true if mock invocations count is ok
+func (m *AccessorMock) ForPrototypeFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.ForPrototypeMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.ForPrototypeCounter) == uint64(len(m.ForPrototypeMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.ForPrototypeMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.ForPrototypeCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.ForPrototypeFunc != nil {
+		return atomic.LoadUint64(&m.ForPrototypeCounter) > 0
+	}
+
+	return true
+}
+
+//ValidateCallCounters checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *AccessorMock) ValidateCallCounters() {
+
+	if !m.ForParentFinished() {
+		m.t.Fatal("Expected call to AccessorMock.ForParent")
+	}
+
+	if !m.ForPrototypeFinished() {
+		m.t.Fatal("Expected call to AccessorMock.ForPrototype")
+	}
+
+}
+
+//CheckMocksCalled checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *AccessorMock) CheckMocksCalled() {
+	m.Finish()
+}
+
+//Finish checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish or use Finish method of minimock.Controller
+func (m *AccessorMock) Finish() {
+	m.MinimockFinish()
+}
+
+//MinimockFinish checks that all mocked methods of the interface have been called at least once
+func (m *AccessorMock) MinimockFinish() {
+
+	if !m.ForParentFinished() {
+		m.t.Fatal("Expected call to AccessorMock.ForParent")
+	}
+
+	if !m.ForPrototypeFinished() {
+		m.t.Fatal("Expected call to AccessorMock.ForPrototype")
+	}
+
+}
+
+//Wait waits for all mocked methods to be called at least once
+//Deprecated: please use MinimockWait or use Wait method of minimock.Controller
+func (m *AccessorMock) Wait(timeout time.Duration) {
+	m.MinimockWait(timeout)
+}
+
+//MinimockWait waits for all mocked methods to be called at least once
+//this method is called by minimock.Controller
+func (m *AccessorMock) MinimockWait(timeout time.Duration) {
+	timeoutCh := time.After(timeout)
+	for {
+		ok := true
+		ok = ok && m.ForParentFinished()
+		ok = ok && m.ForPrototypeFinished()
+
+		if ok {
+			return
+		}
+
+		select {
+		case <-timeoutCh:
+
+			if !m.ForParentFinished() {
+				m.t.Error("Expected call to AccessorMock.ForParent")
+			}
+
+			if !m.ForPrototypeFinished() {
+				m.t.Error("Expected call to AccessorMock.ForPrototype")
+			}
+
+			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+//AllMocksCalled returns true if all mocked methods were called before the execution of AllMocksCalled,
+//it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
+func (m *AccessorMock) AllMocksCalled() bool {
+
+	if !m.ForParentFinished() {
+		return false
+	}
+
+	if !m.ForPrototypeFinished() {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,104 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package secondary
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/internal/ledger/store"
+)
+
+// StorageDB is a persistent storage of secondary indexes.
+type StorageDB struct {
+	db store.DB
+}
+
+// NewStorageDB creates a new storage, that holds persistent data.
+func NewStorageDB(db store.DB) *StorageDB {
+	return &StorageDB{db: db}
+}
+
+// Key of an index entry is kind, owner reference and object head. Heads of an owner are stored in order of their
+// pulses, because reference starts with pulse number.
+type dbKey struct {
+	kind  kind
+	owner insolar.Reference
+	head  insolar.Reference
+}
+
+func (k *dbKey) Scope() store.Scope {
+	return store.ScopeSecondaryIndex
+}
+
+func (k *dbKey) ID() []byte {
+	return append(ownerPrefix(k.kind, k.owner), k.head[:]...)
+}
+
+func ownerPrefix(kind kind, owner insolar.Reference) []byte {
+	return append([]byte{byte(kind)}, owner[:]...)
+}
+
+// errStop stops iteration over index entries.
+var errStop = errors.New("stop iteration")
+
+// ForPrototype returns heads of objects activated with provided prototype in pulses from `from` to `to` inclusive.
+func (s *StorageDB) ForPrototype(
+	ctx context.Context, prototype insolar.Reference, from, to insolar.PulseNumber,
+) ([]insolar.Reference, error) {
+	return s.heads(kindPrototype, prototype, from, to)
+}
+
+// ForParent returns heads of children of provided parent activated in pulses from `from` to `to` inclusive.
+func (s *StorageDB) ForParent(
+	ctx context.Context, parent insolar.Reference, from, to insolar.PulseNumber,
+) ([]insolar.Reference, error) {
+	return s.heads(kindParent, parent, from, to)
+}
+
+// AddToPrototype adds object head to the index of provided prototype.
+func (s *StorageDB) AddToPrototype(ctx context.Context, prototype, head insolar.Reference) error {
+	return s.db.Set(&dbKey{kind: kindPrototype, owner: prototype, head: head}, []byte{})
+}
+
+// AddToParent adds child head to the index of provided parent.
+func (s *StorageDB) AddToParent(ctx context.Context, parent, head insolar.Reference) error {
+	return s.db.Set(&dbKey{kind: kindParent, owner: parent, head: head}, []byte{})
+}
+
+func (s *StorageDB) heads(kind kind, owner insolar.Reference, from, to insolar.PulseNumber) ([]insolar.Reference, error) {
+	prefix := ownerPrefix(kind, owner)
+	var res []insolar.Reference
+	err := s.db.Iterate(store.ScopeSecondaryIndex, prefix, func(id, _ []byte) error {
+		var head insolar.Reference
+		copy(head[:], id[len(prefix):])
+		pn := head.Record().Pulse()
+		if pn > to {
+			return errStop
+		}
+		if pn >= from {
+			res = append(res, head)
+		}
+		return nil
+	})
+	if err != nil && err != errStop {
+		return nil, errors.Wrap(err, "failed to iterate index")
+	}
+	return res, nil
+}
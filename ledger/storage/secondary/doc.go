@@ -0,0 +1,24 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package secondary contains secondary indexes of objects: from prototype to heads of its instances and from parent
+// to heads of its children.
+//
+// Light material nodes index records of their jets and drop indexes together with the rest of light data. Heavy
+// material node indexes records received by replication and genesis records.
+// Indexes are split by activation pulse of objects, so objects can be listed for a range of pulses without walking
+// children of the root domain.
+package secondary
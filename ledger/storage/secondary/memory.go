@@ -0,0 +1,118 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package secondary
+
+import (
+	"context"
+	"sync"
+
+	"github.com/insolar/insolar/insolar"
+)
+
+type memoryKey struct {
+	kind  kind
+	owner insolar.Reference
+}
+
+type pulseHeads map[insolar.PulseNumber]map[insolar.Reference]struct{}
+
+// StorageMemory is an in-memory storage of secondary indexes.
+type StorageMemory struct {
+	lock    sync.RWMutex
+	indexes map[memoryKey]pulseHeads
+}
+
+// NewStorageMemory creates a new instance of StorageMemory.
+func NewStorageMemory() *StorageMemory {
+	return &StorageMemory{
+		indexes: map[memoryKey]pulseHeads{},
+	}
+}
+
+// ForPrototype returns heads of objects activated with provided prototype in pulses from `from` to `to` inclusive.
+func (s *StorageMemory) ForPrototype(
+	ctx context.Context, prototype insolar.Reference, from, to insolar.PulseNumber,
+) ([]insolar.Reference, error) {
+	return s.heads(memoryKey{kind: kindPrototype, owner: prototype}, from, to), nil
+}
+
+// ForParent returns heads of children of provided parent activated in pulses from `from` to `to` inclusive.
+func (s *StorageMemory) ForParent(
+	ctx context.Context, parent insolar.Reference, from, to insolar.PulseNumber,
+) ([]insolar.Reference, error) {
+	return s.heads(memoryKey{kind: kindParent, owner: parent}, from, to), nil
+}
+
+// AddToPrototype adds object head to the index of provided prototype.
+func (s *StorageMemory) AddToPrototype(ctx context.Context, prototype, head insolar.Reference) error {
+	s.add(memoryKey{kind: kindPrototype, owner: prototype}, head)
+	return nil
+}
+
+// AddToParent adds child head to the index of provided parent.
+func (s *StorageMemory) AddToParent(ctx context.Context, parent, head insolar.Reference) error {
+	s.add(memoryKey{kind: kindParent, owner: parent}, head)
+	return nil
+}
+
+// Delete removes objects activated in provided pulse from indexes.
+func (s *StorageMemory) Delete(ctx context.Context, pulse insolar.PulseNumber) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for key, index := range s.indexes {
+		delete(index, pulse)
+		if len(index) == 0 {
+			delete(s.indexes, key)
+		}
+	}
+}
+
+func (s *StorageMemory) add(key memoryKey, head insolar.Reference) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	index, ok := s.indexes[key]
+	if !ok {
+		index = pulseHeads{}
+		s.indexes[key] = index
+	}
+	pn := head.Record().Pulse()
+	heads, ok := index[pn]
+	if !ok {
+		heads = map[insolar.Reference]struct{}{}
+		index[pn] = heads
+	}
+	heads[head] = struct{}{}
+}
+
+func (s *StorageMemory) heads(key memoryKey, from, to insolar.PulseNumber) []insolar.Reference {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	var res []insolar.Reference
+	for pn, heads := range s.indexes[key] {
+		if pn < from || pn > to {
+			continue
+		}
+		for head := range heads {
+			res = append(res, head)
+		}
+	}
+	sortHeads(res)
+	return res
+}
@@ -0,0 +1,436 @@
+package secondary
+
+/*
+DO NOT EDIT!
+This code was generated automatically using github.com/gojuno/minimock v1.9
+The original interface "Modifier" can be found in github.com/insolar/insolar/ledger/storage/secondary
+*/
+import (
+	context "context"
+	"sync/atomic"
+	"time"
+
+	"github.com/gojuno/minimock"
+	insolar "github.com/insolar/insolar/insolar"
+
+	testify_assert "github.com/stretchr/testify/assert"
+)
+
+//ModifierMock implements github.com/insolar/insolar/ledger/storage/secondary.Modifier
+type ModifierMock struct {
+	t minimock.Tester
+
+	AddToParentFunc       func(p context.Context, p1 insolar.Reference, p2 insolar.Reference) (r error)
+	AddToParentCounter    uint64
+	AddToParentPreCounter uint64
+	AddToParentMock       mModifierMockAddToParent
+
+	AddToPrototypeFunc       func(p context.Context, p1 insolar.Reference, p2 insolar.Reference) (r error)
+	AddToPrototypeCounter    uint64
+	AddToPrototypePreCounter uint64
+	AddToPrototypeMock       mModifierMockAddToPrototype
+}
+
+//NewModifierMock returns a mock for github.com/insolar/insolar/ledger/storage/secondary.Modifier
+func NewModifierMock(t minimock.Tester) *ModifierMock {
+	m := &ModifierMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AddToParentMock = mModifierMockAddToParent{mock: m}
+	m.AddToPrototypeMock = mModifierMockAddToPrototype{mock: m}
+
+	return m
+}
+
+type mModifierMockAddToParent struct {
+	mock              *ModifierMock
+	mainExpectation   *ModifierMockAddToParentExpectation
+	expectationSeries []*ModifierMockAddToParentExpectation
+}
+
+type ModifierMockAddToParentExpectation struct {
+	input  *ModifierMockAddToParentInput
+	result *ModifierMockAddToParentResult
+}
+
+type ModifierMockAddToParentInput struct {
+	p  context.Context
+	p1 insolar.Reference
+	p2 insolar.Reference
+}
+
+type ModifierMockAddToParentResult struct {
+	r error
+}
+
+//Expect specifies that invocation of Modifier.AddToParent is expected from 1 to Infinity times
+func (m *mModifierMockAddToParent) Expect(p context.Context, p1 insolar.Reference, p2 insolar.Reference) *mModifierMockAddToParent {
+	m.mock.AddToParentFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ModifierMockAddToParentExpectation{}
+	}
+	m.mainExpectation.input = &ModifierMockAddToParentInput{p, p1, p2}
+	return m
+}
+
+//Return specifies results of invocation of Modifier.AddToParent
+func (m *mModifierMockAddToParent) Return(r error) *ModifierMock {
+	m.mock.AddToParentFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ModifierMockAddToParentExpectation{}
+	}
+	m.mainExpectation.result = &ModifierMockAddToParentResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Modifier.AddToParent is expected once
+func (m *mModifierMockAddToParent) ExpectOnce(p context.Context, p1 insolar.Reference, p2 insolar.Reference) *ModifierMockAddToParentExpectation {
+	m.mock.AddToParentFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ModifierMockAddToParentExpectation{}
+	expectation.input = &ModifierMockAddToParentInput{p, p1, p2}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ModifierMockAddToParentExpectation) Return(r error) {
+	e.result = &ModifierMockAddToParentResult{r}
+}
+
+//Set uses given function f as a mock of Modifier.AddToParent method
+func (m *mModifierMockAddToParent) Set(f func(p context.Context, p1 insolar.Reference, p2 insolar.Reference) (r error)) *ModifierMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.AddToParentFunc = f
+	return m.mock
+}
+
+//AddToParent implements github.com/insolar/insolar/ledger/storage/secondary.Modifier interface
+func (m *ModifierMock) AddToParent(p context.Context, p1 insolar.Reference, p2 insolar.Reference) (r error) {
+	counter := atomic.AddUint64(&m.AddToParentPreCounter, 1)
+	defer atomic.AddUint64(&m.AddToParentCounter, 1)
+
+	if len(m.AddToParentMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.AddToParentMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ModifierMock.AddToParent. %v %v %v", p, p1, p2)
+			return
+		}
+
+		input := m.AddToParentMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ModifierMockAddToParentInput{p, p1, p2}, "Modifier.AddToParent got unexpected parameters")
+
+		result := m.AddToParentMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ModifierMock.AddToParent")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.AddToParentMock.mainExpectation != nil {
+
+		input := m.AddToParentMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ModifierMockAddToParentInput{p, p1, p2}, "Modifier.AddToParent got unexpected parameters")
+		}
+
+		result := m.AddToParentMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ModifierMock.AddToParent")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.AddToParentFunc == nil {
+		m.t.Fatalf("Unexpected call to ModifierMock.AddToParent. %v %v %v", p, p1, p2)
+		return
+	}
+
+	return m.AddToParentFunc(p, p1, p2)
+}
+
+//AddToParentMinimockCounter returns a count of ModifierMock.AddToParentFunc invocations
+func (m *ModifierMock) AddToParentMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.AddToParentCounter)
+}
+
+//AddToParentMinimockPreCounter returns the value of ModifierMock.AddToParent invocations
+func (m *ModifierMock) AddToParentMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.AddToParentPreCounter)
+}
+
+//AddToParentFinished returns true if mock invocations count is ok
+func (m *ModifierMock) AddToParentFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.AddToParentMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.AddToParentCounter) == uint64(len(m.AddToParentMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.AddToParentMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.AddToParentCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.AddToParentFunc != nil {
+		return atomic.LoadUint64(&m.AddToParentCounter) > 0
+	}
+
+	return true
+}
+
+type mModifierMockAddToPrototype struct {
+	mock              *ModifierMock
+	mainExpectation   *ModifierMockAddToPrototypeExpectation
+	expectationSeries []*ModifierMockAddToPrototypeExpectation
+}
+
+type ModifierMockAddToPrototypeExpectation struct {
+	input  *ModifierMockAddToPrototypeInput
+	result *ModifierMockAddToPrototypeResult
+}
+
+type ModifierMockAddToPrototypeInput struct {
+	p  context.Context
+	p1 insolar.Reference
+	p2 insolar.Reference
+}
+
+type ModifierMockAddToPrototypeResult struct {
+	r error
+}
+
+//Expect specifies that invocation of Modifier.AddToPrototype is expected from 1 to Infinity times
+func (m *mModifierMockAddToPrototype) Expect(p context.Context, p1 insolar.Reference, p2 insolar.Reference) *mModifierMockAddToPrototype {
+	m.mock.AddToPrototypeFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ModifierMockAddToPrototypeExpectation{}
+	}
+	m.mainExpectation.input = &ModifierMockAddToPrototypeInput{p, p1, p2}
+	return m
+}
+
+//Return specifies results of invocation of Modifier.AddToPrototype
+func (m *mModifierMockAddToPrototype) Return(r error) *ModifierMock {
+	m.mock.AddToPrototypeFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ModifierMockAddToPrototypeExpectation{}
+	}
+	m.mainExpectation.result = &ModifierMockAddToPrototypeResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Modifier.AddToPrototype is expected once
+func (m *mModifierMockAddToPrototype) ExpectOnce(p context.Context, p1 insolar.Reference, p2 insolar.Reference) *ModifierMockAddToPrototypeExpectation {
+	m.mock.AddToPrototypeFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ModifierMockAddToPrototypeExpectation{}
+	expectation.input = &ModifierMockAddToPrototypeInput{p, p1, p2}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ModifierMockAddToPrototypeExpectation) Return(r error) {
+	e.result = &ModifierMockAddToPrototypeResult{r}
+}
+
+//Set uses given function f as a mock of Modifier.AddToPrototype method
+func (m *mModifierMockAddToPrototype) Set(f func(p context.Context, p1 insolar.Reference, p2 insolar.Reference) (r error)) *ModifierMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.AddToPrototypeFunc = f
+	return m.mock
+}
+
+//AddToPrototype implements github.com/insolar/insolar/ledger/storage/secondary.Modifier interface
+func (m *ModifierMock) AddToPrototype(p context.Context, p1 insolar.Reference, p2 insolar.Reference) (r error) {
+	counter := atomic.AddUint64(&m.AddToPrototypePreCounter, 1)
+	defer atomic.AddUint64(&m.AddToPrototypeCounter, 1)
+
+	if len(m.AddToPrototypeMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.AddToPrototypeMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ModifierMock.AddToPrototype. %v %v %v", p, p1, p2)
+			return
+		}
+
+		input := m.AddToPrototypeMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ModifierMockAddToPrototypeInput{p, p1, p2}, "Modifier.AddToPrototype got unexpected parameters")
+
+		result := m.AddToPrototypeMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ModifierMock.AddToPrototype")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.AddToPrototypeMock.mainExpectation != nil {
+
+		input := m.AddToPrototypeMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ModifierMockAddToPrototypeInput{p, p1, p2}, "Modifier.AddToPrototype got unexpected parameters")
+		}
+
+		result := m.AddToPrototypeMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ModifierMock.AddToPrototype")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.AddToPrototypeFunc == nil {
+		m.t.Fatalf("Unexpected call to ModifierMock.AddToPrototype. %v %v %v", p, p1, p2)
+		return
+	}
+
+	return m.AddToPrototypeFunc(p, p1, p2)
+}
+
+//AddToPrototypeMinimockCounter returns a count of ModifierMock.AddToPrototypeFunc invocations
+func (m *ModifierMock) AddToPrototypeMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.AddToPrototypeCounter)
+}
+
+//AddToPrototypeMinimockPreCounter returns the value of ModifierMock.AddToPrototype invocations
+func (m *ModifierMock) AddToPrototypeMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.AddToPrototypePreCounter)
+}
+
+//AddToPrototypeFinished returns true if mock invocations count is ok
+func (m *ModifierMock) AddToPrototypeFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.AddToPrototypeMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.AddToPrototypeCounter) == uint64(len(m.AddToPrototypeMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.AddToPrototypeMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.AddToPrototypeCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.AddToPrototypeFunc != nil {
+		return atomic.LoadUint64(&m.AddToPrototypeCounter) > 0
+	}
+
+	return true
+}
+
+//ValidateCallCounters checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *ModifierMock) ValidateCallCounters() {
+
+	if !m.AddToParentFinished() {
+		m.t.Fatal("Expected call to ModifierMock.AddToParent")
+	}
+
+	if !m.AddToPrototypeFinished() {
+		m.t.Fatal("Expected call to ModifierMock.AddToPrototype")
+	}
+
+}
+
+//CheckMocksCalled checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *ModifierMock) CheckMocksCalled() {
+	m.Finish()
+}
+
+//Finish checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish or use Finish method of minimock.Controller
+func (m *ModifierMock) Finish() {
+	m.MinimockFinish()
+}
+
+//MinimockFinish checks that all mocked methods of the interface have been called at least once
+func (m *ModifierMock) MinimockFinish() {
+
+	if !m.AddToParentFinished() {
+		m.t.Fatal("Expected call to ModifierMock.AddToParent")
+	}
+
+	if !m.AddToPrototypeFinished() {
+		m.t.Fatal("Expected call to ModifierMock.AddToPrototype")
+	}
+
+}
+
+//Wait waits for all mocked methods to be called at least once
+//Deprecated: please use MinimockWait or use Wait method of minimock.Controller
+func (m *ModifierMock) Wait(timeout time.Duration) {
+	m.MinimockWait(timeout)
+}
+
+//MinimockWait waits for all mocked methods to be called at least once
+//this method is called by minimock.Controller
+func (m *ModifierMock) MinimockWait(timeout time.Duration) {
+	timeoutCh := time.After(timeout)
+	for {
+		ok := true
+		ok = ok && m.AddToParentFinished()
+		ok = ok && m.AddToPrototypeFinished()
+
+		if ok {
+			return
+		}
+
+		select {
+		case <-timeoutCh:
+
+			if !m.AddToParentFinished() {
+				m.t.Error("Expected call to ModifierMock.AddToParent")
+			}
+
+			if !m.AddToPrototypeFinished() {
+				m.t.Error("Expected call to ModifierMock.AddToPrototype")
+			}
+
+			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+//AllMocksCalled returns true if all mocked methods were called before the execution of AllMocksCalled,
+//it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
+func (m *ModifierMock) AllMocksCalled() bool {
+
+	if !m.AddToParentFinished() {
+		return false
+	}
+
+	if !m.AddToPrototypeFinished() {
+		return false
+	}
+
+	return true
+}
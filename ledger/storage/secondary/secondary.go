@@ -0,0 +1,104 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package secondary
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/ledger/storage/object"
+)
+
+//go:generate minimock -i github.com/insolar/insolar/ledger/storage/secondary.Accessor -o ./ -s _mock.go
+
+// Accessor provides methods for fetching object heads from secondary indexes.
+type Accessor interface {
+	// ForPrototype returns heads of objects activated with provided prototype in pulses from `from` to `to`
+	// inclusive. Heads are ordered by activation pulse.
+	ForPrototype(ctx context.Context, prototype insolar.Reference, from, to insolar.PulseNumber) ([]insolar.Reference, error)
+	// ForParent returns heads of children of provided parent activated in pulses from `from` to `to` inclusive.
+	// Heads are ordered by activation pulse.
+	ForParent(ctx context.Context, parent insolar.Reference, from, to insolar.PulseNumber) ([]insolar.Reference, error)
+}
+
+//go:generate minimock -i github.com/insolar/insolar/ledger/storage/secondary.Modifier -o ./ -s _mock.go
+
+// Modifier provides methods for adding objects to secondary indexes. Activation pulse of an object is the pulse of
+// its head. Adding the same head twice is not an error.
+type Modifier interface {
+	// AddToPrototype adds object head to the index of provided prototype.
+	AddToPrototype(ctx context.Context, prototype, head insolar.Reference) error
+	// AddToParent adds child head to the index of provided parent.
+	AddToParent(ctx context.Context, parent, head insolar.Reference) error
+}
+
+//go:generate minimock -i github.com/insolar/insolar/ledger/storage/secondary.Cleaner -o ./ -s _mock.go
+
+// Cleaner provides an interface for removing objects from secondary indexes.
+type Cleaner interface {
+	// Delete removes objects activated in provided pulse from indexes.
+	Delete(ctx context.Context, pulse insolar.PulseNumber)
+}
+
+// AddActivation adds object activated by provided record to the indexes of its prototype and parent. Object head is
+// the activation request.
+func AddActivation(ctx context.Context, indexes Modifier, rec *object.ActivateRecord) error {
+	// Prototypes are activated with code as image, they are not instances of any prototype.
+	if !rec.IsPrototype {
+		err := indexes.AddToPrototype(ctx, rec.Image, rec.Request)
+		if err != nil {
+			return errors.Wrap(err, "failed to add object to prototype index")
+		}
+	}
+	err := indexes.AddToParent(ctx, rec.Parent, rec.Request)
+	if err != nil {
+		return errors.Wrap(err, "failed to add object to parent index")
+	}
+	return nil
+}
+
+// IndexPulse adds objects activated by records of provided pulse to the indexes.
+func IndexPulse(
+	ctx context.Context, records object.RecordPulseIterator, indexes Modifier, pn insolar.PulseNumber,
+) error {
+	return records.IterateOnPulse(ctx, pn, func(_ insolar.ID, rec record.MaterialRecord) error {
+		activate, ok := rec.Record.(*object.ActivateRecord)
+		if !ok {
+			return nil
+		}
+		return AddActivation(ctx, indexes, activate)
+	})
+}
+
+type kind byte
+
+const (
+	kindPrototype kind = 1
+	kindParent    kind = 2
+)
+
+func sortHeads(heads []insolar.Reference) {
+	// Reference starts with record ID, which starts with pulse number.
+	sort.Slice(heads, func(i, j int) bool {
+		return bytes.Compare(heads[i][:], heads[j][:]) < 0
+	})
+}
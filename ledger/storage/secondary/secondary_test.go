@@ -0,0 +1,161 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package secondary_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/gen"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/storage/object"
+	"github.com/insolar/insolar/ledger/storage/secondary"
+)
+
+func TestSecondary(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+
+	prototype := gen.Reference()
+	parent := gen.Reference()
+	first := insolar.PulseNumber(insolar.FirstPulseNumber)
+
+	head := func(pn insolar.PulseNumber) insolar.Reference {
+		id := gen.ID()
+		copy(id[:insolar.PulseNumberSize], pn.Bytes())
+		return *insolar.NewReference(gen.ID(), id)
+	}
+	// Heads are listed in order of pulses.
+	heads := []insolar.Reference{head(first), head(first + 10), head(first + 20)}
+	child := head(first + 10)
+
+	for name, storage := range map[string]interface {
+		secondary.Accessor
+		secondary.Modifier
+	}{
+		"memory": secondary.NewStorageMemory(),
+		"db":     secondary.NewStorageDB(store.NewMemoryMockDB()),
+	} {
+		t.Run(name, func(t *testing.T) {
+			for i := len(heads) - 1; i >= 0; i-- {
+				err := secondary.AddActivation(ctx, storage, &object.ActivateRecord{
+					SideEffectRecord: object.SideEffectRecord{Request: heads[i]},
+					StateRecord:      object.StateRecord{Image: prototype},
+					Parent:           parent,
+				})
+				require.NoError(t, err)
+			}
+			// Adding twice doesn't duplicate heads.
+			err := storage.AddToPrototype(ctx, prototype, heads[0])
+			require.NoError(t, err)
+			// Prototypes are not indexed as instances of their code.
+			err = secondary.AddActivation(ctx, storage, &object.ActivateRecord{
+				SideEffectRecord: object.SideEffectRecord{Request: child},
+				StateRecord:      object.StateRecord{Image: prototype, IsPrototype: true},
+				Parent:           heads[0],
+			})
+			require.NoError(t, err)
+
+			res, err := storage.ForPrototype(ctx, prototype, 0, math.MaxUint32)
+			require.NoError(t, err)
+			assert.Equal(t, heads, res)
+
+			res, err = storage.ForPrototype(ctx, prototype, first+10, first+10)
+			require.NoError(t, err)
+			assert.Equal(t, heads[1:2], res)
+
+			res, err = storage.ForParent(ctx, parent, first+5, first+20)
+			require.NoError(t, err)
+			assert.Equal(t, heads[1:], res)
+
+			res, err = storage.ForParent(ctx, heads[0], first, first+20)
+			require.NoError(t, err)
+			assert.Equal(t, []insolar.Reference{child}, res)
+
+			res, err = storage.ForPrototype(ctx, gen.Reference(), first, first+20)
+			require.NoError(t, err)
+			assert.Empty(t, res)
+		})
+	}
+}
+
+func TestStorageMemory_Delete(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	storage := secondary.NewStorageMemory()
+
+	parent := gen.Reference()
+	first := insolar.PulseNumber(insolar.FirstPulseNumber)
+	removed := *insolar.NewReference(gen.ID(), *insolar.NewID(first, nil))
+	kept := *insolar.NewReference(gen.ID(), *insolar.NewID(first+10, nil))
+	require.NoError(t, storage.AddToParent(ctx, parent, removed))
+	require.NoError(t, storage.AddToParent(ctx, parent, kept))
+
+	storage.Delete(ctx, first)
+
+	res, err := storage.ForParent(ctx, parent, first, first+10)
+	require.NoError(t, err)
+	assert.Equal(t, []insolar.Reference{kept}, res)
+}
+
+func TestIndexPulse(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	records := object.NewRecordMemory()
+	storage := secondary.NewStorageDB(store.NewMemoryMockDB())
+
+	prototype := gen.Reference()
+	parent := gen.Reference()
+	first := insolar.PulseNumber(insolar.FirstPulseNumber)
+	head := *insolar.NewReference(gen.ID(), *insolar.NewID(first, []byte("head")))
+
+	err := records.Set(ctx, *insolar.NewID(first, []byte("activate")), record.MaterialRecord{
+		Record: &object.ActivateRecord{
+			SideEffectRecord: object.SideEffectRecord{Request: head},
+			StateRecord:      object.StateRecord{Image: prototype},
+			Parent:           parent,
+		},
+	})
+	require.NoError(t, err)
+	err = records.Set(ctx, *insolar.NewID(first, []byte("amend")), record.MaterialRecord{
+		Record: &object.AmendRecord{},
+	})
+	require.NoError(t, err)
+	// Records of other pulses are not indexed.
+	err = records.Set(ctx, *insolar.NewID(first+10, []byte("activate")), record.MaterialRecord{
+		Record: &object.ActivateRecord{
+			SideEffectRecord: object.SideEffectRecord{Request: gen.Reference()},
+			StateRecord:      object.StateRecord{Image: prototype},
+			Parent:           parent,
+		},
+	})
+	require.NoError(t, err)
+
+	err = secondary.IndexPulse(ctx, records, storage, first)
+	require.NoError(t, err)
+
+	res, err := storage.ForPrototype(ctx, prototype, 0, math.MaxUint32)
+	require.NoError(t, err)
+	assert.Equal(t, []insolar.Reference{head}, res)
+
+	res, err = storage.ForParent(ctx, parent, 0, math.MaxUint32)
+	require.NoError(t, err)
+	assert.Equal(t, []insolar.Reference{head}, res)
+}
@@ -0,0 +1,59 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package storage
+
+import (
+	"context"
+
+	"github.com/dgraph-io/badger"
+
+	"github.com/insolar/insolar/insolar"
+)
+
+// IterateIndexKVs calls handler for key/value pair of every index of objects created not later than provided pulse.
+// Pairs are read in a single transaction, so they represent a consistent state of indexes.
+func IterateIndexKVs(
+	ctx context.Context, db DBContext, pn insolar.PulseNumber, handler func(kv insolar.KV) error,
+) error {
+	prefix := []byte{scopeIDLifeline}
+
+	return db.GetBadgerDB().View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			// Key consists of scope, jet prefix and object id.
+			key := it.Item().KeyCopy(nil)
+			if len(key) < len(prefix)+insolar.RecordIDSize {
+				continue
+			}
+			if pulseNumFromKey(len(key)-insolar.RecordIDSize, key) > pn {
+				continue
+			}
+
+			value, err := it.Item().ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+			err = handler(insolar.KV{K: key, V: value})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,22 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package verifier checks integrity of heavy material node storage.
+//
+// Verifier walks storage pulse by pulse and recomputes drop hashes, checks that lifeline indexes point at existing
+// records and that blobs referenced by records exist. Inconsistent drops, records and indexes can be quarantined:
+// they are moved from storage to the quarantine scope of the DB, so they can be inspected and repaired manually.
+package verifier
@@ -0,0 +1,75 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package verifier
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/component"
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/retention"
+	"github.com/insolar/insolar/ledger/storage"
+	"github.com/insolar/insolar/ledger/storage/blob"
+	"github.com/insolar/insolar/ledger/storage/drop"
+	"github.com/insolar/insolar/ledger/storage/object"
+	"github.com/insolar/insolar/ledger/storage/pulse"
+	"github.com/insolar/insolar/platformpolicy"
+)
+
+// Verify checks storage described by provided configuration. If quarantine is true, found inconsistencies are moved
+// to quarantine. Node using the storage should be stopped.
+func Verify(ctx context.Context, conf configuration.Ledger, quarantine bool) (*Report, error) {
+	legacyDB, err := storage.NewDB(conf, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Verify ] failed to open DB")
+	}
+	defer legacyDB.Close()
+	db, err := store.NewBackend(conf.Storage)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Verify ] failed to open DB")
+	}
+	defer db.Stop(ctx)
+
+	verifier := NewVerifier()
+	cm := component.Manager{}
+	cm.Inject(
+		platformpolicy.NewPlatformCryptographyScheme(),
+		legacyDB,
+		db,
+		pulse.NewStorageDB(db),
+		drop.NewStorageDB(db),
+		blob.NewStorageDB(db),
+		object.NewRecordDB(db),
+		retention.NewTombstoneDB(db),
+		verifier,
+	)
+
+	report, err := verifier.Verify(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if quarantine {
+		err = verifier.Quarantine(ctx, report.Problems)
+		if err != nil {
+			return report, err
+		}
+	}
+	return report, nil
+}
@@ -0,0 +1,371 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package verifier
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/dgraph-io/badger"
+	"github.com/pkg/errors"
+	"github.com/ugorji/go/codec"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/retention"
+	"github.com/insolar/insolar/ledger/storage"
+	"github.com/insolar/insolar/ledger/storage/blob"
+	"github.com/insolar/insolar/ledger/storage/drop"
+	"github.com/insolar/insolar/ledger/storage/object"
+	"github.com/insolar/insolar/ledger/storage/pulse"
+)
+
+// ProblemKind is a kind of storage inconsistency.
+type ProblemKind byte
+
+const (
+	// ProblemDropHash means that drop hash doesn't match records of the drop.
+	ProblemDropHash ProblemKind = iota + 1
+	// ProblemDropChain means that drop previous hash doesn't match hash of the previous drop.
+	ProblemDropChain
+	// ProblemMissingRecord means that lifeline index points at a missing record.
+	ProblemMissingRecord
+	// ProblemMissingBlob means that record references a missing blob.
+	ProblemMissingBlob
+)
+
+func (k ProblemKind) String() string {
+	switch k {
+	case ProblemDropHash:
+		return "DropHash"
+	case ProblemDropChain:
+		return "DropChain"
+	case ProblemMissingRecord:
+		return "MissingRecord"
+	case ProblemMissingBlob:
+		return "MissingBlob"
+	}
+	return fmt.Sprintf("ProblemKind(%d)", k)
+}
+
+// Problem describes a single storage inconsistency.
+type Problem struct {
+	Kind  ProblemKind
+	Pulse insolar.PulseNumber
+	JetID insolar.JetID
+	// ID is an id of inconsistent record or object.
+	ID insolar.ID
+	// Missing is an id of missing record or blob.
+	Missing insolar.ID
+
+	// Quarantined is true if inconsistent data was moved to quarantine.
+	Quarantined bool
+
+	key []byte
+}
+
+func (p Problem) String() string {
+	switch p.Kind {
+	case ProblemDropHash, ProblemDropChain:
+		return fmt.Sprintf("%v: drop %v of pulse %v", p.Kind, p.JetID.DebugString(), p.Pulse)
+	case ProblemMissingRecord:
+		return fmt.Sprintf("%v: index of object %v points at record %v", p.Kind, p.ID.String(), p.Missing.String())
+	case ProblemMissingBlob:
+		return fmt.Sprintf("%v: record %v references blob %v", p.Kind, p.ID.String(), p.Missing.String())
+	}
+	return p.Kind.String()
+}
+
+// Report contains results of storage verification.
+type Report struct {
+	Pulses  int
+	Drops   int
+	Records int
+	Indexes int
+
+	Problems []Problem
+}
+
+// Verifier checks integrity of heavy material node storage.
+type Verifier struct {
+	PCS             insolar.PlatformCryptographyScheme `inject:""`
+	PulseAccessor   pulse.Accessor                     `inject:""`
+	PulseCalculator pulse.Calculator                   `inject:""`
+	RecordAccessor  object.RecordAccessor              `inject:""`
+	RecordIterator  object.RecordPulseIterator         `inject:""`
+	BlobAccessor    blob.Accessor                      `inject:""`
+	DropAccessor    drop.Accessor                      `inject:""`
+	DB              store.DB                           `inject:""`
+	DBContext       storage.DBContext                  `inject:""`
+	Tombstones      retention.TombstoneAccessor        `inject:""`
+}
+
+// NewVerifier creates new Verifier instance.
+func NewVerifier() *Verifier {
+	return &Verifier{}
+}
+
+// Verify walks storage from the first pulse to the latest one and returns found inconsistencies. Drops created before
+// hashing was introduced are not verified.
+func (v *Verifier) Verify(ctx context.Context) (*Report, error) {
+	latest, err := v.PulseAccessor.Latest(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Verify ] failed to fetch latest pulse")
+	}
+	drops, err := v.drops()
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Verify ]")
+	}
+
+	report := &Report{}
+	current, err := v.PulseAccessor.ForPulseNumber(ctx, insolar.FirstPulseNumber)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Verify ] failed to fetch first pulse")
+	}
+	var prevPulse insolar.PulseNumber
+	for {
+		err = v.verifyPulse(ctx, report, current.PulseNumber, prevPulse, drops[current.PulseNumber])
+		if err != nil {
+			return nil, errors.Wrapf(err, "[ Verify ] failed to verify pulse %v", current.PulseNumber)
+		}
+		report.Pulses++
+
+		if current.PulseNumber >= latest.PulseNumber {
+			break
+		}
+		prevPulse = current.PulseNumber
+		current, err = v.PulseCalculator.Forwards(ctx, current.PulseNumber, 1)
+		if err == pulse.ErrNotFound {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "[ Verify ] failed to fetch next pulse")
+		}
+	}
+
+	err = v.verifyIndexes(ctx, report, latest.PulseNumber)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Verify ]")
+	}
+	return report, nil
+}
+
+// Quarantine moves inconsistent data of provided problems to the quarantine scope of the DB. Quarantined data is
+// saved under a key consisting of problem kind and original key.
+func (v *Verifier) Quarantine(ctx context.Context, problems []Problem) error {
+	for i := range problems {
+		p := &problems[i]
+		if p.Quarantined {
+			continue
+		}
+
+		var err error
+		switch p.Kind {
+		case ProblemDropHash, ProblemDropChain:
+			err = v.quarantineKey(p, store.ScopeJetDrop)
+		case ProblemMissingBlob:
+			err = v.quarantineKey(p, store.ScopeRecord)
+		case ProblemMissingRecord:
+			err = v.quarantineIndex(ctx, p)
+		}
+		if err != nil {
+			return errors.Wrapf(err, "[ Quarantine ] failed to quarantine %v", p)
+		}
+		p.Quarantined = true
+	}
+	return nil
+}
+
+func (v *Verifier) verifyPulse(
+	ctx context.Context, report *Report, pn, prevPulse insolar.PulseNumber, drops []drop.Drop,
+) error {
+	for _, d := range drops {
+		report.Drops++
+		if len(d.Hash) == 0 {
+			continue
+		}
+		// Drops are stored under jet prefix followed by pulse number.
+		key := append(d.JetID.Prefix(), d.Pulse.Bytes()...)
+
+		if prevPulse != 0 {
+			prevHash, err := drop.PrevHash(ctx, v.DropAccessor, d.JetID, prevPulse)
+			if err != nil {
+				return errors.Wrap(err, "failed to fetch previous drop")
+			}
+			if !bytes.Equal(prevHash, d.PrevHash) {
+				report.Problems = append(report.Problems, Problem{
+					Kind: ProblemDropChain, Pulse: pn, JetID: d.JetID, key: key,
+				})
+			}
+		}
+
+		// Records removed by compaction are proved by their tombstones.
+		ids, err := retention.DropRecordIDs(ctx, v.RecordIterator, v.Tombstones, d.JetID, pn)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(drop.CalculateHash(v.PCS.IntegrityHasher(), d.PrevHash, ids), d.Hash) {
+			report.Problems = append(report.Problems, Problem{
+				Kind: ProblemDropHash, Pulse: pn, JetID: d.JetID, key: key,
+			})
+		}
+	}
+
+	return v.RecordIterator.IterateOnPulse(ctx, pn, func(id insolar.ID, rec record.MaterialRecord) error {
+		report.Records++
+
+		blobID := referencedBlob(rec)
+		if blobID == nil {
+			return nil
+		}
+		_, err := v.BlobAccessor.ForID(ctx, *blobID)
+		if err == blob.ErrNotFound {
+			report.Problems = append(report.Problems, Problem{
+				Kind: ProblemMissingBlob, Pulse: pn, JetID: rec.JetID, ID: id, Missing: *blobID, key: id.Bytes(),
+			})
+			return nil
+		}
+		if err != nil {
+			return errors.Wrapf(err, "failed to fetch blob of record %v", id.String())
+		}
+		return nil
+	})
+}
+
+func (v *Verifier) verifyIndexes(ctx context.Context, report *Report, pn insolar.PulseNumber) error {
+	return storage.IterateIndexKVs(ctx, v.DBContext, pn, func(kv insolar.KV) error {
+		report.Indexes++
+
+		var objID insolar.ID
+		copy(objID[:], kv.K[len(kv.K)-insolar.RecordIDSize:])
+		var idx object.Lifeline
+		err := codec.NewDecoderBytes(kv.V, &codec.CborHandle{}).Decode(&idx)
+		if err != nil {
+			return errors.Wrapf(err, "failed to decode index of object %v", objID.String())
+		}
+
+		for _, id := range []*insolar.ID{idx.LatestState, idx.LatestStateApproved, idx.ChildPointer} {
+			if id == nil {
+				continue
+			}
+			_, err := v.RecordAccessor.ForID(ctx, *id)
+			if err == object.ErrNotFound {
+				report.Problems = append(report.Problems, Problem{
+					Kind:    ProblemMissingRecord,
+					Pulse:   objID.Pulse(),
+					JetID:   idx.JetID,
+					ID:      objID,
+					Missing: *id,
+					key:     kv.K,
+				})
+				return nil
+			}
+			if err != nil {
+				return errors.Wrapf(err, "failed to fetch record %v", id.String())
+			}
+		}
+		return nil
+	})
+}
+
+// drops returns drops of all jets grouped by pulse.
+func (v *Verifier) drops() (map[insolar.PulseNumber][]drop.Drop, error) {
+	drops := map[insolar.PulseNumber][]drop.Drop{}
+	err := v.DB.Iterate(store.ScopeJetDrop, nil, func(_, value []byte) error {
+		d, err := drop.Decode(value)
+		if err != nil {
+			return err
+		}
+		drops[d.Pulse] = append(drops[d.Pulse], *d)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch drops")
+	}
+
+	for _, list := range drops {
+		sort.Slice(list, func(i, j int) bool {
+			return string(list[i].JetID[:]) < string(list[j].JetID[:])
+		})
+	}
+	return drops, nil
+}
+
+func (v *Verifier) quarantineKey(p *Problem, scope store.Scope) error {
+	key := rawKey{scope: scope, id: p.key}
+	value, err := v.DB.Get(key)
+	if err == store.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	batch := &store.Batch{}
+	batch.Set(newQuarantineKey(p), value)
+	batch.Delete(key)
+	return v.DB.Write(batch)
+}
+
+func (v *Verifier) quarantineIndex(ctx context.Context, p *Problem) error {
+	value, err := v.DBContext.Get(ctx, p.key)
+	if err == insolar.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	err = v.DB.Set(newQuarantineKey(p), value)
+	if err != nil {
+		return err
+	}
+	return v.DBContext.GetBadgerDB().Update(func(txn *badger.Txn) error {
+		return txn.Delete(p.key)
+	})
+}
+
+// referencedBlob returns id of blob referenced by record or nil.
+func referencedBlob(rec record.MaterialRecord) *insolar.ID {
+	switch r := rec.Record.(type) {
+	case *object.CodeRecord:
+		return r.Code
+	case object.State:
+		return r.GetMemory()
+	}
+	return nil
+}
+
+type rawKey struct {
+	scope store.Scope
+	id    []byte
+}
+
+func (k rawKey) Scope() store.Scope {
+	return k.scope
+}
+
+func (k rawKey) ID() []byte {
+	return k.id
+}
+
+func newQuarantineKey(p *Problem) rawKey {
+	return rawKey{scope: store.ScopeQuarantine, id: append([]byte{byte(p.Kind)}, p.key...)}
+}
@@ -0,0 +1,201 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package verifier
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/component"
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/record"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/internal/ledger/store"
+	"github.com/insolar/insolar/ledger/storage"
+	"github.com/insolar/insolar/ledger/storage/blob"
+	"github.com/insolar/insolar/ledger/storage/drop"
+	"github.com/insolar/insolar/ledger/storage/object"
+	"github.com/insolar/insolar/ledger/storage/pulse"
+	"github.com/insolar/insolar/platformpolicy"
+)
+
+func testConf(t *testing.T) (configuration.Ledger, func()) {
+	tmpdir, err := ioutil.TempDir("", "verifier-test-")
+	require.NoError(t, err)
+
+	conf := configuration.NewLedger()
+	conf.Storage.DataDirectory = filepath.Join(tmpdir, "data")
+	conf.Storage.DataDirectoryNewDB = filepath.Join(tmpdir, "new-data")
+	return conf, func() { _ = os.RemoveAll(tmpdir) }
+}
+
+type corruption struct {
+	badHash     bool
+	missingBlob bool
+	badIndex    bool
+}
+
+type testData struct {
+	jetID    insolar.JetID
+	pulses   []insolar.PulseNumber
+	recordID insolar.ID
+	objectID insolar.ID
+}
+
+// fillStorage saves three pulses with a record and a hashed drop in each of them and an index. Provided corruption is
+// applied to the second pulse.
+func fillStorage(ctx context.Context, t *testing.T, conf configuration.Ledger, c corruption) testData {
+	legacyDB, err := storage.NewDB(conf, nil)
+	require.NoError(t, err)
+	defer legacyDB.Close()
+	db, err := store.NewBadgerDB(conf.Storage.DataDirectoryNewDB)
+	require.NoError(t, err)
+	defer db.Stop(ctx)
+
+	pcs := platformpolicy.NewPlatformCryptographyScheme()
+	objects := storage.NewObjectStorage()
+	cm := component.Manager{}
+	cm.Inject(pcs, legacyDB, objects)
+	pulses := pulse.NewStorageDB(db)
+	records := object.NewRecordDB(db)
+	blobs := blob.NewStorageDB(db)
+	drops := drop.NewStorageDB(db)
+
+	first := insolar.PulseNumber(insolar.FirstPulseNumber)
+	data := testData{
+		jetID:  *insolar.NewJetID(0, nil),
+		pulses: []insolar.PulseNumber{first, first + 10, first + 20},
+	}
+
+	var prevHash []byte
+	for i, pn := range data.pulses {
+		require.NoError(t, pulses.Append(ctx, insolar.Pulse{PulseNumber: pn}))
+
+		blobID := *insolar.NewID(pn, []byte{1})
+		if i != 1 || !c.missingBlob {
+			require.NoError(t, blobs.Set(ctx, blobID, blob.Blob{Value: []byte{42}, JetID: data.jetID}))
+		}
+		id := *insolar.NewID(pn, []byte{2})
+		err := records.Set(ctx, id, record.MaterialRecord{
+			Record: &object.ActivateRecord{StateRecord: object.StateRecord{Memory: &blobID}},
+			JetID:  data.jetID,
+		})
+		require.NoError(t, err)
+
+		d := drop.Drop{Pulse: pn, JetID: data.jetID, PrevHash: prevHash}
+		d.Hash = drop.CalculateHash(pcs.IntegrityHasher(), prevHash, []insolar.ID{id})
+		prevHash = d.Hash
+		if i == 1 && c.badHash {
+			d.Hash = []byte{1, 2, 3}
+		}
+		require.NoError(t, drops.Set(ctx, d))
+
+		if i == 1 {
+			data.recordID = id
+		}
+	}
+
+	data.objectID = *insolar.NewID(first, []byte{2})
+	latestState := data.objectID
+	if c.badIndex {
+		latestState = *insolar.NewID(first, []byte{3})
+	}
+	err = objects.SetObjectIndex(ctx, insolar.ID(data.jetID), &data.objectID, &object.Lifeline{
+		LatestState: &latestState,
+		State:       object.StateActivation,
+		JetID:       data.jetID,
+	})
+	require.NoError(t, err)
+
+	return data
+}
+
+func TestVerify(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+
+	t.Run("consistent storage", func(t *testing.T) {
+		conf, clean := testConf(t)
+		defer clean()
+		fillStorage(ctx, t, conf, corruption{})
+
+		report, err := Verify(ctx, conf, false)
+		require.NoError(t, err)
+		assert.Equal(t, &Report{Pulses: 3, Drops: 3, Records: 3, Indexes: 1}, report)
+	})
+
+	t.Run("finds problems", func(t *testing.T) {
+		conf, clean := testConf(t)
+		defer clean()
+		data := fillStorage(ctx, t, conf, corruption{badHash: true, missingBlob: true, badIndex: true})
+
+		report, err := Verify(ctx, conf, false)
+		require.NoError(t, err)
+
+		var kinds []ProblemKind
+		for _, p := range report.Problems {
+			kinds = append(kinds, p.Kind)
+			assert.False(t, p.Quarantined)
+		}
+		// Stored hash of the second drop is broken, so the third drop doesn't match it as well.
+		assert.Equal(t, []ProblemKind{
+			ProblemDropHash, ProblemMissingBlob, ProblemDropChain, ProblemMissingRecord,
+		}, kinds)
+		assert.Equal(t, data.pulses[1], report.Problems[0].Pulse)
+		assert.Equal(t, data.recordID, report.Problems[1].ID)
+		assert.Equal(t, data.pulses[2], report.Problems[2].Pulse)
+		assert.Equal(t, data.objectID, report.Problems[3].ID)
+	})
+
+	t.Run("quarantines problems", func(t *testing.T) {
+		conf, clean := testConf(t)
+		defer clean()
+		fillStorage(ctx, t, conf, corruption{missingBlob: true, badIndex: true})
+
+		report, err := Verify(ctx, conf, true)
+		require.NoError(t, err)
+		require.Len(t, report.Problems, 2)
+		for _, p := range report.Problems {
+			assert.True(t, p.Quarantined)
+		}
+
+		// Quarantined record is missing, so the drop hash doesn't match anymore.
+		report, err = Verify(ctx, conf, false)
+		require.NoError(t, err)
+		require.Len(t, report.Problems, 1)
+		assert.Equal(t, ProblemDropHash, report.Problems[0].Kind)
+		assert.Equal(t, 2, report.Records)
+		assert.Equal(t, 0, report.Indexes)
+
+		db, err := store.NewBadgerDB(conf.Storage.DataDirectoryNewDB)
+		require.NoError(t, err)
+		defer db.Stop(ctx)
+		var quarantined []ProblemKind
+		err = db.Iterate(store.ScopeQuarantine, nil, func(id, _ []byte) error {
+			quarantined = append(quarantined, ProblemKind(id[0]))
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []ProblemKind{ProblemMissingRecord, ProblemMissingBlob}, quarantined)
+	})
+}
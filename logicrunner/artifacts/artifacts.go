@@ -70,6 +70,20 @@ type Client interface {
 	// During iteration children refs will be fetched from remote source (parent object).
 	GetChildren(ctx context.Context, parent insolar.Reference, pulse *insolar.PulseNumber) (RefIterator, error)
 
+	// GetObjectsByPrototype returns heads of objects activated with provided prototype in pulses from `from` to `to`
+	// inclusive.
+	//
+	// Unlike walking children of domains, this uses secondary indexes of material nodes: heavy for synced pulses and
+	// light for pulses within light chain limit.
+	GetObjectsByPrototype(ctx context.Context, prototype insolar.Reference, from, to insolar.PulseNumber) ([]insolar.Reference, error)
+
+	// GetObjectsByParent returns heads of children of provided parent activated in pulses from `from` to `to`
+	// inclusive.
+	//
+	// Heads are fetched from secondary indexes of heavy material node merged with indexes of light material nodes
+	// for pulses within light chain limit.
+	GetObjectsByParent(ctx context.Context, parent insolar.Reference, from, to insolar.PulseNumber) ([]insolar.Reference, error)
+
 	// DeclareType creates new type record in storage.
 	//
 	// Type is a contract interface. It contains one method signature.
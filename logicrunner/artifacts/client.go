@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/insolar/insolar/insolar/record"
 	"github.com/insolar/insolar/ledger/storage/pulse"
@@ -47,6 +48,7 @@ type client struct {
 	PlatformCryptographyScheme insolar.PlatformCryptographyScheme `inject:""`
 	PulseAccessor              pulse.Accessor                     `inject:""`
 	JetCoordinator             insolar.JetCoordinator             `inject:""`
+	NodeNetwork                insolar.NodeNetwork                `inject:""`
 
 	getChildrenChunkSize int
 	senders              *ledgerArtifactSenders
@@ -141,7 +143,7 @@ func (m *client) GetCode(
 	sender := BuildSender(
 		bus.Send,
 		m.senders.cachedSender(m.PlatformCryptographyScheme),
-		followRedirectSender(bus),
+		countRedirectsSender(),
 		retryJetSender(currentPN, m.JetStorage),
 	)
 
@@ -208,7 +210,7 @@ func (m *client) GetObject(
 	bus := insolar.MessageBusFromContext(ctx, m.DefaultBus)
 	sender := BuildSender(
 		bus.Send,
-		followRedirectSender(bus),
+		countRedirectsSender(),
 		retryJetSender(currentPN, m.JetStorage),
 	)
 
@@ -376,7 +378,7 @@ func (m *client) GetDelegate(
 	}
 
 	bus := insolar.MessageBusFromContext(ctx, m.DefaultBus)
-	sender := BuildSender(bus.Send, followRedirectSender(bus), retryJetSender(currentPN, m.JetStorage))
+	sender := BuildSender(bus.Send, countRedirectsSender(), retryJetSender(currentPN, m.JetStorage))
 	genericReact, err := sender(ctx, &message.GetDelegate{
 		Head:   head,
 		AsType: asType,
@@ -419,11 +421,138 @@ func (m *client) GetChildren(
 	}
 
 	bus := insolar.MessageBusFromContext(ctx, m.DefaultBus)
-	sender := BuildSender(bus.Send, followRedirectSender(bus), retryJetSender(currentPN, m.JetStorage))
+	sender := BuildSender(bus.Send, countRedirectsSender(), retryJetSender(currentPN, m.JetStorage))
 	iter, err := NewChildIterator(ctx, sender, parent, pulse, m.getChildrenChunkSize)
 	return iter, err
 }
 
+// GetObjectsByPrototype returns heads of objects activated with provided prototype in pulses from `from` to `to`
+// inclusive.
+//
+// Heads are fetched from secondary indexes of heavy material node. Objects activated in pulses within light chain
+// limit may not be synced to heavy yet, so indexes of light material nodes are merged in for such pulses.
+func (m *client) GetObjectsByPrototype(
+	ctx context.Context, prototype insolar.Reference, from, to insolar.PulseNumber,
+) ([]insolar.Reference, error) {
+	var err error
+
+	ctx, span := instracer.StartSpan(ctx, "artifactmanager.GetObjectsByPrototype")
+	instrumenter := instrument(ctx, "GetObjectsByPrototype").err(&err)
+	defer func() {
+		if err != nil {
+			span.AddAttributes(trace.StringAttribute("error", err.Error()))
+		}
+		span.End()
+		instrumenter.end()
+	}()
+
+	refs, err := m.getObjectHeads(ctx, to, &message.GetObjectsByPrototype{
+		Prototype: prototype,
+		FromPulse: from,
+		ToPulse:   to,
+	})
+	return refs, err
+}
+
+// GetObjectsByParent returns heads of children of provided parent activated in pulses from `from` to `to` inclusive.
+//
+// Heads are fetched from secondary indexes of heavy material node. Objects activated in pulses within light chain
+// limit may not be synced to heavy yet, so indexes of light material nodes are merged in for such pulses.
+func (m *client) GetObjectsByParent(
+	ctx context.Context, parent insolar.Reference, from, to insolar.PulseNumber,
+) ([]insolar.Reference, error) {
+	var err error
+
+	ctx, span := instracer.StartSpan(ctx, "artifactmanager.GetObjectsByParent")
+	instrumenter := instrument(ctx, "GetObjectsByParent").err(&err)
+	defer func() {
+		if err != nil {
+			span.AddAttributes(trace.StringAttribute("error", err.Error()))
+		}
+		span.End()
+		instrumenter.end()
+	}()
+
+	refs, err := m.getObjectHeads(ctx, to, &message.GetObjectsByParent{
+		Parent:    parent,
+		FromPulse: from,
+		ToPulse:   to,
+	})
+	return refs, err
+}
+
+func (m *client) getObjectHeads(
+	ctx context.Context, to insolar.PulseNumber, msg insolar.Message,
+) ([]insolar.Reference, error) {
+	currentPN, err := m.pulse(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bus := insolar.MessageBusFromContext(ctx, m.DefaultBus)
+	heads, err := sendForObjectHeads(ctx, bus, msg, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Light material nodes drop their data beyond the limit, so they have nothing for the range.
+	onHeavy, err := m.JetCoordinator.IsBeyondLimit(ctx, currentPN, to)
+	if err != nil {
+		return nil, err
+	}
+	if onHeavy {
+		return heads, nil
+	}
+
+	// Objects are indexed by light material nodes executing their jets, so every light node has to be asked.
+	for _, node := range m.NodeNetwork.GetWorkingNodesByRole(insolar.DynamicRoleLightExecutor) {
+		receiver := node
+		lightHeads, err := sendForObjectHeads(ctx, bus, msg, &insolar.MessageSendOptions{Receiver: &receiver})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to fetch object heads from light node %s", node)
+		}
+		heads = append(heads, lightHeads...)
+	}
+
+	return mergeObjectHeads(heads), nil
+}
+
+func sendForObjectHeads(
+	ctx context.Context, bus insolar.MessageBus, msg insolar.Message, options *insolar.MessageSendOptions,
+) ([]insolar.Reference, error) {
+	genericReply, err := bus.Send(ctx, msg, options)
+	if err != nil {
+		return nil, err
+	}
+
+	switch rep := genericReply.(type) {
+	case *reply.ObjectHeads:
+		return rep.Refs, nil
+	case *reply.Error:
+		return nil, rep.Error()
+	default:
+		return nil, fmt.Errorf("%s: unexpected reply: %#v", msg.Type(), rep)
+	}
+}
+
+// mergeObjectHeads removes heads found by both light and heavy material nodes and orders heads by activation pulse.
+func mergeObjectHeads(heads []insolar.Reference) []insolar.Reference {
+	seen := make(map[insolar.Reference]struct{}, len(heads))
+	res := heads[:0]
+	for _, head := range heads {
+		if _, ok := seen[head]; ok {
+			continue
+		}
+		seen[head] = struct{}{}
+		res = append(res, head)
+	}
+	// Reference starts with record ID, which starts with pulse number.
+	sort.Slice(res, func(i, j int) bool {
+		return bytes.Compare(res[i][:], res[j][:]) < 0
+	})
+	return res
+}
+
 // DeclareType creates new type record in storage.
 //
 // Type is a contract interface. It contains one method signature.
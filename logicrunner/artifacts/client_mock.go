@@ -70,6 +70,16 @@ type ClientMock struct {
 	GetObjectPreCounter uint64
 	GetObjectMock       mClientMockGetObject
 
+	GetObjectsByParentFunc       func(p context.Context, p1 insolar.Reference, p2 insolar.PulseNumber, p3 insolar.PulseNumber) (r []insolar.Reference, r1 error)
+	GetObjectsByParentCounter    uint64
+	GetObjectsByParentPreCounter uint64
+	GetObjectsByParentMock       mClientMockGetObjectsByParent
+
+	GetObjectsByPrototypeFunc       func(p context.Context, p1 insolar.Reference, p2 insolar.PulseNumber, p3 insolar.PulseNumber) (r []insolar.Reference, r1 error)
+	GetObjectsByPrototypeCounter    uint64
+	GetObjectsByPrototypePreCounter uint64
+	GetObjectsByPrototypeMock       mClientMockGetObjectsByPrototype
+
 	GetPendingRequestFunc       func(p context.Context, p1 insolar.ID) (r insolar.Parcel, r1 error)
 	GetPendingRequestCounter    uint64
 	GetPendingRequestPreCounter uint64
@@ -129,6 +139,8 @@ func NewClientMock(t minimock.Tester) *ClientMock {
 	m.GetCodeMock = mClientMockGetCode{mock: m}
 	m.GetDelegateMock = mClientMockGetDelegate{mock: m}
 	m.GetObjectMock = mClientMockGetObject{mock: m}
+	m.GetObjectsByParentMock = mClientMockGetObjectsByParent{mock: m}
+	m.GetObjectsByPrototypeMock = mClientMockGetObjectsByPrototype{mock: m}
 	m.GetPendingRequestMock = mClientMockGetPendingRequest{mock: m}
 	m.HasPendingRequestsMock = mClientMockHasPendingRequests{mock: m}
 	m.RegisterRequestMock = mClientMockRegisterRequest{mock: m}
@@ -1654,6 +1666,312 @@ func (m *ClientMock) GetObjectFinished() bool {
 	return true
 }
 
+type mClientMockGetObjectsByParent struct {
+	mock              *ClientMock
+	mainExpectation   *ClientMockGetObjectsByParentExpectation
+	expectationSeries []*ClientMockGetObjectsByParentExpectation
+}
+
+type ClientMockGetObjectsByParentExpectation struct {
+	input  *ClientMockGetObjectsByParentInput
+	result *ClientMockGetObjectsByParentResult
+}
+
+type ClientMockGetObjectsByParentInput struct {
+	p  context.Context
+	p1 insolar.Reference
+	p2 insolar.PulseNumber
+	p3 insolar.PulseNumber
+}
+
+type ClientMockGetObjectsByParentResult struct {
+	r  []insolar.Reference
+	r1 error
+}
+
+//Expect specifies that invocation of Client.GetObjectsByParent is expected from 1 to Infinity times
+func (m *mClientMockGetObjectsByParent) Expect(p context.Context, p1 insolar.Reference, p2 insolar.PulseNumber, p3 insolar.PulseNumber) *mClientMockGetObjectsByParent {
+	m.mock.GetObjectsByParentFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ClientMockGetObjectsByParentExpectation{}
+	}
+	m.mainExpectation.input = &ClientMockGetObjectsByParentInput{p, p1, p2, p3}
+	return m
+}
+
+//Return specifies results of invocation of Client.GetObjectsByParent
+func (m *mClientMockGetObjectsByParent) Return(r []insolar.Reference, r1 error) *ClientMock {
+	m.mock.GetObjectsByParentFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ClientMockGetObjectsByParentExpectation{}
+	}
+	m.mainExpectation.result = &ClientMockGetObjectsByParentResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Client.GetObjectsByParent is expected once
+func (m *mClientMockGetObjectsByParent) ExpectOnce(p context.Context, p1 insolar.Reference, p2 insolar.PulseNumber, p3 insolar.PulseNumber) *ClientMockGetObjectsByParentExpectation {
+	m.mock.GetObjectsByParentFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ClientMockGetObjectsByParentExpectation{}
+	expectation.input = &ClientMockGetObjectsByParentInput{p, p1, p2, p3}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ClientMockGetObjectsByParentExpectation) Return(r []insolar.Reference, r1 error) {
+	e.result = &ClientMockGetObjectsByParentResult{r, r1}
+}
+
+//Set uses given function f as a mock of Client.GetObjectsByParent method
+func (m *mClientMockGetObjectsByParent) Set(f func(p context.Context, p1 insolar.Reference, p2 insolar.PulseNumber, p3 insolar.PulseNumber) (r []insolar.Reference, r1 error)) *ClientMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.GetObjectsByParentFunc = f
+	return m.mock
+}
+
+//GetObjectsByParent implements github.com/insolar/insolar/logicrunner/artifacts.Client interface
+func (m *ClientMock) GetObjectsByParent(p context.Context, p1 insolar.Reference, p2 insolar.PulseNumber, p3 insolar.PulseNumber) (r []insolar.Reference, r1 error) {
+	counter := atomic.AddUint64(&m.GetObjectsByParentPreCounter, 1)
+	defer atomic.AddUint64(&m.GetObjectsByParentCounter, 1)
+
+	if len(m.GetObjectsByParentMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.GetObjectsByParentMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ClientMock.GetObjectsByParent. %v %v %v %v", p, p1, p2, p3)
+			return
+		}
+
+		input := m.GetObjectsByParentMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ClientMockGetObjectsByParentInput{p, p1, p2, p3}, "Client.GetObjectsByParent got unexpected parameters")
+
+		result := m.GetObjectsByParentMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ClientMock.GetObjectsByParent")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.GetObjectsByParentMock.mainExpectation != nil {
+
+		input := m.GetObjectsByParentMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ClientMockGetObjectsByParentInput{p, p1, p2, p3}, "Client.GetObjectsByParent got unexpected parameters")
+		}
+
+		result := m.GetObjectsByParentMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ClientMock.GetObjectsByParent")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.GetObjectsByParentFunc == nil {
+		m.t.Fatalf("Unexpected call to ClientMock.GetObjectsByParent. %v %v %v %v", p, p1, p2, p3)
+		return
+	}
+
+	return m.GetObjectsByParentFunc(p, p1, p2, p3)
+}
+
+//GetObjectsByParentMinimockCounter returns a count of ClientMock.GetObjectsByParentFunc invocations
+func (m *ClientMock) GetObjectsByParentMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.GetObjectsByParentCounter)
+}
+
+//GetObjectsByParentMinimockPreCounter returns the value of ClientMock.GetObjectsByParent invocations
+func (m *ClientMock) GetObjectsByParentMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.GetObjectsByParentPreCounter)
+}
+
+//GetObjectsByParentFinished returns true if mock invocations count is ok
+func (m *ClientMock) GetObjectsByParentFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.GetObjectsByParentMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.GetObjectsByParentCounter) == uint64(len(m.GetObjectsByParentMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.GetObjectsByParentMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.GetObjectsByParentCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.GetObjectsByParentFunc != nil {
+		return atomic.LoadUint64(&m.GetObjectsByParentCounter) > 0
+	}
+
+	return true
+}
+
+type mClientMockGetObjectsByPrototype struct {
+	mock              *ClientMock
+	mainExpectation   *ClientMockGetObjectsByPrototypeExpectation
+	expectationSeries []*ClientMockGetObjectsByPrototypeExpectation
+}
+
+type ClientMockGetObjectsByPrototypeExpectation struct {
+	input  *ClientMockGetObjectsByPrototypeInput
+	result *ClientMockGetObjectsByPrototypeResult
+}
+
+type ClientMockGetObjectsByPrototypeInput struct {
+	p  context.Context
+	p1 insolar.Reference
+	p2 insolar.PulseNumber
+	p3 insolar.PulseNumber
+}
+
+type ClientMockGetObjectsByPrototypeResult struct {
+	r  []insolar.Reference
+	r1 error
+}
+
+//Expect specifies that invocation of Client.GetObjectsByPrototype is expected from 1 to Infinity times
+func (m *mClientMockGetObjectsByPrototype) Expect(p context.Context, p1 insolar.Reference, p2 insolar.PulseNumber, p3 insolar.PulseNumber) *mClientMockGetObjectsByPrototype {
+	m.mock.GetObjectsByPrototypeFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ClientMockGetObjectsByPrototypeExpectation{}
+	}
+	m.mainExpectation.input = &ClientMockGetObjectsByPrototypeInput{p, p1, p2, p3}
+	return m
+}
+
+//Return specifies results of invocation of Client.GetObjectsByPrototype
+func (m *mClientMockGetObjectsByPrototype) Return(r []insolar.Reference, r1 error) *ClientMock {
+	m.mock.GetObjectsByPrototypeFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &ClientMockGetObjectsByPrototypeExpectation{}
+	}
+	m.mainExpectation.result = &ClientMockGetObjectsByPrototypeResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Client.GetObjectsByPrototype is expected once
+func (m *mClientMockGetObjectsByPrototype) ExpectOnce(p context.Context, p1 insolar.Reference, p2 insolar.PulseNumber, p3 insolar.PulseNumber) *ClientMockGetObjectsByPrototypeExpectation {
+	m.mock.GetObjectsByPrototypeFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &ClientMockGetObjectsByPrototypeExpectation{}
+	expectation.input = &ClientMockGetObjectsByPrototypeInput{p, p1, p2, p3}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *ClientMockGetObjectsByPrototypeExpectation) Return(r []insolar.Reference, r1 error) {
+	e.result = &ClientMockGetObjectsByPrototypeResult{r, r1}
+}
+
+//Set uses given function f as a mock of Client.GetObjectsByPrototype method
+func (m *mClientMockGetObjectsByPrototype) Set(f func(p context.Context, p1 insolar.Reference, p2 insolar.PulseNumber, p3 insolar.PulseNumber) (r []insolar.Reference, r1 error)) *ClientMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.GetObjectsByPrototypeFunc = f
+	return m.mock
+}
+
+//GetObjectsByPrototype implements github.com/insolar/insolar/logicrunner/artifacts.Client interface
+func (m *ClientMock) GetObjectsByPrototype(p context.Context, p1 insolar.Reference, p2 insolar.PulseNumber, p3 insolar.PulseNumber) (r []insolar.Reference, r1 error) {
+	counter := atomic.AddUint64(&m.GetObjectsByPrototypePreCounter, 1)
+	defer atomic.AddUint64(&m.GetObjectsByPrototypeCounter, 1)
+
+	if len(m.GetObjectsByPrototypeMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.GetObjectsByPrototypeMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to ClientMock.GetObjectsByPrototype. %v %v %v %v", p, p1, p2, p3)
+			return
+		}
+
+		input := m.GetObjectsByPrototypeMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, ClientMockGetObjectsByPrototypeInput{p, p1, p2, p3}, "Client.GetObjectsByPrototype got unexpected parameters")
+
+		result := m.GetObjectsByPrototypeMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the ClientMock.GetObjectsByPrototype")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.GetObjectsByPrototypeMock.mainExpectation != nil {
+
+		input := m.GetObjectsByPrototypeMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, ClientMockGetObjectsByPrototypeInput{p, p1, p2, p3}, "Client.GetObjectsByPrototype got unexpected parameters")
+		}
+
+		result := m.GetObjectsByPrototypeMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the ClientMock.GetObjectsByPrototype")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.GetObjectsByPrototypeFunc == nil {
+		m.t.Fatalf("Unexpected call to ClientMock.GetObjectsByPrototype. %v %v %v %v", p, p1, p2, p3)
+		return
+	}
+
+	return m.GetObjectsByPrototypeFunc(p, p1, p2, p3)
+}
+
+//GetObjectsByPrototypeMinimockCounter returns a count of ClientMock.GetObjectsByPrototypeFunc invocations
+func (m *ClientMock) GetObjectsByPrototypeMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.GetObjectsByPrototypeCounter)
+}
+
+//GetObjectsByPrototypeMinimockPreCounter returns the value of ClientMock.GetObjectsByPrototype invocations
+func (m *ClientMock) GetObjectsByPrototypeMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.GetObjectsByPrototypePreCounter)
+}
+
+//GetObjectsByPrototypeFinished returns true if mock invocations count is ok
+func (m *ClientMock) GetObjectsByPrototypeFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.GetObjectsByPrototypeMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.GetObjectsByPrototypeCounter) == uint64(len(m.GetObjectsByPrototypeMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.GetObjectsByPrototypeMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.GetObjectsByPrototypeCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.GetObjectsByPrototypeFunc != nil {
+		return atomic.LoadUint64(&m.GetObjectsByPrototypeCounter) > 0
+	}
+
+	return true
+}
+
 type mClientMockGetPendingRequest struct {
 	mock              *ClientMock
 	mainExpectation   *ClientMockGetPendingRequestExpectation
@@ -2902,6 +3220,14 @@ func (m *ClientMock) ValidateCallCounters() {
 		m.t.Fatal("Expected call to ClientMock.GetObject")
 	}
 
+	if !m.GetObjectsByParentFinished() {
+		m.t.Fatal("Expected call to ClientMock.GetObjectsByParent")
+	}
+
+	if !m.GetObjectsByPrototypeFinished() {
+		m.t.Fatal("Expected call to ClientMock.GetObjectsByPrototype")
+	}
+
 	if !m.GetPendingRequestFinished() {
 		m.t.Fatal("Expected call to ClientMock.GetPendingRequest")
 	}
@@ -2991,6 +3317,14 @@ func (m *ClientMock) MinimockFinish() {
 		m.t.Fatal("Expected call to ClientMock.GetObject")
 	}
 
+	if !m.GetObjectsByParentFinished() {
+		m.t.Fatal("Expected call to ClientMock.GetObjectsByParent")
+	}
+
+	if !m.GetObjectsByPrototypeFinished() {
+		m.t.Fatal("Expected call to ClientMock.GetObjectsByPrototype")
+	}
+
 	if !m.GetPendingRequestFinished() {
 		m.t.Fatal("Expected call to ClientMock.GetPendingRequest")
 	}
@@ -3047,6 +3381,8 @@ func (m *ClientMock) MinimockWait(timeout time.Duration) {
 		ok = ok && m.GetCodeFinished()
 		ok = ok && m.GetDelegateFinished()
 		ok = ok && m.GetObjectFinished()
+		ok = ok && m.GetObjectsByParentFinished()
+		ok = ok && m.GetObjectsByPrototypeFinished()
 		ok = ok && m.GetPendingRequestFinished()
 		ok = ok && m.HasPendingRequestsFinished()
 		ok = ok && m.RegisterRequestFinished()
@@ -3103,6 +3439,14 @@ func (m *ClientMock) MinimockWait(timeout time.Duration) {
 				m.t.Error("Expected call to ClientMock.GetObject")
 			}
 
+			if !m.GetObjectsByParentFinished() {
+				m.t.Error("Expected call to ClientMock.GetObjectsByParent")
+			}
+
+			if !m.GetObjectsByPrototypeFinished() {
+				m.t.Error("Expected call to ClientMock.GetObjectsByPrototype")
+			}
+
 			if !m.GetPendingRequestFinished() {
 				m.t.Error("Expected call to ClientMock.GetPendingRequest")
 			}
@@ -3187,6 +3531,14 @@ func (m *ClientMock) AllMocksCalled() bool {
 		return false
 	}
 
+	if !m.GetObjectsByParentFinished() {
+		return false
+	}
+
+	if !m.GetObjectsByPrototypeFinished() {
+		return false
+	}
+
 	if !m.GetPendingRequestFinished() {
 		return false
 	}
@@ -30,7 +30,6 @@ import (
 
 	"github.com/insolar/insolar/component"
 	"github.com/insolar/insolar/insolar"
-	"github.com/insolar/insolar/insolar/delegationtoken"
 	"github.com/insolar/insolar/insolar/jet"
 	"github.com/insolar/insolar/insolar/message"
 	"github.com/insolar/insolar/insolar/reply"
@@ -42,6 +41,7 @@ import (
 	"github.com/insolar/insolar/ledger/storage/storagetest"
 	"github.com/insolar/insolar/platformpolicy"
 	"github.com/insolar/insolar/testutils"
+	"github.com/insolar/insolar/testutils/network"
 )
 
 type amSuite struct {
@@ -183,79 +183,6 @@ func (s *amSuite) TestLedgerArtifactManager_GetCodeWithCache() {
 
 }
 
-func (s *amSuite) TestLedgerArtifactManager_GetObject_FollowsRedirect() {
-	mc := minimock.NewController(s.T())
-	am := NewClient()
-	mb := testutils.NewMessageBusMock(mc)
-
-	pa := pulse.NewAccessorMock(s.T())
-	pa.LatestMock.Return(*insolar.GenesisPulse, nil)
-	am.PulseAccessor = pa
-
-	objRef := genRandomRef(0)
-	nodeRef := genRandomRef(0)
-	mb.SendFunc = func(c context.Context, m insolar.Message, o *insolar.MessageSendOptions) (r insolar.Reply, r1 error) {
-		o = o.Safe()
-
-		switch m.(type) {
-		case *message.GetObjectIndex:
-			return &reply.ObjectIndex{}, nil
-		case *message.GetObject:
-			if o.Receiver == nil {
-				return &reply.GetObjectRedirectReply{
-					Receiver: nodeRef,
-					Token:    &delegationtoken.GetObjectRedirectToken{Signature: []byte{1, 2, 3}},
-				}, nil
-			}
-
-			token, ok := o.Token.(*delegationtoken.GetObjectRedirectToken)
-			assert.True(s.T(), ok)
-			assert.Equal(s.T(), []byte{1, 2, 3}, token.Signature)
-			assert.Equal(s.T(), nodeRef, o.Receiver)
-			return &reply.Object{}, nil
-		default:
-			panic("unexpected call")
-		}
-	}
-	am.DefaultBus = mb
-
-	_, err := am.GetObject(s.ctx, *objRef, nil, false)
-
-	require.NoError(s.T(), err)
-}
-
-func (s *amSuite) TestLedgerArtifactManager_GetChildren_FollowsRedirect() {
-	mc := minimock.NewController(s.T())
-	am := NewClient()
-	mb := testutils.NewMessageBusMock(mc)
-
-	objRef := genRandomRef(0)
-	nodeRef := genRandomRef(0)
-	mb.SendFunc = func(c context.Context, m insolar.Message, o *insolar.MessageSendOptions) (r insolar.Reply, r1 error) {
-		o = o.Safe()
-		if o.Receiver == nil {
-			return &reply.GetChildrenRedirectReply{
-				Receiver: nodeRef,
-				Token:    &delegationtoken.GetChildrenRedirectToken{Signature: []byte{1, 2, 3}},
-			}, nil
-		}
-
-		token, ok := o.Token.(*delegationtoken.GetChildrenRedirectToken)
-		assert.True(s.T(), ok)
-		assert.Equal(s.T(), []byte{1, 2, 3}, token.Signature)
-		assert.Equal(s.T(), nodeRef, o.Receiver)
-		return &reply.Children{}, nil
-	}
-	am.DefaultBus = mb
-
-	pa := pulse.NewAccessorMock(s.T())
-	pa.LatestMock.Return(*insolar.GenesisPulse, nil)
-	am.PulseAccessor = pa
-
-	_, err := am.GetChildren(s.ctx, *objRef, nil)
-	require.NoError(s.T(), err)
-}
-
 func (s *amSuite) TestLedgerArtifactManager_RegisterRequest_JetMiss() {
 	mc := minimock.NewController(s.T())
 	defer mc.Finish()
@@ -357,3 +284,84 @@ func (s *amSuite) TestLedgerArtifactManager_GetRequest_Success() {
 	require.Equal(s.T(), parcel, res)
 
 }
+
+func (s *amSuite) TestLedgerArtifactManager_GetObjectsByPrototype_MergesLightAndHeavy() {
+	mc := minimock.NewController(s.T())
+	defer mc.Finish()
+
+	prototype := testutils.RandomRef()
+	light := testutils.RandomRef()
+	synced := *genRandomRef(insolar.FirstPulseNumber)
+	recent := *genRandomRef(insolar.FirstPulseNumber + 10)
+
+	pa := pulse.NewAccessorMock(mc)
+	pa.LatestMock.Return(insolar.Pulse{PulseNumber: insolar.FirstPulseNumber + 10}, nil)
+
+	jc := testutils.NewJetCoordinatorMock(mc)
+	jc.IsBeyondLimitMock.Return(false, nil)
+
+	nn := network.NewNodeNetworkMock(mc)
+	nn.GetWorkingNodesByRoleMock.Expect(insolar.DynamicRoleLightExecutor).Return([]insolar.Reference{light})
+
+	mb := testutils.NewMessageBusMock(mc)
+	mb.SendFunc = func(_ context.Context, msg insolar.Message, options *insolar.MessageSendOptions) (insolar.Reply, error) {
+		require.Equal(s.T(), prototype, msg.(*message.GetObjectsByPrototype).Prototype)
+		// Heavy is reached by default role of the message.
+		if options == nil {
+			return &reply.ObjectHeads{Refs: []insolar.Reference{synced}}, nil
+		}
+		require.Equal(s.T(), light, *options.Receiver)
+		// Pulse is synced, but not cleaned from light yet.
+		return &reply.ObjectHeads{Refs: []insolar.Reference{synced, recent}}, nil
+	}
+
+	am := NewClient()
+	am.PulseAccessor = pa
+	am.JetCoordinator = jc
+	am.NodeNetwork = nn
+	am.DefaultBus = mb
+
+	refs, err := am.GetObjectsByPrototype(s.ctx, prototype, insolar.FirstPulseNumber, insolar.FirstPulseNumber+10)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), []insolar.Reference{synced, recent}, refs)
+}
+
+func (s *amSuite) TestLedgerArtifactManager_GetObjectsByParent_SkipsLightBeyondLimit() {
+	mc := minimock.NewController(s.T())
+	defer mc.Finish()
+
+	parent := testutils.RandomRef()
+	child := *genRandomRef(insolar.FirstPulseNumber)
+
+	pa := pulse.NewAccessorMock(mc)
+	pa.LatestMock.Return(insolar.Pulse{PulseNumber: insolar.FirstPulseNumber + 100}, nil)
+
+	jc := testutils.NewJetCoordinatorMock(mc)
+	jc.IsBeyondLimitFunc = func(_ context.Context, currentPN, targetPN insolar.PulseNumber) (bool, error) {
+		require.Equal(s.T(), insolar.PulseNumber(insolar.FirstPulseNumber+100), currentPN)
+		require.Equal(s.T(), insolar.PulseNumber(insolar.FirstPulseNumber), targetPN)
+		return true, nil
+	}
+
+	// Light nodes are not asked, only heavy is reached by default role of the message.
+	mb := testutils.NewMessageBusMock(mc)
+	mb.SendFunc = func(_ context.Context, msg insolar.Message, options *insolar.MessageSendOptions) (insolar.Reply, error) {
+		require.Nil(s.T(), options)
+		require.Equal(s.T(), &message.GetObjectsByParent{
+			Parent:    parent,
+			FromPulse: insolar.FirstPulseNumber,
+			ToPulse:   insolar.FirstPulseNumber,
+		}, msg)
+		return &reply.ObjectHeads{Refs: []insolar.Reference{child}}, nil
+	}
+
+	am := NewClient()
+	am.PulseAccessor = pa
+	am.JetCoordinator = jc
+	am.NodeNetwork = network.NewNodeNetworkMock(mc)
+	am.DefaultBus = mb
+
+	refs, err := am.GetObjectsByParent(s.ctx, parent, insolar.FirstPulseNumber, insolar.FirstPulseNumber)
+	require.NoError(s.T(), err)
+	assert.Equal(s.T(), []insolar.Reference{child}, refs)
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/insolar/insolar/ledger/storage/drop"
 	"github.com/insolar/insolar/ledger/storage/node"
 	"github.com/insolar/insolar/ledger/storage/pulse"
+	"github.com/insolar/insolar/ledger/storage/secondary"
 	"github.com/insolar/insolar/ledger/storage/storagetest"
 	"github.com/insolar/insolar/log"
 	"github.com/insolar/insolar/logicrunner/pulsemanager"
@@ -161,6 +162,9 @@ func TmpLedger(t *testing.T, dir string, c insolar.Components) (*TMPLedger, stor
 	handler.BlobAccessor = bs
 	handler.RecordModifier = recordModifier
 	handler.RecordAccessor = recordAccessor
+	indexes := secondary.NewStorageMemory()
+	handler.SecondaryIndex = indexes
+	handler.SecondaryIndexModifier = indexes
 
 	idLockerMock := storage.NewIDLockerMock(t)
 	idLockerMock.LockMock.Return()
@@ -173,6 +177,7 @@ func TmpLedger(t *testing.T, dir string, c insolar.Components) (*TMPLedger, stor
 
 	am.DefaultBus = c.MessageBus
 	am.JetCoordinator = jc
+	am.NodeNetwork = c.NodeNetwork
 
 	cm.Inject(
 		platformpolicy.NewPlatformCryptographyScheme(),
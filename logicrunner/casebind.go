@@ -67,6 +67,19 @@ func NewCaseBindFromValidateMessage(ctx context.Context, mb insolar.MessageBus,
 	return res
 }
 
+// Close releases tapes of request players.
+func (cb *CaseBind) Close(ctx context.Context) {
+	for _, req := range cb.Requests {
+		closer, ok := req.MessageBus.(insolar.TapeCloser)
+		if !ok {
+			continue
+		}
+		if err := closer.CloseTape(ctx); err != nil {
+			inslogger.FromContext(ctx).Error(errors.Wrap(err, "can't close request tape"))
+		}
+	}
+}
+
 func NewCaseBindFromExecutorResultsMessage(msg *message.ExecutorResults) *CaseBind {
 	panic("not implemented")
 }
@@ -201,9 +214,9 @@ func (lr *LogicRunner) HandleValidateCaseBindMessage(ctx context.Context, inmsg
 		return nil, errors.Wrap(err, "[ HandleValidateCaseBindMessage ] can't play role")
 	}
 
-	passedStepsCount, validationError := lr.Validate(
-		ctx, msg.GetReference(), msg.GetPulse(), *NewCaseBindFromValidateMessage(ctx, lr.MessageBus, msg),
-	)
+	cb := NewCaseBindFromValidateMessage(ctx, lr.MessageBus, msg)
+	defer cb.Close(ctx)
+	passedStepsCount, validationError := lr.Validate(ctx, msg.GetReference(), msg.GetPulse(), *cb)
 	errstr := ""
 	if validationError != nil {
 		errstr = validationError.Error()
@@ -171,6 +171,16 @@ func (t *TestArtifactManager) GetChildren(ctx context.Context, parent insolar.Re
 	panic("implement me")
 }
 
+// GetObjectsByPrototype implementation for tests
+func (t *TestArtifactManager) GetObjectsByPrototype(ctx context.Context, prototype insolar.Reference, from, to insolar.PulseNumber) ([]insolar.Reference, error) {
+	panic("implement me")
+}
+
+// GetObjectsByParent implementation for tests
+func (t *TestArtifactManager) GetObjectsByParent(ctx context.Context, parent insolar.Reference, from, to insolar.PulseNumber) ([]insolar.Reference, error) {
+	panic("implement me")
+}
+
 // NewTestArtifactManager implementation for tests
 func NewTestArtifactManager() *TestArtifactManager {
 	return &TestArtifactManager{
@@ -23,4 +23,10 @@ import (
 var (
 	// ErrNoReply is returned from player when there is no stored reply for provided message.
 	ErrNoReply = errors.New("no such reply")
+	// ErrIncorrectPulse is returned when receiver rejects message sent in previous pulse.
+	ErrIncorrectPulse = errors.New("Incorrect message pulse")
+	// ErrQuorumNotReached is returned from broadcast send when too many nodes failed to reply.
+	ErrQuorumNotReached = errors.New("quorum not reached")
+	// ErrOverloaded is returned when incoming parcel is dropped because of rate limits or full queues.
+	ErrOverloaded = errors.New("message bus is overloaded")
 )
@@ -0,0 +1,238 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package messagebus
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/ugorji/go/codec"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/reply"
+)
+
+// fileTapeMagic starts every file tape stream. Memory tape streams start with CBOR-encoded pulse number,
+// so they can't be confused with file tapes.
+var fileTapeMagic = []byte("INSTAPE\x01")
+
+const (
+	fileTapeHeaderSize = 8 + 8 // magic + pulse number
+	fileTapeEntryHead  = 4 + 4 // message hash length + payload length
+)
+
+// fileTape saves message reply/error pairs to a file and reads them back on demand.
+//
+// Stream layout is magic, big-endian pulse number and entries. Every entry is a message hash and CBOR-encoded
+// itemBlob, both prefixed with their big-endian uint32 lengths. Only message hashes and entry offsets are kept in
+// memory, so tapes of long executions can be replayed without loading them.
+type fileTape struct {
+	lock  sync.Mutex
+	r     io.ReaderAt
+	w     io.WriterAt
+	pulse insolar.PulseNumber
+	size  int64
+	// index maps message hash to offsets of not yet fetched entries in the order they were set.
+	index map[string][]int64
+	// closer releases temporary file owned by the tape. It is nil for tapes opened on top of caller's reader.
+	closer io.Closer
+}
+
+// newFileTape creates writable tape on top of empty file.
+func newFileTape(f interface {
+	io.ReaderAt
+	io.WriterAt
+}, pulse insolar.PulseNumber) (*fileTape, error) {
+	header := make([]byte, fileTapeHeaderSize)
+	copy(header, fileTapeMagic)
+	binary.BigEndian.PutUint64(header[len(fileTapeMagic):], uint64(pulse))
+	if _, err := f.WriteAt(header, 0); err != nil {
+		return nil, errors.Wrap(err, "[ FileTape ] can't write header")
+	}
+
+	return &fileTape{
+		r:     f,
+		w:     f,
+		pulse: pulse,
+		size:  fileTapeHeaderSize,
+		index: map[string][]int64{},
+	}, nil
+}
+
+// newTempFileTape creates writable tape on top of a new temporary file in dir. The file is released on Close.
+func newTempFileTape(pulse insolar.PulseNumber, dir string) (*fileTape, error) {
+	f, err := createTapeFile(dir)
+	if err != nil {
+		return nil, err
+	}
+	t, err := newFileTape(f, pulse)
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	t.closer = f
+	return t, nil
+}
+
+// openFileTape opens read-only tape from random access reader. It scans entry headers to build message hash index,
+// entry payloads are read on Get.
+func openFileTape(ctx context.Context, r io.ReaderAt) (*fileTape, error) {
+	header := make([]byte, fileTapeHeaderSize)
+	if _, err := r.ReadAt(header, 0); err != nil {
+		return nil, errors.Wrap(err, "[ FileTape ] can't read header")
+	}
+	if !bytes.Equal(header[:len(fileTapeMagic)], fileTapeMagic) {
+		return nil, errors.New("[ FileTape ] not a file tape")
+	}
+
+	t := &fileTape{
+		r:     r,
+		pulse: insolar.PulseNumber(binary.BigEndian.Uint64(header[len(fileTapeMagic):])),
+		size:  fileTapeHeaderSize,
+		index: map[string][]int64{},
+	}
+	head := make([]byte, fileTapeEntryHead)
+	for {
+		n, err := r.ReadAt(head, t.size)
+		if n == 0 && err == io.EOF {
+			break
+		}
+		if n < len(head) {
+			return nil, errors.Wrapf(err, "[ FileTape ] can't read entry at %d", t.size)
+		}
+		hash := make([]byte, binary.BigEndian.Uint32(head))
+		if _, err := r.ReadAt(hash, t.size+fileTapeEntryHead); err != nil {
+			return nil, errors.Wrapf(err, "[ FileTape ] can't read entry hash at %d", t.size)
+		}
+
+		key := string(hash)
+		t.index[key] = append(t.index[key], t.size)
+		t.size += fileTapeEntryHead + int64(len(hash)) + int64(binary.BigEndian.Uint32(head[4:]))
+	}
+	return t, nil
+}
+
+// Close releases temporary file owned by the tape. The tape can't be used after Close.
+func (t *fileTape) Close() error {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.closer == nil {
+		return nil
+	}
+	err := t.closer.Close()
+	t.closer = nil
+	if err != nil {
+		return errors.Wrap(err, "[ FileTape ] can't close tape file")
+	}
+	return nil
+}
+
+// Write copies whole tape stream to the provided writer.
+func (t *fileTape) Write(ctx context.Context, w io.Writer) error {
+	t.lock.Lock()
+	size := t.size
+	t.lock.Unlock()
+
+	_, err := io.Copy(w, io.NewSectionReader(t.r, 0, size))
+	if err != nil {
+		return errors.Wrap(err, "[ FileTape ] can't write tape")
+	}
+	return nil
+}
+
+// Get reads the earliest not yet fetched reply for provided message hash.
+func (t *fileTape) Get(ctx context.Context, msgHash []byte) (*TapeItem, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	offsets := t.index[string(msgHash)]
+	if len(offsets) == 0 {
+		return nil, ErrNoReply
+	}
+	offset := offsets[0]
+
+	head := make([]byte, fileTapeEntryHead)
+	if _, err := t.r.ReadAt(head, offset); err != nil {
+		return nil, errors.Wrapf(err, "[ FileTape ] can't read entry at %d", offset)
+	}
+	payload := make([]byte, binary.BigEndian.Uint32(head[4:]))
+	_, err := t.r.ReadAt(payload, offset+fileTapeEntryHead+int64(binary.BigEndian.Uint32(head)))
+	if err != nil {
+		return nil, errors.Wrapf(err, "[ FileTape ] can't read entry payload at %d", offset)
+	}
+
+	var blob itemBlob
+	err = codec.NewDecoderBytes(payload, new(codec.CborHandle)).Decode(&blob)
+	if err != nil {
+		return nil, errors.Wrapf(err, "[ FileTape ] can't decode entry at %d", offset)
+	}
+	item := TapeItem{}
+	if blob.ReplyB != nil {
+		item.Reply, err = reply.Deserialize(bytes.NewReader(blob.ReplyB))
+		if err != nil {
+			return nil, errors.Wrapf(err, "[ FileTape ] can't decode reply at %d", offset)
+		}
+	}
+	if blob.ErrorB != nil {
+		item.Error = decodeTapeError(blob.ErrorB)
+	}
+
+	t.index[string(msgHash)] = offsets[1:]
+	return &item, nil
+}
+
+// Set appends reply/error pair to the end of the file.
+func (t *fileTape) Set(ctx context.Context, msgHash []byte, rep insolar.Reply, gotError error) error {
+	if t.w == nil {
+		return errors.New("[ FileTape ] tape is read-only")
+	}
+
+	blob := itemBlob{}
+	if rep != nil {
+		blob.ReplyB = reply.ToBytes(rep)
+	}
+	if gotError != nil {
+		blob.ErrorB = encodeTapeError(gotError)
+	}
+	var payload []byte
+	err := codec.NewEncoderBytes(&payload, new(codec.CborHandle)).Encode(blob)
+	if err != nil {
+		return errors.Wrap(err, "[ FileTape ] can't encode entry")
+	}
+
+	entry := make([]byte, fileTapeEntryHead, fileTapeEntryHead+len(msgHash)+len(payload))
+	binary.BigEndian.PutUint32(entry, uint32(len(msgHash)))
+	binary.BigEndian.PutUint32(entry[4:], uint32(len(payload)))
+	entry = append(entry, msgHash...)
+	entry = append(entry, payload...)
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if _, err := t.w.WriteAt(entry, t.size); err != nil {
+		return errors.Wrap(err, "[ FileTape ] can't write entry")
+	}
+	key := string(msgHash)
+	t.index[key] = append(t.index[key], t.size)
+	t.size += int64(len(entry))
+	return nil
+}
@@ -0,0 +1,72 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package messagebus
+
+import (
+	"sync"
+
+	"github.com/insolar/insolar/insolar"
+)
+
+// interceptors holds inbound and outbound chains. The same chains are shared by bus and its recorders and players.
+type interceptors struct {
+	lock     sync.RWMutex
+	inbound  []insolar.InboundInterceptor
+	outbound []insolar.OutboundInterceptor
+}
+
+func newInterceptors() *interceptors {
+	return &interceptors{}
+}
+
+func (i *interceptors) addInbound(interceptor insolar.InboundInterceptor) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	i.inbound = append(i.inbound, interceptor)
+}
+
+func (i *interceptors) addOutbound(interceptor insolar.OutboundInterceptor) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	i.outbound = append(i.outbound, interceptor)
+}
+
+// handler wraps provided handler with inbound chain. First added interceptor is called first.
+func (i *interceptors) handler(handler insolar.MessageHandler) insolar.MessageHandler {
+	if i == nil {
+		return handler
+	}
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+	for n := len(i.inbound) - 1; n >= 0; n-- {
+		handler = i.inbound[n](handler)
+	}
+	return handler
+}
+
+// sender wraps provided sender with outbound chain. First added interceptor is called first.
+func (i *interceptors) sender(sender insolar.MessageSender) insolar.MessageSender {
+	if i == nil {
+		return sender
+	}
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+	for n := len(i.outbound) - 1; n >= 0; n-- {
+		sender = i.outbound[n](sender)
+	}
+	return sender
+}
@@ -0,0 +1,123 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package messagebus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/reply"
+)
+
+func recordingInbound(calls *[]string, name string) insolar.InboundInterceptor {
+	return func(next insolar.MessageHandler) insolar.MessageHandler {
+		return func(ctx context.Context, parcel insolar.Parcel) (insolar.Reply, error) {
+			*calls = append(*calls, name)
+			return next(ctx, parcel)
+		}
+	}
+}
+
+func recordingOutbound(calls *[]string, name string) insolar.OutboundInterceptor {
+	return func(next insolar.MessageSender) insolar.MessageSender {
+		return func(ctx context.Context, msg insolar.Message, ops *insolar.MessageSendOptions) (insolar.Reply, error) {
+			*calls = append(*calls, name)
+			return next(ctx, msg, ops)
+		}
+	}
+}
+
+func shortCircuitOutbound(rep insolar.Reply) insolar.OutboundInterceptor {
+	return func(next insolar.MessageSender) insolar.MessageSender {
+		return func(context.Context, insolar.Message, *insolar.MessageSendOptions) (insolar.Reply, error) {
+			return rep, nil
+		}
+	}
+}
+
+func TestMessageBus_InboundInterceptors_Order(t *testing.T) {
+	ctx := context.Background()
+	mb, _, parcel := prepare(t, ctx, 100, 100)
+
+	var calls []string
+	mb.AddInboundInterceptor(recordingInbound(&calls, "first"))
+	mb.AddInboundInterceptor(recordingInbound(&calls, "second"))
+
+	result, err := mb.doDeliver(ctx, parcel)
+	require.NoError(t, err)
+	require.Equal(t, testReply, result)
+	require.Equal(t, []string{"first", "second"}, calls)
+}
+
+func TestMessageBus_InboundInterceptors_ShortCircuit(t *testing.T) {
+	ctx := context.Background()
+	mb, _, parcel := prepare(t, ctx, 100, 100)
+
+	var calls []string
+	mb.AddInboundInterceptor(func(next insolar.MessageHandler) insolar.MessageHandler {
+		return func(context.Context, insolar.Parcel) (insolar.Reply, error) {
+			return &reply.OK{}, nil
+		}
+	})
+	mb.AddInboundInterceptor(recordingInbound(&calls, "skipped"))
+
+	result, err := mb.doDeliver(ctx, parcel)
+	require.NoError(t, err)
+	require.Equal(t, &reply.OK{}, result)
+	require.Empty(t, calls)
+}
+
+func TestMessageBus_OutboundInterceptors(t *testing.T) {
+	ctx := context.Background()
+	mb, _, _ := prepare(t, ctx, 100, 100)
+
+	var calls []string
+	mb.AddOutboundInterceptor(recordingOutbound(&calls, "first"))
+	mb.AddOutboundInterceptor(recordingOutbound(&calls, "second"))
+	mb.AddOutboundInterceptor(shortCircuitOutbound(&reply.OK{}))
+
+	result, err := mb.Send(ctx, &message.GetObject{}, nil)
+	require.NoError(t, err)
+	require.Equal(t, &reply.OK{}, result)
+	require.Equal(t, []string{"first", "second"}, calls)
+}
+
+func TestMessageBus_OutboundInterceptors_SharedWithRecorder(t *testing.T) {
+	ctx := context.Background()
+	mb, _, _ := prepare(t, ctx, 100, 100)
+
+	rec, err := mb.NewRecorder(ctx, insolar.Pulse{PulseNumber: 100})
+	require.NoError(t, err)
+
+	// Interceptor added after recorder creation is applied as well.
+	var calls []string
+	rec.AddOutboundInterceptor(recordingOutbound(&calls, "recorder"))
+	rec.AddOutboundInterceptor(shortCircuitOutbound(&reply.OK{}))
+
+	result, err := rec.Send(ctx, &message.GetObject{}, nil)
+	require.NoError(t, err)
+	require.Equal(t, &reply.OK{}, result)
+
+	result, err = mb.Send(ctx, &message.GetObject{}, nil)
+	require.NoError(t, err)
+	require.Equal(t, &reply.OK{}, result)
+	require.Equal(t, []string{"recorder", "recorder"}, calls)
+}
@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"io"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/insolar/insolar/ledger/storage/pulse"
@@ -53,8 +54,15 @@ type MessageBus struct {
 	ParcelFactory              message.ParcelFactory              `inject:""`
 	PulseAccessor              pulse.Accessor                     `inject:""`
 
-	handlers     map[insolar.MessageType]insolar.MessageHandler
-	signmessages bool
+	handlers      map[insolar.MessageType]insolar.MessageHandler
+	retryPolicies map[insolar.MessageType]RetryPolicy
+	interceptors  *interceptors
+	signmessages  bool
+
+	senderLimiter  *rateLimiter
+	typeLimiter    *rateLimiter
+	futurePoolSize uint32
+	tapeDirectory  string
 
 	globalLock                  sync.RWMutex
 	NextPulseMessagePoolChan    chan interface{}
@@ -67,32 +75,48 @@ type MessageBus struct {
 func NewMessageBus(config configuration.Configuration) (*MessageBus, error) {
 	mb := &MessageBus{
 		handlers:                 map[insolar.MessageType]insolar.MessageHandler{},
+		retryPolicies:            defaultRetryPolicies(),
+		interceptors:             newInterceptors(),
 		signmessages:             config.Host.SignMessages,
+		senderLimiter:            newRateLimiter(config.MessageBus.SenderRate, config.MessageBus.SenderBurst),
+		typeLimiter:              newRateLimiter(config.MessageBus.TypeRate, config.MessageBus.TypeBurst),
+		futurePoolSize:           uint32(config.MessageBus.FuturePoolSize),
+		tapeDirectory:            config.MessageBus.TapeDirectory,
 		NextPulseMessagePoolChan: make(chan interface{}),
 	}
 	mb.Lock(context.Background())
 	return mb, nil
 }
 
-// NewPlayer creates a new player from stream. This is a very long operation, as it saves replies in storage until the
-// stream is exhausted.
+// NewPlayer creates a new player from stream. Memory tapes are loaded until the stream is exhausted, file tapes are
+// read on demand.
 //
-// Player can be created from MessageBus and passed as MessageBus instance.
+// Player can be created from MessageBus and passed as MessageBus instance. It should be closed with CloseTape when
+// replay is finished.
 func (mb *MessageBus) NewPlayer(ctx context.Context, reader io.Reader) (insolar.MessageBus, error) {
-	tape, err := newMemoryTapeFromReader(ctx, reader)
+	tape, err := newTapeFromReader(ctx, reader, mb.tapeDirectory)
 	if err != nil {
 		return nil, err
 	}
 	pl := newPlayer(mb, tape, mb.PlatformCryptographyScheme, mb.PulseAccessor)
+	pl.retryPolicies = mb.retryPolicies
+	pl.interceptors = mb.interceptors
 	return pl, nil
 }
 
-// NewRecorder creates a new recorder with unique tape that can be used to store message replies.
+// NewRecorder creates a new recorder with unique tape that can be used to store message replies. The tape is kept in
+// a temporary file in configured tape directory.
 //
-// Recorder can be created from MessageBus and passed as MessageBus instance.
+// Recorder can be created from MessageBus and passed as MessageBus instance. It should be closed with CloseTape when
+// the tape is no longer needed.
 func (mb *MessageBus) NewRecorder(ctx context.Context, currentPulse insolar.Pulse) (insolar.MessageBus, error) {
-	tape := newMemoryTape(currentPulse.PulseNumber)
+	tape, err := newTempFileTape(currentPulse.PulseNumber, mb.tapeDirectory)
+	if err != nil {
+		return nil, err
+	}
 	rec := newRecorder(mb, tape, mb.PlatformCryptographyScheme, mb.PulseAccessor)
+	rec.retryPolicies = mb.retryPolicies
+	rec.interceptors = mb.interceptors
 	return rec, nil
 }
 
@@ -136,11 +160,37 @@ func (mb *MessageBus) MustRegister(p insolar.MessageType, handler insolar.Messag
 	}
 }
 
-// Send an `Message` and get a `Value` or error from remote host.
+// AddInboundInterceptor adds interceptor to the chain that wraps handlers of all received messages. Interceptors
+// are executed in the order they were added. Interceptors are shared with recorders and players created from the bus.
+func (mb *MessageBus) AddInboundInterceptor(interceptor insolar.InboundInterceptor) {
+	mb.interceptors.addInbound(interceptor)
+}
+
+// AddOutboundInterceptor adds interceptor to the chain that wraps all sent messages. Interceptors are executed in
+// the order they were added and see every Send call once, regardless of retries.
+func (mb *MessageBus) AddOutboundInterceptor(interceptor insolar.OutboundInterceptor) {
+	mb.interceptors.addOutbound(interceptor)
+}
+
+// SetRetryPolicy sets how messages of provided type are resent. It should be called before the bus is started.
+func (mb *MessageBus) SetRetryPolicy(p insolar.MessageType, policy RetryPolicy) {
+	mb.retryPolicies[p] = policy
+}
+
+// Send an `Message` and get a `Value` or error from remote host. Message is passed through outbound interceptors and
+// resent according to its RetryPolicy.
 func (mb *MessageBus) Send(ctx context.Context, msg insolar.Message, ops *insolar.MessageSendOptions) (insolar.Reply, error) {
 	ctx, span := instracer.StartSpan(ctx, "MessageBus.Send "+msg.Type().String())
 	defer span.End()
 
+	return mb.interceptors.sender(mb.sendWithRetries)(ctx, msg, ops)
+}
+
+func (mb *MessageBus) sendWithRetries(ctx context.Context, msg insolar.Message, ops *insolar.MessageSendOptions) (insolar.Reply, error) {
+	return mb.retryPolicies[msg.Type()].send(ctx, msg, ops, mb.send)
+}
+
+func (mb *MessageBus) send(ctx context.Context, msg insolar.Message, ops *insolar.MessageSendOptions) (insolar.Reply, error) {
 	currentPulse, err := mb.PulseAccessor.Latest(ctx)
 	if err != nil {
 		return nil, err
@@ -178,10 +228,9 @@ func (mb *MessageBus) SendParcel(
 		nodes []insolar.Reference
 		err   error
 	)
-	if options != nil && options.Receiver != nil {
+	if options != nil && options.Receiver != nil && !options.Broadcast {
 		nodes = []insolar.Reference{*options.Receiver}
 	} else {
-		// TODO: send to all actors of the role if nil Target
 		target := parcel.DefaultTarget()
 		// FIXME: @andreyromancev. 21.12.18. Temp hack. All messages should have a default target.
 		if target == nil {
@@ -200,6 +249,10 @@ func (mb *MessageBus) SendParcel(
 
 	stats.Record(ctx, statParcelsSentTotal.M(1))
 
+	if options != nil && options.Broadcast {
+		return mb.broadcast(ctx, parcel, nodes, options.Quorum)
+	}
+
 	if len(nodes) > 1 {
 		cascade := insolar.Cascade{
 			NodeIds:           nodes,
@@ -210,19 +263,87 @@ func (mb *MessageBus) SendParcel(
 		return nil, err
 	}
 
+	return mb.sendToNode(ctx, parcel, nodes[0])
+}
+
+// broadcast sends parcel to all provided nodes in parallel and collects their replies. It returns as soon as quorum
+// of successful replies is collected or when quorum can't be reached anymore.
+func (mb *MessageBus) broadcast(
+	ctx context.Context,
+	parcel insolar.Parcel,
+	nodes []insolar.Reference,
+	quorum int,
+) (insolar.Reply, error) {
+	if quorum <= 0 || quorum > len(nodes) {
+		quorum = len(nodes)
+	}
+
+	type result struct {
+		node insolar.Reference
+		rep  insolar.Reply
+		err  error
+	}
+	// Buffered, so senders don't block after quorum is reached.
+	results := make(chan result, len(nodes))
+	for _, node := range nodes {
+		go func(node insolar.Reference) {
+			rep, err := mb.sendToNode(ctx, parcel, node)
+			results <- result{node: node, rep: rep, err: err}
+		}(node)
+	}
+
+	rep := &reply.Broadcast{
+		Replies: map[insolar.Reference]insolar.Reply{},
+		Errors:  map[insolar.Reference]string{},
+	}
+	for pending := len(nodes); pending > 0; pending-- {
+		if len(rep.Replies) >= quorum {
+			return rep, nil
+		}
+		if len(rep.Replies)+pending < quorum {
+			break
+		}
+
+		res := <-results
+		if res.err != nil {
+			inslogger.FromContext(ctx).Warnf("[ broadcast ] node %s failed to reply: %s", res.node, res.err)
+			rep.Errors[res.node] = res.err.Error()
+			continue
+		}
+		rep.Replies[res.node] = res.rep
+	}
+
+	if len(rep.Replies) < quorum {
+		return rep, errors.Wrapf(
+			ErrQuorumNotReached, "[ broadcast ] got %d of %d required replies", len(rep.Replies), quorum,
+		)
+	}
+	return rep, nil
+}
+
+// sendToNode delivers parcel to a single node and waits for reply.
+func (mb *MessageBus) sendToNode(ctx context.Context, parcel insolar.Parcel, node insolar.Reference) (insolar.Reply, error) {
 	// Short path when sending to self node. Skip serialization
 	origin := mb.NodeNetwork.GetOrigin()
-	if nodes[0].Equal(origin.ID()) {
+	if node.Equal(origin.ID()) {
 		stats.Record(ctx, statLocallyDeliveredParcelsTotal.M(1))
 		return mb.doDeliver(parcel.Context(context.Background()), parcel)
 	}
 
-	res, err := mb.Network.SendMessage(nodes[0], deliverRPCMethodName, parcel)
+	res, err := mb.Network.SendMessage(node, deliverRPCMethodName, parcel)
 	if err != nil {
 		return nil, err
 	}
 
-	return reply.Deserialize(bytes.NewBuffer(res))
+	rep, err := reply.Deserialize(bytes.NewBuffer(res))
+	if err != nil {
+		return nil, err
+	}
+	// Rejected pulse is sent as reply to keep the error type across the network.
+	if r, ok := rep.(*reply.Error); ok && r.ErrType == reply.ErrIncorrectPulse {
+		return nil, errors.Wrapf(ErrIncorrectPulse, "[ sendToNode ] rejected by node %s", node)
+	}
+	return rep, nil
 }
 
 type serializableError struct {
@@ -241,6 +362,9 @@ func (mb *MessageBus) OnPulse(context.Context, insolar.Pulse) error {
 
 	mb.NextPulseMessagePoolChan = make(chan interface{})
 
+	mb.senderLimiter.prune()
+	mb.typeLimiter.prune()
+
 	return nil
 }
 
@@ -271,7 +395,7 @@ func (mb *MessageBus) doDeliver(ctx context.Context, msg insolar.Parcel) (insola
 	}
 	// TODO: sergey.morozov 2018-12-21 there is potential race condition because of readBarrier. We must implement correct locking.
 
-	resp, err := handler(ctx, msg)
+	resp, err := mb.interceptors.handler(handler)(ctx, msg)
 	if err != nil {
 		return nil, &serializableError{
 			S: err.Error(),
@@ -318,7 +442,7 @@ func (mb *MessageBus) checkPulse(ctx context.Context, parcel insolar.Parcel, loc
 			*message.HotData,
 			*message.CallMethod:
 			inslogger.FromContext(ctx).Errorf("[ checkPulse ] Incorrect message pulse (parcel: %d, current: %d)", ppn, pulse.PulseNumber)
-			return fmt.Errorf("[ checkPulse ] Incorrect message pulse (parcel: %d, current: %d)", ppn, pulse.PulseNumber)
+			return errors.Wrapf(ErrIncorrectPulse, "[ checkPulse ] parcel: %d, current: %d", ppn, pulse.PulseNumber)
 		}
 	}
 
@@ -333,6 +457,23 @@ func (mb *MessageBus) handleParcelFromTheFuture(ctx context.Context, parcel inso
 	inslogger.FromContext(ctx).Debug(
 		"message from the future, msg pulse: ", ppn,
 	)
+
+	waiting := atomic.AddUint32(&mb.NextPulseMessagePoolCounter, 1)
+	defer func() {
+		waiting := atomic.AddUint32(&mb.NextPulseMessagePoolCounter, ^uint32(0))
+		stats.Record(ctx, statFuturePoolSize.M(int64(waiting)))
+	}()
+	if mb.futurePoolSize > 0 && waiting > mb.futurePoolSize {
+		stats.Record(
+			insmetrics.InsertTag(ctx, tagMessageType, parcel.Type().String()),
+			statParcelsDroppedTotal.M(1),
+		)
+		return errors.Wrapf(
+			ErrOverloaded, "[ handleParcelFromTheFuture ] %d parcels are already waiting for pulse", mb.futurePoolSize,
+		)
+	}
+	stats.Record(ctx, statFuturePoolSize.M(int64(waiting)))
+
 	if locked {
 		mb.globalLock.RUnlock()
 		defer mb.globalLock.RLock()
@@ -390,6 +531,13 @@ func (mb *MessageBus) deliver(ctx context.Context, args [][]byte) (result []byte
 
 	if err = mb.checkPulse(parcelCtx, parcel, true); err != nil {
 		mb.globalLock.RUnlock()
+		switch errors.Cause(err) {
+		case ErrOverloaded:
+			inslogger.FromContext(parcelCtx).Warn(err)
+			return serializeReply(&reply.Overloaded{Reason: err.Error()})
+		case ErrIncorrectPulse:
+			return serializeReply(&reply.Error{ErrType: reply.ErrIncorrectPulse})
+		}
 		return nil, err
 	}
 
@@ -399,12 +547,40 @@ func (mb *MessageBus) deliver(ctx context.Context, args [][]byte) (result []byte
 	}
 	mb.globalLock.RUnlock()
 
+	// Sender is known only after signature is checked, so parcels are throttled after that.
+	if err = mb.throttle(parcelCtx, parcel); err != nil {
+		inslogger.FromContext(parcelCtx).Warn(err)
+		return serializeReply(&reply.Overloaded{Reason: err.Error()})
+	}
+
 	resp, err := mb.doDeliver(parcelCtx, parcel)
 	if err != nil {
 		return nil, err
 	}
 
-	rd, err := reply.Serialize(resp)
+	return serializeReply(resp)
+}
+
+// throttle checks incoming parcel against sender and message type rate limits.
+func (mb *MessageBus) throttle(ctx context.Context, parcel insolar.Parcel) error {
+	var limit string
+	switch {
+	case !mb.senderLimiter.allow(parcel.GetSender()):
+		limit = "sender"
+	case !mb.typeLimiter.allow(parcel.Type()):
+		limit = "type"
+	default:
+		return nil
+	}
+
+	ctx = insmetrics.InsertTag(ctx, tagMessageType, parcel.Type().String())
+	ctx = insmetrics.InsertTag(ctx, tagLimit, limit)
+	stats.Record(ctx, statParcelsThrottledTotal.M(1))
+	return errors.Wrapf(ErrOverloaded, "[ throttle ] %s rate limit exceeded by %s", limit, parcel.GetSender())
+}
+
+func serializeReply(rep insolar.Reply) ([]byte, error) {
+	rd, err := reply.Serialize(rep)
 	if err != nil {
 		return nil, err
 	}
@@ -17,18 +17,26 @@
 package messagebus
 
 import (
+	"bytes"
 	"context"
+	"crypto"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/insolar/insolar/ledger/storage/pulse"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
 
 	"github.com/insolar/insolar/component"
 	"github.com/insolar/insolar/configuration"
 	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/delegationtoken"
 	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/instrumentation/instracer"
 	"github.com/insolar/insolar/testutils"
 	"github.com/insolar/insolar/testutils/network"
 )
@@ -174,3 +182,247 @@ func TestMessageBus_doDeliver_TwoAheadPulses(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, insolar.PulseNumber(102), pulse.PulseNumber)
 }
+
+func prepareBroadcast(t *testing.T, ctx context.Context, failed int) (*MessageBus, insolar.Parcel, []insolar.Reference) {
+	mb, _, parcel := prepare(t, ctx, 100, 100)
+	parcelMock := parcel.(*testutils.ParcelMock)
+	parcelMock.DefaultTargetMock.Return(&insolar.Reference{})
+	parcelMock.DefaultRoleMock.Return(insolar.DynamicRoleLightValidator)
+
+	nodes := []insolar.Reference{testutils.RandomRef(), testutils.RandomRef(), testutils.RandomRef()}
+	jc := testutils.NewJetCoordinatorMock(t)
+	jc.QueryRoleMock.Return(nodes, nil)
+	mb.JetCoordinator = jc
+
+	net := testutils.NewNetworkMock(t)
+	net.SendMessageFunc = func(node insolar.Reference, method string, _ insolar.Parcel) ([]byte, error) {
+		require.Equal(t, deliverRPCMethodName, method)
+		for _, n := range nodes[:failed] {
+			if n == node {
+				return nil, errors.New("node is down")
+			}
+		}
+		return reply.ToBytes(&reply.OK{}), nil
+	}
+	mb.Network = net
+
+	return mb, parcel, nodes
+}
+
+func TestMessageBus_SendParcel_Broadcast(t *testing.T) {
+	ctx := context.Background()
+	mb, parcel, nodes := prepareBroadcast(t, ctx, 1)
+
+	rep, err := mb.SendParcel(ctx, parcel, insolar.Pulse{PulseNumber: 100}, &insolar.MessageSendOptions{Broadcast: true})
+	require.Error(t, err)
+	require.Equal(t, ErrQuorumNotReached, errors.Cause(err))
+
+	// Broadcast stops waiting as soon as the failed node makes quorum unreachable.
+	broadcast := rep.(*reply.Broadcast)
+	require.Equal(t, map[insolar.Reference]string{nodes[0]: "node is down"}, broadcast.Errors)
+	require.NotContains(t, broadcast.Replies, nodes[0])
+	for _, r := range broadcast.Replies {
+		require.Equal(t, &reply.OK{}, r)
+	}
+}
+
+func TestMessageBus_SendParcel_BroadcastAll(t *testing.T) {
+	ctx := context.Background()
+	mb, parcel, nodes := prepareBroadcast(t, ctx, 0)
+
+	rep, err := mb.SendParcel(ctx, parcel, insolar.Pulse{PulseNumber: 100}, &insolar.MessageSendOptions{
+		Broadcast: true,
+		Receiver:  &nodes[0],
+	})
+	require.NoError(t, err)
+
+	broadcast := rep.(*reply.Broadcast)
+	require.Len(t, broadcast.Replies, len(nodes))
+	require.Empty(t, broadcast.Errors)
+}
+
+func TestMessageBus_SendParcel_BroadcastQuorum(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("reached", func(t *testing.T) {
+		mb, parcel, _ := prepareBroadcast(t, ctx, 1)
+		rep, err := mb.SendParcel(ctx, parcel, insolar.Pulse{PulseNumber: 100}, &insolar.MessageSendOptions{
+			Broadcast: true,
+			Quorum:    2,
+		})
+		require.NoError(t, err)
+		require.Len(t, rep.(*reply.Broadcast).Replies, 2)
+	})
+
+	t.Run("not reached", func(t *testing.T) {
+		mb, parcel, _ := prepareBroadcast(t, ctx, 2)
+		rep, err := mb.SendParcel(ctx, parcel, insolar.Pulse{PulseNumber: 100}, &insolar.MessageSendOptions{
+			Broadcast: true,
+			Quorum:    2,
+		})
+		require.Equal(t, ErrQuorumNotReached, errors.Cause(err))
+		require.True(t, len(rep.(*reply.Broadcast).Replies) < 2)
+	})
+}
+
+func TestMessageBus_throttle(t *testing.T) {
+	ctx := context.Background()
+	mb, _, parcel := prepare(t, ctx, 100, 100)
+	sender := testutils.RandomRef()
+	parcel.(*testutils.ParcelMock).GetSenderMock.Return(sender)
+	mb.senderLimiter = newRateLimiter(1, 2)
+
+	require.NoError(t, mb.throttle(ctx, parcel))
+	require.NoError(t, mb.throttle(ctx, parcel))
+	err := mb.throttle(ctx, parcel)
+	require.Equal(t, ErrOverloaded, errors.Cause(err))
+
+	mb.senderLimiter = nil
+	mb.typeLimiter = newRateLimiter(1, 1)
+	require.NoError(t, mb.throttle(ctx, parcel))
+	err = mb.throttle(ctx, parcel)
+	require.Equal(t, ErrOverloaded, errors.Cause(err))
+}
+
+func TestMessageBus_deliver_ThrottlesVerifiedSender(t *testing.T) {
+	ctx := context.Background()
+	mb, _, _ := prepare(t, ctx, 100, 100)
+	mb.signmessages = true
+	mb.senderLimiter = newRateLimiter(1, 1)
+
+	sender := testutils.RandomRef()
+	node := network.NewNetworkNodeMock(t)
+	node.PublicKeyMock.Return(nil)
+	nn := network.NewNodeNetworkMock(t)
+	nn.GetWorkingNodeMock.Return(node)
+	nn.GetOriginFunc = mb.NodeNetwork.GetOrigin
+	mb.NodeNetwork = nn
+
+	valid := false
+	cs := testutils.NewCryptographyServiceMock(t)
+	cs.VerifyFunc = func(crypto.PublicKey, insolar.Signature, []byte) bool {
+		return valid
+	}
+	mb.ParcelFactory = &parcelFactory{Cryptography: cs}
+
+	parcel := message.ParcelToBytes(&message.Parcel{
+		Msg:         &message.GetObject{},
+		Sender:      sender,
+		PulseNumber: 100,
+		ServiceData: message.ServiceData{TraceSpanData: instracer.MustSerialize(ctx)},
+	})
+
+	// Forged parcels don't use up sender's limit.
+	for i := 0; i < 3; i++ {
+		_, err := mb.deliver(ctx, [][]byte{parcel})
+		require.Error(t, err)
+	}
+
+	valid = true
+	mb.handlers[insolar.TypeGetObject] = func(context.Context, insolar.Parcel) (insolar.Reply, error) {
+		return &reply.OK{}, nil
+	}
+	res, err := mb.deliver(ctx, [][]byte{parcel})
+	require.NoError(t, err)
+	rep, err := reply.Deserialize(bytes.NewReader(res))
+	require.NoError(t, err)
+	require.Equal(t, &reply.OK{}, rep)
+
+	res, err = mb.deliver(ctx, [][]byte{parcel})
+	require.NoError(t, err)
+	rep, err = reply.Deserialize(bytes.NewReader(res))
+	require.NoError(t, err)
+	require.IsType(t, &reply.Overloaded{}, rep)
+}
+
+func TestMessageBus_doDeliver_FuturePoolFull(t *testing.T) {
+	ctx := context.Background()
+	mb, _, parcel := prepare(t, ctx, 100, 101)
+	mb.futurePoolSize = 1
+	mb.NextPulseMessagePoolCounter = 1
+
+	result, err := mb.doDeliver(ctx, parcel)
+	require.Equal(t, ErrOverloaded, errors.Cause(err))
+	require.Nil(t, result)
+	require.Equal(t, uint32(1), atomic.LoadUint32(&mb.NextPulseMessagePoolCounter))
+}
+
+func TestMessageBus_Send_FollowsRedirect(t *testing.T) {
+	ctx := context.Background()
+	nodeRef := testutils.RandomRef()
+
+	tests := map[string]struct {
+		msg      insolar.Message
+		redirect insolar.Reply
+		token    insolar.DelegationToken
+		result   insolar.Reply
+	}{
+		"GetObject": {
+			msg: &message.GetObject{},
+			redirect: &reply.GetObjectRedirectReply{
+				Receiver: &nodeRef,
+				Token:    &delegationtoken.GetObjectRedirectToken{Signature: []byte{1, 2, 3}},
+			},
+			token:  &delegationtoken.GetObjectRedirectToken{Signature: []byte{1, 2, 3}},
+			result: &reply.Object{},
+		},
+		"GetChildren": {
+			msg: &message.GetChildren{},
+			redirect: &reply.GetChildrenRedirectReply{
+				Receiver: &nodeRef,
+				Token:    &delegationtoken.GetChildrenRedirectToken{Signature: []byte{1, 2, 3}},
+			},
+			token:  &delegationtoken.GetChildrenRedirectToken{Signature: []byte{1, 2, 3}},
+			result: &reply.Children{},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mb, _, _ := prepare(t, ctx, 100, 100)
+			cs := testutils.NewCryptographyServiceMock(t)
+			cs.SignMock.Return(&insolar.Signature{}, nil)
+			mb.ParcelFactory = &parcelFactory{Cryptography: cs}
+			jc := testutils.NewJetCoordinatorMock(t)
+			jc.QueryRoleMock.Return([]insolar.Reference{testutils.RandomRef()}, nil)
+			mb.JetCoordinator = jc
+
+			net := testutils.NewNetworkMock(t)
+			net.SendMessageFunc = func(node insolar.Reference, method string, parcel insolar.Parcel) ([]byte, error) {
+				if parcel.DelegationToken() == nil {
+					return reply.ToBytes(test.redirect), nil
+				}
+				require.Equal(t, test.token, parcel.DelegationToken())
+				require.Equal(t, nodeRef, node)
+				return reply.ToBytes(test.result), nil
+			}
+			mb.Network = net
+
+			redirects := stats.Int64("test/redirects/"+name, "test redirects", stats.UnitDimensionless)
+			v := &view.View{Measure: redirects, Aggregation: view.Count()}
+			require.NoError(t, view.Register(v))
+			defer view.Unregister(v)
+
+			rep, err := mb.Send(ContextWithRedirectMeasure(ctx, redirects), test.msg, nil)
+			require.NoError(t, err)
+			require.Equal(t, test.result, rep)
+
+			rows, err := view.RetrieveData(v.Name)
+			require.NoError(t, err)
+			require.Len(t, rows, 1)
+			require.Equal(t, int64(1), rows[0].Data.(*view.CountData).Value)
+		})
+	}
+}
+
+func TestMessageBus_sendToNode_IncorrectPulse(t *testing.T) {
+	ctx := context.Background()
+	mb, _, parcel := prepare(t, ctx, 100, 100)
+
+	net := testutils.NewNetworkMock(t)
+	net.SendMessageMock.Return(reply.ToBytes(&reply.Error{ErrType: reply.ErrIncorrectPulse}), nil)
+	mb.Network = net
+
+	_, err := mb.sendToNode(ctx, parcel, testutils.RandomRef())
+	require.Equal(t, ErrIncorrectPulse, errors.Cause(err))
+	require.True(t, isIncorrectPulse(err))
+}
@@ -26,6 +26,7 @@ import (
 
 var (
 	tagMessageType = insmetrics.MustTagKey("messageType")
+	tagLimit       = insmetrics.MustTagKey("limit")
 )
 
 var (
@@ -39,6 +40,31 @@ var (
 		"total number of parcels delivered to the same machine",
 		stats.UnitDimensionless,
 	)
+	statRetriesTotal = stats.Int64(
+		"messagebus/retries/count",
+		"number of resent messages",
+		stats.UnitDimensionless,
+	)
+	statRedirectsTotal = stats.Int64(
+		"messagebus/redirects/count",
+		"number of followed redirects",
+		stats.UnitDimensionless,
+	)
+	statParcelsThrottledTotal = stats.Int64(
+		"messagebus/parcels/throttled/count",
+		"number of incoming parcels rejected by rate limits",
+		stats.UnitDimensionless,
+	)
+	statParcelsDroppedTotal = stats.Int64(
+		"messagebus/parcels/dropped/count",
+		"number of incoming parcels from future pulses dropped because of full pool",
+		stats.UnitDimensionless,
+	)
+	statFuturePoolSize = stats.Int64(
+		"messagebus/parcels/future/pool",
+		"number of parcels from future pulses waiting for pulse change",
+		stats.UnitDimensionless,
+	)
 	statParcelsTime = stats.Float64(
 		"messagebus/parcels/time",
 		"time spent on sending parcels",
@@ -58,6 +84,30 @@ func init() {
 			Aggregation: view.Count(),
 			TagKeys:     []tag.Key{tagMessageType},
 		},
+		&view.View{
+			Measure:     statRetriesTotal,
+			Aggregation: view.Sum(),
+			TagKeys:     []tag.Key{tagMessageType},
+		},
+		&view.View{
+			Measure:     statRedirectsTotal,
+			Aggregation: view.Sum(),
+			TagKeys:     []tag.Key{tagMessageType},
+		},
+		&view.View{
+			Measure:     statParcelsThrottledTotal,
+			Aggregation: view.Sum(),
+			TagKeys:     []tag.Key{tagMessageType, tagLimit},
+		},
+		&view.View{
+			Measure:     statParcelsDroppedTotal,
+			Aggregation: view.Sum(),
+			TagKeys:     []tag.Key{tagMessageType},
+		},
+		&view.View{
+			Measure:     statFuturePoolSize,
+			Aggregation: view.LastValue(),
+		},
 		&view.View{
 			Measure:     statParcelsTime,
 			Aggregation: view.Distribution(0.001, 0.01, 0.1, 1, 10, 100, 1000, 5000, 10000, 20000),
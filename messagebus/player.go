@@ -30,6 +30,8 @@ type player struct {
 	tape          tape
 	scheme        insolar.PlatformCryptographyScheme
 	pulseAccessor pulse.Accessor
+	retryPolicies map[insolar.MessageType]RetryPolicy
+	interceptors  *interceptors
 }
 
 // newPlayer creates player instance. It will replay replies from provided tape.
@@ -42,9 +44,24 @@ func newPlayer(s sender, tape tape, scheme insolar.PlatformCryptographyScheme, p
 	}
 }
 
+// CloseTape releases resources held by player's tape.
+func (p *player) CloseTape(ctx context.Context) error {
+	return closeTape(p.tape)
+}
+
 // Send wraps MessageBus Send to reply replies from the tape. If reply for this message is not on the tape, an error
 // will be returned.
+//
+// Resends and redirects are replayed according to message RetryPolicy in the same order recorder saved them.
 func (p *player) Send(ctx context.Context, msg insolar.Message, ops *insolar.MessageSendOptions) (insolar.Reply, error) {
+	return p.interceptors.sender(p.sendWithRetries)(ctx, msg, ops)
+}
+
+func (p *player) sendWithRetries(ctx context.Context, msg insolar.Message, ops *insolar.MessageSendOptions) (insolar.Reply, error) {
+	return p.retryPolicies[msg.Type()].send(ctx, msg, ops, p.send)
+}
+
+func (p *player) send(ctx context.Context, msg insolar.Message, ops *insolar.MessageSendOptions) (insolar.Reply, error) {
 	currentPulse, err := p.pulseAccessor.Latest(ctx)
 	if err != nil {
 		return nil, err
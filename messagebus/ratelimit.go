@@ -0,0 +1,105 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package messagebus
+
+import (
+	"sync"
+	"time"
+)
+
+// tokenBucket is refilled with tokens at a constant rate. Every accepted event takes one token.
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// rateLimiter holds separate token bucket for every key. Nil limiter allows everything.
+type rateLimiter struct {
+	lock    sync.Mutex
+	rate    float64
+	burst   float64
+	now     func() time.Time
+	buckets map[interface{}]*tokenBucket
+}
+
+// newRateLimiter creates limiter that allows rate events per second for every key with bursts up to burst events.
+// Returns nil if rate is not positive.
+func newRateLimiter(rate float64, burst int) *rateLimiter {
+	if rate <= 0 {
+		return nil
+	}
+	b := float64(burst)
+	if b < 1 {
+		b = 1
+	}
+	return &rateLimiter{
+		rate:    rate,
+		burst:   b,
+		now:     time.Now,
+		buckets: map[interface{}]*tokenBucket{},
+	}
+}
+
+// allow takes a token from the key bucket. It returns false if the bucket is empty.
+func (l *rateLimiter) allow(key interface{}) bool {
+	if l == nil {
+		return true
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := l.now()
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &tokenBucket{tokens: l.burst, last: now}
+		l.buckets[key] = b
+	}
+	l.refill(b, now)
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// prune removes full buckets. They are created again on demand, so memory is held only by active keys.
+func (l *rateLimiter) prune() {
+	if l == nil {
+		return
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := l.now()
+	for key, b := range l.buckets {
+		l.refill(b, now)
+		if b.tokens >= l.burst {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+func (l *rateLimiter) refill(b *tokenBucket, now time.Time) {
+	b.tokens += now.Sub(b.last).Seconds() * l.rate
+	if b.tokens > l.burst {
+		b.tokens = l.burst
+	}
+	b.last = now
+}
@@ -0,0 +1,54 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package messagebus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Now()
+	l := newRateLimiter(2, 3)
+	l.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		require.True(t, l.allow("a"))
+	}
+	require.False(t, l.allow("a"))
+	require.True(t, l.allow("b"), "keys have separate buckets")
+
+	now = now.Add(time.Second)
+	require.True(t, l.allow("a"))
+	require.True(t, l.allow("a"))
+	require.False(t, l.allow("a"))
+
+	now = now.Add(time.Hour)
+	l.prune()
+	require.Empty(t, l.buckets)
+}
+
+func TestRateLimiter_Disabled(t *testing.T) {
+	l := newRateLimiter(0, 10)
+	require.Nil(t, l)
+	for i := 0; i < 100; i++ {
+		require.True(t, l.allow("a"))
+	}
+	l.prune()
+}
@@ -31,6 +31,8 @@ type recorder struct {
 	tape          tape
 	scheme        insolar.PlatformCryptographyScheme
 	pulseAccessor pulse.Accessor
+	retryPolicies map[insolar.MessageType]RetryPolicy
+	interceptors  *interceptors
 }
 
 // newRecorder create new recorder instance.
@@ -48,9 +50,24 @@ func (r *recorder) WriteTape(ctx context.Context, w io.Writer) error {
 	return r.tape.Write(ctx, w)
 }
 
+// CloseTape releases resources held by recorder's tape.
+func (r *recorder) CloseTape(ctx context.Context) error {
+	return closeTape(r.tape)
+}
+
 // Send wraps MessageBus Send to save received replies to the tape. This reply is also used to return directly from the
 // tape is the message is sent again, thus providing a cash for message replies.
+//
+// Every resend and redirect made according to message RetryPolicy is saved to the tape as well.
 func (r *recorder) Send(ctx context.Context, msg insolar.Message, ops *insolar.MessageSendOptions) (insolar.Reply, error) {
+	return r.interceptors.sender(r.sendWithRetries)(ctx, msg, ops)
+}
+
+func (r *recorder) sendWithRetries(ctx context.Context, msg insolar.Message, ops *insolar.MessageSendOptions) (insolar.Reply, error) {
+	return r.retryPolicies[msg.Type()].send(ctx, msg, ops, r.send)
+}
+
+func (r *recorder) send(ctx context.Context, msg insolar.Message, ops *insolar.MessageSendOptions) (insolar.Reply, error) {
 	currentPulse, err := r.pulseAccessor.Latest(ctx)
 	if err != nil {
 		return nil, err
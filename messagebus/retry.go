@@ -0,0 +1,152 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package messagebus
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opencensus.io/stats"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/utils/backoff"
+)
+
+// RetryPolicy describes how messages of a particular type are resent.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of sends. Zero means the message is sent once.
+	MaxAttempts int
+	// Backoff provides delays between attempts. Zero value uses backoff defaults.
+	Backoff backoff.Backoff
+	// FollowRedirects resends message to the receiver with the delegation token provided by insolar.RedirectReply.
+	// Only one redirect per attempt is allowed.
+	FollowRedirects bool
+	// RetryIncorrectPulse resends message if the receiver rejected it because of pulse change. Message is created
+	// again for the new pulse.
+	RetryIncorrectPulse bool
+	// RetryOverloaded resends message if the receiver replied with reply.Overloaded.
+	RetryOverloaded bool
+}
+
+// defaultRetryPolicies returns policies for read-only ledger requests which are safe to resend.
+func defaultRetryPolicies() map[insolar.MessageType]RetryPolicy {
+	read := RetryPolicy{
+		MaxAttempts:         3,
+		Backoff:             backoff.Backoff{Min: 100 * time.Millisecond, Max: time.Second, Factor: 2, Jitter: true},
+		RetryIncorrectPulse: true,
+		RetryOverloaded:     true,
+	}
+	redirect := read
+	redirect.FollowRedirects = true
+
+	return map[insolar.MessageType]RetryPolicy{
+		insolar.TypeGetCode:            redirect,
+		insolar.TypeGetObject:          redirect,
+		insolar.TypeGetChildren:        redirect,
+		insolar.TypeGetDelegate:        redirect,
+		insolar.TypeGetObjectIndex:     read,
+		insolar.TypeGetPendingRequests: read,
+
+		insolar.TypeGetObjectsByPrototype: read,
+		insolar.TypeGetObjectsByParent:    read,
+	}
+}
+
+type redirectMeasureKey struct{}
+
+// ContextWithRedirectMeasure returns context in which followed redirects are recorded to provided measure as well.
+// It lets components keep their own redirect metrics while redirects are followed by MessageBus.
+func ContextWithRedirectMeasure(ctx context.Context, m *stats.Int64Measure) context.Context {
+	return context.WithValue(ctx, redirectMeasureKey{}, m)
+}
+
+// send sends message with provided function according to the policy.
+func (p RetryPolicy) send(
+	ctx context.Context,
+	msg insolar.Message,
+	ops *insolar.MessageSendOptions,
+	send insolar.MessageSender,
+) (insolar.Reply, error) {
+	bo := p.Backoff.Copy()
+	for attempt := 1; ; attempt++ {
+		rep, err := p.sendOnce(ctx, msg, ops, send)
+		if !p.retryable(rep, err) || attempt >= p.MaxAttempts {
+			return rep, err
+		}
+
+		stats.Record(ctx, statRetriesTotal.M(1))
+		delay := bo.Duration()
+		inslogger.FromContext(ctx).Debugf(
+			"[ RetryPolicy ] resending %s in %s (attempt %d), reply: %T, error: %v", msg.Type(), delay, attempt+1, rep, err,
+		)
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+func (p RetryPolicy) sendOnce(
+	ctx context.Context,
+	msg insolar.Message,
+	ops *insolar.MessageSendOptions,
+	send insolar.MessageSender,
+) (insolar.Reply, error) {
+	rep, err := send(ctx, msg, ops)
+	if err != nil || !p.FollowRedirects {
+		return rep, err
+	}
+	r, ok := rep.(insolar.RedirectReply)
+	if !ok {
+		return rep, nil
+	}
+
+	stats.Record(ctx, statRedirectsTotal.M(1))
+	if m, ok := ctx.Value(redirectMeasureKey{}).(*stats.Int64Measure); ok {
+		stats.Record(ctx, m.M(1))
+	}
+	inslogger.FromContext(ctx).Debugf("[ RetryPolicy ] redirect receiver=%v", r.GetReceiver())
+	rep, err = send(ctx, r.Redirected(msg), &insolar.MessageSendOptions{
+		Token:    r.GetToken(),
+		Receiver: r.GetReceiver(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := rep.(insolar.RedirectReply); ok {
+		return nil, errors.New("double redirects are forbidden")
+	}
+	return rep, nil
+}
+
+// retryable checks if message should be resent after provided reply or error.
+func (p RetryPolicy) retryable(rep insolar.Reply, err error) bool {
+	if err != nil {
+		return p.RetryIncorrectPulse && isIncorrectPulse(err)
+	}
+	_, overloaded := rep.(*reply.Overloaded)
+	return p.RetryOverloaded && overloaded
+}
+
+// isIncorrectPulse checks if error is caused by receiver rejecting message pulse.
+func isIncorrectPulse(err error) bool {
+	return errors.Cause(err) == ErrIncorrectPulse
+}
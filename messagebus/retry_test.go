@@ -0,0 +1,153 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package messagebus
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/insolar/delegationtoken"
+	"github.com/insolar/insolar/insolar/message"
+	"github.com/insolar/insolar/insolar/reply"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/testutils"
+	"github.com/insolar/insolar/utils/backoff"
+)
+
+func TestRetryPolicy_FollowsRedirect(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	node := testutils.RandomRef()
+	token := &delegationtoken.GetObjectRedirectToken{Signature: []byte{1, 2, 3}}
+
+	calls := 0
+	send := func(ctx context.Context, msg insolar.Message, ops *insolar.MessageSendOptions) (insolar.Reply, error) {
+		calls++
+		if ops.Safe().Receiver == nil {
+			return &reply.GetObjectRedirectReply{Receiver: &node, Token: token}, nil
+		}
+		assert.Equal(t, &node, ops.Receiver)
+		assert.Equal(t, token, ops.Token)
+		return &reply.Object{}, nil
+	}
+
+	rep, err := RetryPolicy{FollowRedirects: true}.send(ctx, &message.GetObject{}, nil, send)
+	require.NoError(t, err)
+	require.Equal(t, &reply.Object{}, rep)
+	require.Equal(t, 2, calls)
+
+	rep, err = RetryPolicy{}.send(ctx, &message.GetObject{}, nil, send)
+	require.NoError(t, err)
+	require.IsType(t, &reply.GetObjectRedirectReply{}, rep)
+}
+
+func TestRetryPolicy_DoubleRedirect(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	send := func(ctx context.Context, msg insolar.Message, ops *insolar.MessageSendOptions) (insolar.Reply, error) {
+		return &reply.GetChildrenRedirectReply{Receiver: &insolar.Reference{}}, nil
+	}
+
+	_, err := RetryPolicy{FollowRedirects: true}.send(ctx, &message.GetChildren{}, nil, send)
+	require.Error(t, err)
+}
+
+func TestRetryPolicy_RetriesIncorrectPulse(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	policy := RetryPolicy{
+		MaxAttempts:         3,
+		Backoff:             backoff.Backoff{Min: time.Millisecond, Max: time.Millisecond},
+		RetryIncorrectPulse: true,
+	}
+
+	t.Run("succeeds after pulse change", func(t *testing.T) {
+		calls := 0
+		send := func(ctx context.Context, msg insolar.Message, ops *insolar.MessageSendOptions) (insolar.Reply, error) {
+			calls++
+			if calls < 3 {
+				return nil, errors.Wrap(ErrIncorrectPulse, "remote")
+			}
+			return &reply.OK{}, nil
+		}
+		rep, err := policy.send(ctx, &message.GetObject{}, nil, send)
+		require.NoError(t, err)
+		require.Equal(t, &reply.OK{}, rep)
+		require.Equal(t, 3, calls)
+	})
+
+	t.Run("stops after max attempts", func(t *testing.T) {
+		calls := 0
+		send := func(ctx context.Context, msg insolar.Message, ops *insolar.MessageSendOptions) (insolar.Reply, error) {
+			calls++
+			return nil, errors.Wrap(ErrIncorrectPulse, "local")
+		}
+		_, err := policy.send(ctx, &message.GetObject{}, nil, send)
+		require.Equal(t, ErrIncorrectPulse, errors.Cause(err))
+		require.Equal(t, 3, calls)
+	})
+
+	t.Run("doesn't retry other errors", func(t *testing.T) {
+		calls := 0
+		send := func(ctx context.Context, msg insolar.Message, ops *insolar.MessageSendOptions) (insolar.Reply, error) {
+			calls++
+			return nil, errors.New("handler failed")
+		}
+		_, err := policy.send(ctx, &message.GetObject{}, nil, send)
+		require.EqualError(t, err, "handler failed")
+		require.Equal(t, 1, calls)
+	})
+
+	t.Run("stops on context cancel", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		send := func(ctx context.Context, msg insolar.Message, ops *insolar.MessageSendOptions) (insolar.Reply, error) {
+			cancel()
+			return nil, ErrIncorrectPulse
+		}
+		slow := policy
+		slow.Backoff = backoff.Backoff{Min: time.Hour, Max: time.Hour}
+		_, err := slow.send(ctx, &message.GetObject{}, nil, send)
+		require.Equal(t, context.Canceled, err)
+	})
+}
+
+func TestRetryPolicy_RetriesOverloaded(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	policy := RetryPolicy{
+		MaxAttempts:     2,
+		Backoff:         backoff.Backoff{Min: time.Millisecond, Max: time.Millisecond},
+		RetryOverloaded: true,
+	}
+
+	calls := 0
+	send := func(ctx context.Context, msg insolar.Message, ops *insolar.MessageSendOptions) (insolar.Reply, error) {
+		calls++
+		return &reply.Overloaded{}, nil
+	}
+	rep, err := policy.send(ctx, &message.GetObject{}, nil, send)
+	require.NoError(t, err)
+	require.IsType(t, &reply.Overloaded{}, rep)
+	require.Equal(t, 2, calls)
+
+	calls = 0
+	_, err = RetryPolicy{MaxAttempts: 2}.send(ctx, &message.GetObject{}, nil, send)
+	require.NoError(t, err)
+	require.Equal(t, 1, calls)
+}
@@ -21,6 +21,16 @@ import (
 type senderMock struct {
 	t minimock.Tester
 
+	AddInboundInterceptorFunc       func(p insolar.InboundInterceptor)
+	AddInboundInterceptorCounter    uint64
+	AddInboundInterceptorPreCounter uint64
+	AddInboundInterceptorMock       msenderMockAddInboundInterceptor
+
+	AddOutboundInterceptorFunc       func(p insolar.OutboundInterceptor)
+	AddOutboundInterceptorCounter    uint64
+	AddOutboundInterceptorPreCounter uint64
+	AddOutboundInterceptorMock       msenderMockAddOutboundInterceptor
+
 	CreateParcelFunc       func(p context.Context, p1 insolar.Message, p2 insolar.DelegationToken, p3 insolar.Pulse) (r insolar.Parcel, r1 error)
 	CreateParcelCounter    uint64
 	CreateParcelPreCounter uint64
@@ -70,6 +80,8 @@ func NewsenderMock(t minimock.Tester) *senderMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.AddInboundInterceptorMock = msenderMockAddInboundInterceptor{mock: m}
+	m.AddOutboundInterceptorMock = msenderMockAddOutboundInterceptor{mock: m}
 	m.CreateParcelMock = msenderMockCreateParcel{mock: m}
 	m.MustRegisterMock = msenderMockMustRegister{mock: m}
 	m.NewPlayerMock = msenderMockNewPlayer{mock: m}
@@ -82,6 +94,252 @@ func NewsenderMock(t minimock.Tester) *senderMock {
 	return m
 }
 
+type msenderMockAddInboundInterceptor struct {
+	mock              *senderMock
+	mainExpectation   *senderMockAddInboundInterceptorExpectation
+	expectationSeries []*senderMockAddInboundInterceptorExpectation
+}
+
+type senderMockAddInboundInterceptorExpectation struct {
+	input *senderMockAddInboundInterceptorInput
+}
+
+type senderMockAddInboundInterceptorInput struct {
+	p insolar.InboundInterceptor
+}
+
+//Expect specifies that invocation of sender.AddInboundInterceptor is expected from 1 to Infinity times
+func (m *msenderMockAddInboundInterceptor) Expect(p insolar.InboundInterceptor) *msenderMockAddInboundInterceptor {
+	m.mock.AddInboundInterceptorFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &senderMockAddInboundInterceptorExpectation{}
+	}
+	m.mainExpectation.input = &senderMockAddInboundInterceptorInput{p}
+	return m
+}
+
+//Return specifies results of invocation of sender.AddInboundInterceptor
+func (m *msenderMockAddInboundInterceptor) Return() *senderMock {
+	m.mock.AddInboundInterceptorFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &senderMockAddInboundInterceptorExpectation{}
+	}
+
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of sender.AddInboundInterceptor is expected once
+func (m *msenderMockAddInboundInterceptor) ExpectOnce(p insolar.InboundInterceptor) *senderMockAddInboundInterceptorExpectation {
+	m.mock.AddInboundInterceptorFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &senderMockAddInboundInterceptorExpectation{}
+	expectation.input = &senderMockAddInboundInterceptorInput{p}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+//Set uses given function f as a mock of sender.AddInboundInterceptor method
+func (m *msenderMockAddInboundInterceptor) Set(f func(p insolar.InboundInterceptor)) *senderMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.AddInboundInterceptorFunc = f
+	return m.mock
+}
+
+//AddInboundInterceptor implements github.com/insolar/insolar/messagebus.sender interface
+func (m *senderMock) AddInboundInterceptor(p insolar.InboundInterceptor) {
+	counter := atomic.AddUint64(&m.AddInboundInterceptorPreCounter, 1)
+	defer atomic.AddUint64(&m.AddInboundInterceptorCounter, 1)
+
+	if len(m.AddInboundInterceptorMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.AddInboundInterceptorMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to senderMock.AddInboundInterceptor. %v", p)
+			return
+		}
+
+		input := m.AddInboundInterceptorMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, senderMockAddInboundInterceptorInput{p}, "sender.AddInboundInterceptor got unexpected parameters")
+
+		return
+	}
+
+	if m.AddInboundInterceptorMock.mainExpectation != nil {
+
+		input := m.AddInboundInterceptorMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, senderMockAddInboundInterceptorInput{p}, "sender.AddInboundInterceptor got unexpected parameters")
+		}
+
+		return
+	}
+
+	if m.AddInboundInterceptorFunc == nil {
+		m.t.Fatalf("Unexpected call to senderMock.AddInboundInterceptor. %v", p)
+		return
+	}
+
+	m.AddInboundInterceptorFunc(p)
+}
+
+//AddInboundInterceptorMinimockCounter returns a count of senderMock.AddInboundInterceptorFunc invocations
+func (m *senderMock) AddInboundInterceptorMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.AddInboundInterceptorCounter)
+}
+
+//AddInboundInterceptorMinimockPreCounter returns the value of senderMock.AddInboundInterceptor invocations
+func (m *senderMock) AddInboundInterceptorMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.AddInboundInterceptorPreCounter)
+}
+
+//AddInboundInterceptorFinished returns true if mock invocations count is ok
+func (m *senderMock) AddInboundInterceptorFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.AddInboundInterceptorMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.AddInboundInterceptorCounter) == uint64(len(m.AddInboundInterceptorMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.AddInboundInterceptorMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.AddInboundInterceptorCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.AddInboundInterceptorFunc != nil {
+		return atomic.LoadUint64(&m.AddInboundInterceptorCounter) > 0
+	}
+
+	return true
+}
+
+type msenderMockAddOutboundInterceptor struct {
+	mock              *senderMock
+	mainExpectation   *senderMockAddOutboundInterceptorExpectation
+	expectationSeries []*senderMockAddOutboundInterceptorExpectation
+}
+
+type senderMockAddOutboundInterceptorExpectation struct {
+	input *senderMockAddOutboundInterceptorInput
+}
+
+type senderMockAddOutboundInterceptorInput struct {
+	p insolar.OutboundInterceptor
+}
+
+//Expect specifies that invocation of sender.AddOutboundInterceptor is expected from 1 to Infinity times
+func (m *msenderMockAddOutboundInterceptor) Expect(p insolar.OutboundInterceptor) *msenderMockAddOutboundInterceptor {
+	m.mock.AddOutboundInterceptorFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &senderMockAddOutboundInterceptorExpectation{}
+	}
+	m.mainExpectation.input = &senderMockAddOutboundInterceptorInput{p}
+	return m
+}
+
+//Return specifies results of invocation of sender.AddOutboundInterceptor
+func (m *msenderMockAddOutboundInterceptor) Return() *senderMock {
+	m.mock.AddOutboundInterceptorFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &senderMockAddOutboundInterceptorExpectation{}
+	}
+
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of sender.AddOutboundInterceptor is expected once
+func (m *msenderMockAddOutboundInterceptor) ExpectOnce(p insolar.OutboundInterceptor) *senderMockAddOutboundInterceptorExpectation {
+	m.mock.AddOutboundInterceptorFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &senderMockAddOutboundInterceptorExpectation{}
+	expectation.input = &senderMockAddOutboundInterceptorInput{p}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+//Set uses given function f as a mock of sender.AddOutboundInterceptor method
+func (m *msenderMockAddOutboundInterceptor) Set(f func(p insolar.OutboundInterceptor)) *senderMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.AddOutboundInterceptorFunc = f
+	return m.mock
+}
+
+//AddOutboundInterceptor implements github.com/insolar/insolar/messagebus.sender interface
+func (m *senderMock) AddOutboundInterceptor(p insolar.OutboundInterceptor) {
+	counter := atomic.AddUint64(&m.AddOutboundInterceptorPreCounter, 1)
+	defer atomic.AddUint64(&m.AddOutboundInterceptorCounter, 1)
+
+	if len(m.AddOutboundInterceptorMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.AddOutboundInterceptorMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to senderMock.AddOutboundInterceptor. %v", p)
+			return
+		}
+
+		input := m.AddOutboundInterceptorMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, senderMockAddOutboundInterceptorInput{p}, "sender.AddOutboundInterceptor got unexpected parameters")
+
+		return
+	}
+
+	if m.AddOutboundInterceptorMock.mainExpectation != nil {
+
+		input := m.AddOutboundInterceptorMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, senderMockAddOutboundInterceptorInput{p}, "sender.AddOutboundInterceptor got unexpected parameters")
+		}
+
+		return
+	}
+
+	if m.AddOutboundInterceptorFunc == nil {
+		m.t.Fatalf("Unexpected call to senderMock.AddOutboundInterceptor. %v", p)
+		return
+	}
+
+	m.AddOutboundInterceptorFunc(p)
+}
+
+//AddOutboundInterceptorMinimockCounter returns a count of senderMock.AddOutboundInterceptorFunc invocations
+func (m *senderMock) AddOutboundInterceptorMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.AddOutboundInterceptorCounter)
+}
+
+//AddOutboundInterceptorMinimockPreCounter returns the value of senderMock.AddOutboundInterceptor invocations
+func (m *senderMock) AddOutboundInterceptorMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.AddOutboundInterceptorPreCounter)
+}
+
+//AddOutboundInterceptorFinished returns true if mock invocations count is ok
+func (m *senderMock) AddOutboundInterceptorFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.AddOutboundInterceptorMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.AddOutboundInterceptorCounter) == uint64(len(m.AddOutboundInterceptorMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.AddOutboundInterceptorMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.AddOutboundInterceptorCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.AddOutboundInterceptorFunc != nil {
+		return atomic.LoadUint64(&m.AddOutboundInterceptorCounter) > 0
+	}
+
+	return true
+}
+
 type msenderMockCreateParcel struct {
 	mock              *senderMock
 	mainExpectation   *senderMockCreateParcelExpectation
@@ -1266,6 +1524,14 @@ func (m *senderMock) SendParcelFinished() bool {
 //Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
 func (m *senderMock) ValidateCallCounters() {
 
+	if !m.AddInboundInterceptorFinished() {
+		m.t.Fatal("Expected call to senderMock.AddInboundInterceptor")
+	}
+
+	if !m.AddOutboundInterceptorFinished() {
+		m.t.Fatal("Expected call to senderMock.AddOutboundInterceptor")
+	}
+
 	if !m.CreateParcelFinished() {
 		m.t.Fatal("Expected call to senderMock.CreateParcel")
 	}
@@ -1315,6 +1581,14 @@ func (m *senderMock) Finish() {
 //MinimockFinish checks that all mocked methods of the interface have been called at least once
 func (m *senderMock) MinimockFinish() {
 
+	if !m.AddInboundInterceptorFinished() {
+		m.t.Fatal("Expected call to senderMock.AddInboundInterceptor")
+	}
+
+	if !m.AddOutboundInterceptorFinished() {
+		m.t.Fatal("Expected call to senderMock.AddOutboundInterceptor")
+	}
+
 	if !m.CreateParcelFinished() {
 		m.t.Fatal("Expected call to senderMock.CreateParcel")
 	}
@@ -1361,6 +1635,8 @@ func (m *senderMock) MinimockWait(timeout time.Duration) {
 	timeoutCh := time.After(timeout)
 	for {
 		ok := true
+		ok = ok && m.AddInboundInterceptorFinished()
+		ok = ok && m.AddOutboundInterceptorFinished()
 		ok = ok && m.CreateParcelFinished()
 		ok = ok && m.MustRegisterFinished()
 		ok = ok && m.NewPlayerFinished()
@@ -1377,6 +1653,14 @@ func (m *senderMock) MinimockWait(timeout time.Duration) {
 		select {
 		case <-timeoutCh:
 
+			if !m.AddInboundInterceptorFinished() {
+				m.t.Error("Expected call to senderMock.AddInboundInterceptor")
+			}
+
+			if !m.AddOutboundInterceptorFinished() {
+				m.t.Error("Expected call to senderMock.AddOutboundInterceptor")
+			}
+
 			if !m.CreateParcelFinished() {
 				m.t.Error("Expected call to senderMock.CreateParcel")
 			}
@@ -1421,6 +1705,14 @@ func (m *senderMock) MinimockWait(timeout time.Duration) {
 //it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
 func (m *senderMock) AllMocksCalled() bool {
 
+	if !m.AddInboundInterceptorFinished() {
+		return false
+	}
+
+	if !m.AddOutboundInterceptorFinished() {
+		return false
+	}
+
 	if !m.CreateParcelFinished() {
 		return false
 	}
@@ -17,10 +17,13 @@
 package messagebus
 
 import (
+	"bufio"
 	"bytes"
 	"context"
-	"fmt"
+	"encoding/gob"
 	"io"
+	"io/ioutil"
+	"os"
 
 	"github.com/pkg/errors"
 	"github.com/ugorji/go/codec"
@@ -45,6 +48,88 @@ type TapeItem struct {
 	Error error
 }
 
+// newTapeFromReader reads tape of any kind from the stream. File tapes are opened in place when reader supports random
+// access and spooled to a temporary file in dir otherwise, so they are never loaded into memory.
+func newTapeFromReader(ctx context.Context, r io.Reader, dir string) (tape, error) {
+	if ra, ok := r.(io.ReaderAt); ok {
+		magic := make([]byte, len(fileTapeMagic))
+		if n, _ := ra.ReadAt(magic, 0); n == len(magic) && bytes.Equal(magic, fileTapeMagic) {
+			return openFileTape(ctx, ra)
+		}
+		return newMemoryTapeFromReader(ctx, r)
+	}
+
+	br := bufio.NewReader(r)
+	magic, _ := br.Peek(len(fileTapeMagic))
+	if !bytes.Equal(magic, fileTapeMagic) {
+		return newMemoryTapeFromReader(ctx, br)
+	}
+
+	f, err := createTapeFile(dir)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(f, br); err != nil {
+		_ = f.Close()
+		return nil, errors.Wrap(err, "[ newTapeFromReader ] can't spool tape")
+	}
+	t, err := openFileTape(ctx, f)
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	t.closer = f
+	return t, nil
+}
+
+// createTapeFile creates temporary file for a tape in dir. The file is unlinked right away and lives until
+// the descriptor is closed, so it is removed even if the process crashes.
+func createTapeFile(dir string) (*os.File, error) {
+	f, err := ioutil.TempFile(dir, "insolar-tape")
+	if err != nil {
+		return nil, errors.Wrap(err, "[ createTapeFile ] can't create temporary file")
+	}
+	if err := os.Remove(f.Name()); err != nil {
+		_ = f.Close()
+		return nil, errors.Wrap(err, "[ createTapeFile ] can't unlink temporary file")
+	}
+	return f, nil
+}
+
+// closeTape releases resources held by the tape. Only tapes backed by temporary files hold any.
+func closeTape(t tape) error {
+	if c, ok := t.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+// encodeTapeError serializes error preserving its type. Errors of types not registered in gob are saved as
+// serializableError with the same message.
+func encodeTapeError(err error) []byte {
+	var buf bytes.Buffer
+	if gob.NewEncoder(&buf).Encode(&err) == nil {
+		return buf.Bytes()
+	}
+
+	buf.Reset()
+	var serializable error = &serializableError{S: err.Error()}
+	if gob.NewEncoder(&buf).Encode(&serializable) != nil {
+		return []byte(err.Error())
+	}
+	return buf.Bytes()
+}
+
+// decodeTapeError restores error saved by encodeTapeError. Tapes written before error types were preserved contain
+// plain error messages.
+func decodeTapeError(b []byte) error {
+	var err error
+	if gob.NewDecoder(bytes.NewReader(b)).Decode(&err) == nil && err != nil {
+		return err
+	}
+	return errors.New(string(b))
+}
+
 // memoryTape saves and fetches message reply/error pairs to/from memory array.
 //
 // It uses <storageTape id> + <message hash> for Value keys.
@@ -95,7 +180,7 @@ func newMemoryTapeFromReader(ctx context.Context, r io.Reader) (*memoryTape, err
 			item.Reply = rep
 		}
 		if blob.ErrorB != nil {
-			item.Error = fmt.Errorf(string(blob.ErrorB))
+			item.Error = decodeTapeError(blob.ErrorB)
 		}
 		storage = append(storage, memoryTapeMessage{
 			MsgHash: blob.MsgHash,
@@ -127,8 +212,7 @@ func (t *memoryTape) Write(ctx context.Context, w io.Writer) error {
 			blob.ReplyB = reply.ToBytes(record.Item.Reply)
 		}
 		if record.Item.Error != nil {
-			// TODO: preserve error type (use gob?) - 24.Dec.2018 - @nordicdyno
-			blob.ErrorB = []byte(record.Item.Error.Error())
+			blob.ErrorB = encodeTapeError(record.Item.Error)
 		}
 		storageBlobs = append(storageBlobs, blob)
 	}
@@ -17,8 +17,13 @@
 package messagebus
 
 import (
+	"bufio"
 	"bytes"
+	"encoding/gob"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
 	"testing"
 
 	"github.com/insolar/insolar/platformpolicy"
@@ -132,3 +137,178 @@ func TestTape_Write(t *testing.T) {
 		// fmt.Printf("gotItem => %+v\n", gotItem)
 	}
 }
+
+type testTapeError struct {
+	Code int
+}
+
+func (e *testTapeError) Error() string {
+	return fmt.Sprintf("test error %d", e.Code)
+}
+
+func init() {
+	gob.Register(&testTapeError{})
+}
+
+func TestTape_Write_PreservesErrorType(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	tp := newMemoryTape(insolar.FirstPulseNumber)
+
+	err := tp.Set(ctx, []byte{1}, nil, &testTapeError{Code: 42})
+	require.NoError(t, err)
+	err = tp.Set(ctx, []byte{2}, nil, errors.New("unregistered"))
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	err = tp.Write(ctx, &buf)
+	require.NoError(t, err)
+	rTape, err := newMemoryTapeFromReader(ctx, &buf)
+	require.NoError(t, err)
+
+	item, err := rTape.Get(ctx, []byte{1})
+	require.NoError(t, err)
+	assert.Equal(t, &testTapeError{Code: 42}, item.Error)
+
+	item, err = rTape.Get(ctx, []byte{2})
+	require.NoError(t, err)
+	assert.Equal(t, &serializableError{S: "unregistered"}, item.Error)
+}
+
+func TestFileTape_SetGet(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	f, err := ioutil.TempFile("", "tape")
+	require.NoError(t, err)
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	tp, err := newFileTape(f, insolar.FirstPulseNumber)
+	require.NoError(t, err)
+
+	rep := &reply.Object{Memory: []byte{9, 9, 9}}
+	err = tp.Set(ctx, []byte{4, 5, 6}, rep, nil)
+	require.NoError(t, err)
+	err = tp.Set(ctx, []byte{4, 5, 7}, nil, &testTapeError{Code: 1})
+	require.NoError(t, err)
+	err = tp.Set(ctx, []byte{4, 5, 6}, nil, &testTapeError{Code: 2})
+	require.NoError(t, err)
+
+	item, err := tp.Get(ctx, []byte{4, 5, 7})
+	require.NoError(t, err)
+	assert.Nil(t, item.Reply)
+	assert.Equal(t, &testTapeError{Code: 1}, item.Error)
+
+	item, err = tp.Get(ctx, []byte{4, 5, 6})
+	require.NoError(t, err)
+	assert.Equal(t, rep, item.Reply)
+	assert.Nil(t, item.Error)
+
+	item, err = tp.Get(ctx, []byte{4, 5, 6})
+	require.NoError(t, err)
+	assert.Equal(t, &testTapeError{Code: 2}, item.Error)
+
+	_, err = tp.Get(ctx, []byte{4, 5, 6})
+	assert.Equal(t, ErrNoReply, err)
+}
+
+func TestFileTape_Write(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	pn := insolar.PulseNumber(insolar.FirstPulseNumber + 1000)
+	f, err := ioutil.TempFile("", "tape")
+	require.NoError(t, err)
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	tp, err := newFileTape(f, pn)
+	require.NoError(t, err)
+	for i := byte(0); i < 100; i++ {
+		err = tp.Set(ctx, []byte{i}, &reply.Object{Memory: []byte{i}}, nil)
+		require.NoError(t, err)
+	}
+
+	var buf bytes.Buffer
+	err = tp.Write(ctx, &buf)
+	require.NoError(t, err)
+
+	readers := map[string]io.Reader{
+		"random access": bytes.NewReader(buf.Bytes()),
+		"stream":        bufio.NewReader(bytes.NewReader(buf.Bytes())),
+		"file":          f,
+	}
+	for name, r := range readers {
+		t.Run(name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "tapes")
+			require.NoError(t, err)
+			defer os.RemoveAll(dir)
+
+			rTape, err := newTapeFromReader(ctx, r, dir)
+			require.NoError(t, err)
+			require.IsType(t, &fileTape{}, rTape)
+			defer func() {
+				require.NoError(t, closeTape(rTape))
+			}()
+
+			spooled, err := ioutil.ReadDir(dir)
+			require.NoError(t, err)
+			assert.Empty(t, spooled, "spooled tape file should be unlinked")
+			assert.Equal(t, pn, rTape.(*fileTape).pulse)
+
+			for i := byte(99); i < 100; i-- {
+				item, err := rTape.Get(ctx, []byte{i})
+				require.NoError(t, err)
+				assert.Equal(t, &reply.Object{Memory: []byte{i}}, item.Reply)
+			}
+
+			err = rTape.Set(ctx, []byte{1}, nil, nil)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestNewTapeFromReader_MemoryTape(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	tp := newMemoryTape(insolar.FirstPulseNumber)
+	err := tp.Set(ctx, []byte{1}, &reply.OK{}, nil)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	err = tp.Write(ctx, &buf)
+	require.NoError(t, err)
+
+	rTape, err := newTapeFromReader(ctx, &buf, "")
+	require.NoError(t, err)
+	require.IsType(t, &memoryTape{}, rTape)
+	item, err := rTape.Get(ctx, []byte{1})
+	require.NoError(t, err)
+	assert.Equal(t, &reply.OK{}, item.Reply)
+}
+
+func TestMessageBus_NewRecorder_FileTape(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	dir, err := ioutil.TempDir("", "tapes")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	mb, _, _ := prepare(t, ctx, 100, 100)
+	mb.tapeDirectory = dir
+
+	rec, err := mb.NewRecorder(ctx, insolar.Pulse{PulseNumber: insolar.FirstPulseNumber})
+	require.NoError(t, err)
+	tp, ok := rec.(*recorder).tape.(*fileTape)
+	require.True(t, ok, "recorder should use file tape")
+	err = tp.Set(ctx, []byte{1}, &reply.OK{}, nil)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	err = rec.(insolar.TapeWriter).WriteTape(ctx, &buf)
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(buf.Bytes(), fileTapeMagic))
+
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, files, "tape file should be unlinked")
+
+	err = rec.(insolar.TapeCloser).CloseTape(ctx)
+	require.NoError(t, err)
+	err = rec.(insolar.TapeWriter).WriteTape(ctx, &buf)
+	assert.Error(t, err, "closed tape can't be written")
+}
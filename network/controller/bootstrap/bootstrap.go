@@ -143,13 +143,18 @@ func (bc *bootstrapper) setRequest(ref insolar.Reference, req *GenesisRequest) {
 	bc.genesisRequestsReceived[ref] = req
 }
 
-type NodeBootstrapRequest struct{}
+type NodeBootstrapRequest struct {
+	// ProtocolVersion is the latest wire format supported by joining node
+	ProtocolVersion network.ProtocolVersion
+}
 
 type NodeBootstrapResponse struct {
 	Code         Code
 	RedirectHost string
 	RejectReason string
 	NetworkSize  int
+	// ProtocolVersion is the wire format both nodes use after bootstrap
+	ProtocolVersion network.ProtocolVersion
 	// FirstPulseTimeUnix int64
 }
 
@@ -538,7 +543,9 @@ func (bc *bootstrapper) startBootstrap(ctx context.Context, address string) (*ne
 	if err != nil {
 		return nil, errors.Wrapf(err, "Failed to ping address %s", address)
 	}
-	request := bc.Transport.NewRequestBuilder().Type(types.Bootstrap).Data(&NodeBootstrapRequest{}).Build()
+	request := bc.Transport.NewRequestBuilder().Type(types.Bootstrap).Data(&NodeBootstrapRequest{
+		ProtocolVersion: network.ProtocolCurrent,
+	}).Build()
 	future, err := bc.Transport.SendRequestPacket(ctx, request, bootstrapHost)
 	if err != nil {
 		return nil, errors.Wrapf(err, "Failed to send bootstrap request to address %s", address)
@@ -548,6 +555,7 @@ func (bc *bootstrapper) startBootstrap(ctx context.Context, address string) (*ne
 		return nil, errors.Wrapf(err, "Failed to get response to bootstrap request from address %s", address)
 	}
 	data := response.GetData().(*NodeBootstrapResponse)
+	bc.Transport.SetProtocolVersion(bootstrapHost.Address.String(), negotiateProtocolVersion(data.ProtocolVersion))
 	switch data.Code {
 	case Rejected:
 		return nil, errors.New("Rejected: " + data.RejectReason)
@@ -604,14 +612,27 @@ func (bc *bootstrapper) processBootstrap(ctx context.Context, request network.Re
 	} else {
 		code = Accepted
 	}
+	data := request.GetData().(*NodeBootstrapRequest)
+	version := negotiateProtocolVersion(data.ProtocolVersion)
+	bc.Transport.SetProtocolVersion(request.GetSenderHost().Address.String(), version)
 	return bc.Transport.BuildResponse(ctx, request,
 		&NodeBootstrapResponse{
-			Code:        code,
-			NetworkSize: len(bc.NodeKeeper.GetAccessor().GetActiveNodes()),
+			Code:            code,
+			NetworkSize:     len(bc.NodeKeeper.GetAccessor().GetActiveNodes()),
+			ProtocolVersion: version,
 			// FirstPulseTimeUnix: bc.firstPulseTime.Unix(),
 		}), nil
 }
 
+// negotiateProtocolVersion returns the latest wire format supported by both nodes.
+// Nodes without protocol version in bootstrap packets support legacy format only.
+func negotiateProtocolVersion(remote network.ProtocolVersion) network.ProtocolVersion {
+	if remote < network.ProtocolCurrent {
+		return remote
+	}
+	return network.ProtocolCurrent
+}
+
 func (bc *bootstrapper) processGenesis(ctx context.Context, request network.Request) (network.Response, error) {
 	data := request.GetData().(*GenesisRequest)
 	discovery, err := newNodeStruct(bc.NodeKeeper.GetOrigin())
@@ -0,0 +1,382 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+
+package bootstrap
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/consensus/packets"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/network"
+	"github.com/insolar/insolar/network/hostnetwork/packet"
+)
+
+// Marshal implements packet.Payload.
+func (r *ChallengeRequest) Marshal() ([]byte, error) {
+	return (&packet.PayloadChallengeRequest{SessionID: uint64(r.SessionID), Nonce: r.Nonce}).Marshal()
+}
+
+// Unmarshal implements packet.Payload.
+func (r *ChallengeRequest) Unmarshal(data []byte) error {
+	wire := packet.PayloadChallengeRequest{}
+	if err := wire.Unmarshal(data); err != nil {
+		return err
+	}
+	r.SessionID, r.Nonce = SessionID(wire.SessionID), wire.Nonce
+	return nil
+}
+
+// Marshal implements packet.Payload.
+func (r *SignedChallengeResponse) Marshal() ([]byte, error) {
+	wire := &packet.PayloadSignedChallengeResponse{Success: r.Header.Success, Error: r.Header.Error}
+	if r.Payload != nil {
+		wire.HasPayload = true
+		wire.SignedNonce = r.Payload.SignedNonce
+		wire.XorDiscoveryNonce = r.Payload.XorDiscoveryNonce
+		wire.DiscoveryNonce = r.Payload.DiscoveryNonce
+	}
+	return wire.Marshal()
+}
+
+// Unmarshal implements packet.Payload.
+func (r *SignedChallengeResponse) Unmarshal(data []byte) error {
+	wire := packet.PayloadSignedChallengeResponse{}
+	if err := wire.Unmarshal(data); err != nil {
+		return err
+	}
+	r.Header = ChallengeResponseHeader{Success: wire.Success, Error: wire.Error}
+	r.Payload = nil
+	if wire.HasPayload {
+		r.Payload = &SignedChallengePayload{
+			SignedNonce:       wire.SignedNonce,
+			XorDiscoveryNonce: wire.XorDiscoveryNonce,
+			DiscoveryNonce:    wire.DiscoveryNonce,
+		}
+	}
+	return nil
+}
+
+// Marshal implements packet.Payload.
+func (r *SignedChallengeRequest) Marshal() ([]byte, error) {
+	return (&packet.PayloadSignedChallengeRequest{
+		SessionID:            uint64(r.SessionID),
+		SignedDiscoveryNonce: r.SignedDiscoveryNonce,
+		XorNonce:             r.XorNonce,
+	}).Marshal()
+}
+
+// Unmarshal implements packet.Payload.
+func (r *SignedChallengeRequest) Unmarshal(data []byte) error {
+	wire := packet.PayloadSignedChallengeRequest{}
+	if err := wire.Unmarshal(data); err != nil {
+		return err
+	}
+	r.SessionID, r.SignedDiscoveryNonce, r.XorNonce = SessionID(wire.SessionID), wire.SignedDiscoveryNonce, wire.XorNonce
+	return nil
+}
+
+// Marshal implements packet.Payload.
+func (r *ChallengeResponse) Marshal() ([]byte, error) {
+	wire := &packet.PayloadChallengeResponse{Success: r.Header.Success, Error: r.Header.Error}
+	if r.Payload != nil {
+		wire.HasPayload = true
+		wire.AssignShortID = uint32(r.Payload.AssignShortID)
+	}
+	return wire.Marshal()
+}
+
+// Unmarshal implements packet.Payload.
+func (r *ChallengeResponse) Unmarshal(data []byte) error {
+	wire := packet.PayloadChallengeResponse{}
+	if err := wire.Unmarshal(data); err != nil {
+		return err
+	}
+	r.Header = ChallengeResponseHeader{Success: wire.Success, Error: wire.Error}
+	r.Payload = nil
+	if wire.HasPayload {
+		r.Payload = &ChallengePayload{AssignShortID: insolar.ShortNodeID(wire.AssignShortID)}
+	}
+	return nil
+}
+
+// Marshal implements packet.Payload.
+func (r *NodeBootstrapRequest) Marshal() ([]byte, error) {
+	return (&packet.PayloadNodeBootstrapRequest{ProtocolVersion: uint32(r.ProtocolVersion)}).Marshal()
+}
+
+// Unmarshal implements packet.Payload.
+func (r *NodeBootstrapRequest) Unmarshal(data []byte) error {
+	wire := packet.PayloadNodeBootstrapRequest{}
+	if err := wire.Unmarshal(data); err != nil {
+		return err
+	}
+	r.ProtocolVersion = network.ProtocolVersion(wire.ProtocolVersion)
+	return nil
+}
+
+// Marshal implements packet.Payload.
+func (r *NodeBootstrapResponse) Marshal() ([]byte, error) {
+	return (&packet.PayloadNodeBootstrapResponse{
+		Code:            uint32(r.Code),
+		RedirectHost:    r.RedirectHost,
+		RejectReason:    r.RejectReason,
+		NetworkSize:     int64(r.NetworkSize),
+		ProtocolVersion: uint32(r.ProtocolVersion),
+	}).Marshal()
+}
+
+// Unmarshal implements packet.Payload.
+func (r *NodeBootstrapResponse) Unmarshal(data []byte) error {
+	wire := packet.PayloadNodeBootstrapResponse{}
+	if err := wire.Unmarshal(data); err != nil {
+		return err
+	}
+	*r = NodeBootstrapResponse{
+		Code:            Code(wire.Code),
+		RedirectHost:    wire.RedirectHost,
+		RejectReason:    wire.RejectReason,
+		NetworkSize:     int(wire.NetworkSize),
+		ProtocolVersion: network.ProtocolVersion(wire.ProtocolVersion),
+	}
+	return nil
+}
+
+func (r *GenesisRequest) wire() *packet.PayloadGenesisRequest {
+	wire := &packet.PayloadGenesisRequest{LastPulse: uint32(r.LastPulse)}
+	if r.Discovery != nil {
+		wire.Discovery = &packet.PayloadNode{
+			ID:      r.Discovery.ID.Bytes(),
+			SID:     uint32(r.Discovery.SID),
+			Role:    uint32(r.Discovery.Role),
+			PK:      r.Discovery.PK,
+			Address: r.Discovery.Address,
+			Version: r.Discovery.Version,
+		}
+	}
+	return wire
+}
+
+func (r *GenesisRequest) fromWire(wire *packet.PayloadGenesisRequest) error {
+	*r = GenesisRequest{}
+	if wire == nil {
+		return nil
+	}
+	r.LastPulse = insolar.PulseNumber(wire.LastPulse)
+	if wire.Discovery != nil {
+		r.Discovery = &NodeStruct{
+			SID:     insolar.ShortNodeID(wire.Discovery.SID),
+			Role:    insolar.StaticRole(wire.Discovery.Role),
+			PK:      wire.Discovery.PK,
+			Address: wire.Discovery.Address,
+			Version: wire.Discovery.Version,
+		}
+		if err := r.Discovery.ID.Unmarshal(wire.Discovery.ID); err != nil {
+			return errors.Wrap(err, "invalid discovery node id")
+		}
+	}
+	return nil
+}
+
+// Marshal implements packet.Payload.
+func (r *GenesisRequest) Marshal() ([]byte, error) {
+	return r.wire().Marshal()
+}
+
+// Unmarshal implements packet.Payload.
+func (r *GenesisRequest) Unmarshal(data []byte) error {
+	wire := packet.PayloadGenesisRequest{}
+	if err := wire.Unmarshal(data); err != nil {
+		return err
+	}
+	return r.fromWire(&wire)
+}
+
+// Marshal implements packet.Payload.
+func (r *GenesisResponse) Marshal() ([]byte, error) {
+	return (&packet.PayloadGenesisResponse{Response: r.Response.wire(), Error: r.Error}).Marshal()
+}
+
+// Unmarshal implements packet.Payload.
+func (r *GenesisResponse) Unmarshal(data []byte) error {
+	wire := packet.PayloadGenesisResponse{}
+	if err := wire.Unmarshal(data); err != nil {
+		return err
+	}
+	r.Error = wire.Error
+	return r.Response.fromWire(wire.Response)
+}
+
+// Marshal implements packet.Payload.
+func (r *StartSessionRequest) Marshal() ([]byte, error) {
+	return (&packet.PayloadStartSessionRequest{}).Marshal()
+}
+
+// Unmarshal implements packet.Payload.
+func (r *StartSessionRequest) Unmarshal(data []byte) error {
+	return (&packet.PayloadStartSessionRequest{}).Unmarshal(data)
+}
+
+// Marshal implements packet.Payload.
+func (r *StartSessionResponse) Marshal() ([]byte, error) {
+	return (&packet.PayloadStartSessionResponse{SessionID: uint64(r.SessionID)}).Marshal()
+}
+
+// Unmarshal implements packet.Payload.
+func (r *StartSessionResponse) Unmarshal(data []byte) error {
+	wire := packet.PayloadStartSessionResponse{}
+	if err := wire.Unmarshal(data); err != nil {
+		return err
+	}
+	r.SessionID = SessionID(wire.SessionID)
+	return nil
+}
+
+// Marshal implements packet.Payload.
+func (r *AuthorizationRequest) Marshal() ([]byte, error) {
+	return (&packet.PayloadAuthorizationRequest{Certificate: r.Certificate}).Marshal()
+}
+
+// Unmarshal implements packet.Payload.
+func (r *AuthorizationRequest) Unmarshal(data []byte) error {
+	wire := packet.PayloadAuthorizationRequest{}
+	if err := wire.Unmarshal(data); err != nil {
+		return err
+	}
+	r.Certificate = wire.Certificate
+	return nil
+}
+
+// Marshal implements packet.Payload.
+func (r *AuthorizationResponse) Marshal() ([]byte, error) {
+	return (&packet.PayloadAuthorizationResponse{
+		Code:      uint32(r.Code),
+		Error:     r.Error,
+		SessionID: uint64(r.SessionID),
+	}).Marshal()
+}
+
+// Unmarshal implements packet.Payload.
+func (r *AuthorizationResponse) Unmarshal(data []byte) error {
+	wire := packet.PayloadAuthorizationResponse{}
+	if err := wire.Unmarshal(data); err != nil {
+		return err
+	}
+	r.Code, r.Error, r.SessionID = OperationCode(wire.Code), wire.Error, SessionID(wire.SessionID)
+	return nil
+}
+
+// Marshal implements packet.Payload.
+func (r *RegistrationRequest) Marshal() ([]byte, error) {
+	wire := &packet.PayloadRegistrationRequest{SessionID: uint64(r.SessionID), Version: r.Version}
+	if r.JoinClaim != nil {
+		claim, err := r.JoinClaim.Serialize()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to serialize join claim")
+		}
+		wire.JoinClaim = claim
+	}
+	return wire.Marshal()
+}
+
+// Unmarshal implements packet.Payload.
+func (r *RegistrationRequest) Unmarshal(data []byte) error {
+	wire := packet.PayloadRegistrationRequest{}
+	if err := wire.Unmarshal(data); err != nil {
+		return err
+	}
+	r.SessionID, r.Version, r.JoinClaim = SessionID(wire.SessionID), wire.Version, nil
+	if len(wire.JoinClaim) != 0 {
+		r.JoinClaim = &packets.NodeJoinClaim{}
+		if err := r.JoinClaim.Deserialize(bytes.NewReader(wire.JoinClaim)); err != nil {
+			return errors.Wrap(err, "failed to deserialize join claim")
+		}
+	}
+	return nil
+}
+
+// Marshal implements packet.Payload.
+func (r *RegistrationResponse) Marshal() ([]byte, error) {
+	return (&packet.PayloadRegistrationResponse{
+		Code:    uint32(r.Code),
+		RetryIn: int64(r.RetryIn),
+		Error:   r.Error,
+	}).Marshal()
+}
+
+// Unmarshal implements packet.Payload.
+func (r *RegistrationResponse) Unmarshal(data []byte) error {
+	wire := packet.PayloadRegistrationResponse{}
+	if err := wire.Unmarshal(data); err != nil {
+		return err
+	}
+	r.Code, r.RetryIn, r.Error = OperationCode(wire.Code), time.Duration(wire.RetryIn), wire.Error
+	return nil
+}
+
+func init() {
+	packet.RegisterPayload(packet.PayloadTypeChallengeRequest, func() packet.Payload { return &ChallengeRequest{} })
+	packet.RegisterPayload(packet.PayloadTypeSignedChallengeResponse, func() packet.Payload { return &SignedChallengeResponse{} })
+	packet.RegisterPayload(packet.PayloadTypeSignedChallengeRequest, func() packet.Payload { return &SignedChallengeRequest{} })
+	packet.RegisterPayload(packet.PayloadTypeChallengeResponse, func() packet.Payload { return &ChallengeResponse{} })
+	packet.RegisterPayload(packet.PayloadTypeNodeBootstrapRequest, func() packet.Payload { return &NodeBootstrapRequest{} })
+	packet.RegisterPayload(packet.PayloadTypeNodeBootstrapResponse, func() packet.Payload { return &NodeBootstrapResponse{} })
+	packet.RegisterPayload(packet.PayloadTypeGenesisRequest, func() packet.Payload { return &GenesisRequest{} })
+	packet.RegisterPayload(packet.PayloadTypeGenesisResponse, func() packet.Payload { return &GenesisResponse{} })
+	packet.RegisterPayload(packet.PayloadTypeStartSessionRequest, func() packet.Payload { return &StartSessionRequest{} })
+	packet.RegisterPayload(packet.PayloadTypeStartSessionResponse, func() packet.Payload { return &StartSessionResponse{} })
+	packet.RegisterPayload(packet.PayloadTypeAuthorizationRequest, func() packet.Payload { return &AuthorizationRequest{} })
+	packet.RegisterPayload(packet.PayloadTypeAuthorizationResponse, func() packet.Payload { return &AuthorizationResponse{} })
+	packet.RegisterPayload(packet.PayloadTypeRegistrationRequest, func() packet.Payload { return &RegistrationRequest{} })
+	packet.RegisterPayload(packet.PayloadTypeRegistrationResponse, func() packet.Payload { return &RegistrationResponse{} })
+}
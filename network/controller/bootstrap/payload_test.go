@@ -0,0 +1,107 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+
+package bootstrap
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/consensus/packets"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/network"
+	"github.com/insolar/insolar/network/hostnetwork/host"
+	"github.com/insolar/insolar/network/hostnetwork/packet"
+	"github.com/insolar/insolar/network/hostnetwork/packet/types"
+	"github.com/insolar/insolar/testutils"
+)
+
+func TestPayloads_Protobuf(t *testing.T) {
+	sender, err := host.NewHostN("127.0.0.1:31337", testutils.RandomRef())
+	require.NoError(t, err)
+
+	claim := &packets.NodeJoinClaim{ShortNodeID: 42, JoinsAfter: 3, NodeRef: testutils.RandomRef()}
+	claim.Signature[0] = 1
+
+	payloads := []interface{}{
+		&ChallengeRequest{SessionID: 1, Nonce: Nonce{1, 2}},
+		&SignedChallengeResponse{Header: ChallengeResponseHeader{Success: true}, Payload: &SignedChallengePayload{
+			SignedNonce: SignedNonce{1}, XorDiscoveryNonce: Nonce{2}, DiscoveryNonce: Nonce{3},
+		}},
+		&SignedChallengeResponse{Header: ChallengeResponseHeader{Error: "failed"}},
+		&SignedChallengeRequest{SessionID: 2, SignedDiscoveryNonce: SignedNonce{4}, XorNonce: Nonce{5}},
+		&ChallengeResponse{Header: ChallengeResponseHeader{Success: true}, Payload: &ChallengePayload{AssignShortID: 7}},
+		&NodeBootstrapRequest{ProtocolVersion: network.ProtocolCurrent},
+		&NodeBootstrapResponse{Code: Redirected, RedirectHost: "127.0.0.1:1", NetworkSize: 5, ProtocolVersion: 1},
+		&GenesisRequest{LastPulse: insolar.FirstPulseNumber, Discovery: &NodeStruct{
+			ID: testutils.RandomRef(), SID: 3, Role: insolar.StaticRoleVirtual, PK: []byte{1}, Address: "127.0.0.1:2", Version: "v1",
+		}},
+		&GenesisResponse{Response: GenesisRequest{LastPulse: 10}, Error: "error"},
+		&StartSessionRequest{},
+		&StartSessionResponse{SessionID: 3},
+		&AuthorizationRequest{Certificate: []byte("certificate")},
+		&AuthorizationResponse{Code: OpConfirmed, SessionID: 4},
+		&RegistrationRequest{SessionID: 5, Version: "v1", JoinClaim: claim},
+		&RegistrationResponse{Code: OpRetry, RetryIn: time.Second, Error: "retry"},
+	}
+
+	for _, payload := range payloads {
+		p := packet.NewBuilder(sender).Receiver(sender).Type(types.Bootstrap).Request(payload).Build()
+		data, err := packet.SerializePacketVersion(p, network.ProtocolProtobuf)
+		require.NoError(t, err, "%T", payload)
+
+		deserialized, err := packet.DeserializePacket(bytes.NewReader(data))
+		require.NoError(t, err, "%T", payload)
+		require.Equal(t, payload, deserialized.Data)
+	}
+}
@@ -0,0 +1,160 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+
+package controller
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/network/hostnetwork/packet"
+)
+
+func (r *RequestRPC) wire() *packet.PayloadRequestRPC {
+	return &packet.PayloadRequestRPC{Method: r.Method, Data: r.Data}
+}
+
+func (r *RequestRPC) fromWire(wire *packet.PayloadRequestRPC) {
+	if wire == nil {
+		*r = RequestRPC{}
+		return
+	}
+	r.Method, r.Data = wire.Method, wire.Data
+}
+
+// Marshal implements packet.Payload.
+func (r *RequestRPC) Marshal() ([]byte, error) {
+	return r.wire().Marshal()
+}
+
+// Unmarshal implements packet.Payload.
+func (r *RequestRPC) Unmarshal(data []byte) error {
+	wire := packet.PayloadRequestRPC{}
+	if err := wire.Unmarshal(data); err != nil {
+		return err
+	}
+	r.fromWire(&wire)
+	return nil
+}
+
+// Marshal implements packet.Payload.
+func (r *ResponseRPC) Marshal() ([]byte, error) {
+	return (&packet.PayloadResponseRPC{Success: r.Success, Result: r.Result, Error: r.Error}).Marshal()
+}
+
+// Unmarshal implements packet.Payload.
+func (r *ResponseRPC) Unmarshal(data []byte) error {
+	wire := packet.PayloadResponseRPC{}
+	if err := wire.Unmarshal(data); err != nil {
+		return err
+	}
+	r.Success, r.Result, r.Error = wire.Success, wire.Result, wire.Error
+	return nil
+}
+
+// Marshal implements packet.Payload.
+func (r *RequestCascade) Marshal() ([]byte, error) {
+	wire := &packet.PayloadRequestCascade{
+		TraceID:           r.TraceID,
+		RPC:               r.RPC.wire(),
+		Entropy:           r.Cascade.Entropy[:],
+		ReplicationFactor: uint64(r.Cascade.ReplicationFactor),
+	}
+	for _, id := range r.Cascade.NodeIds {
+		wire.NodeIds = append(wire.NodeIds, id.Bytes())
+	}
+	return wire.Marshal()
+}
+
+// Unmarshal implements packet.Payload.
+func (r *RequestCascade) Unmarshal(data []byte) error {
+	wire := packet.PayloadRequestCascade{}
+	if err := wire.Unmarshal(data); err != nil {
+		return err
+	}
+	if len(wire.Entropy) != len(r.Cascade.Entropy) {
+		return errors.New("invalid cascade entropy length")
+	}
+	r.TraceID = wire.TraceID
+	r.RPC.fromWire(wire.RPC)
+	copy(r.Cascade.Entropy[:], wire.Entropy)
+	r.Cascade.ReplicationFactor = uint(wire.ReplicationFactor)
+	r.Cascade.NodeIds = nil
+	for _, id := range wire.NodeIds {
+		var ref insolar.Reference
+		if err := ref.Unmarshal(id); err != nil {
+			return errors.Wrap(err, "invalid cascade node id")
+		}
+		r.Cascade.NodeIds = append(r.Cascade.NodeIds, ref)
+	}
+	return nil
+}
+
+// Marshal implements packet.Payload.
+func (r *ResponseCascade) Marshal() ([]byte, error) {
+	return (&packet.PayloadResponseCascade{Success: r.Success, Error: r.Error}).Marshal()
+}
+
+// Unmarshal implements packet.Payload.
+func (r *ResponseCascade) Unmarshal(data []byte) error {
+	wire := packet.PayloadResponseCascade{}
+	if err := wire.Unmarshal(data); err != nil {
+		return err
+	}
+	r.Success, r.Error = wire.Success, wire.Error
+	return nil
+}
+
+func init() {
+	packet.RegisterPayload(packet.PayloadTypeRequestRPC, func() packet.Payload { return &RequestRPC{} })
+	packet.RegisterPayload(packet.PayloadTypeResponseRPC, func() packet.Payload { return &ResponseRPC{} })
+	packet.RegisterPayload(packet.PayloadTypeRequestCascade, func() packet.Payload { return &RequestCascade{} })
+	packet.RegisterPayload(packet.PayloadTypeResponseCascade, func() packet.Payload { return &ResponseCascade{} })
+}
@@ -56,18 +56,52 @@ import (
 	"github.com/pkg/errors"
 )
 
+// AddressType is a kind of address that was used to create Address.
+type AddressType uint8
+
+const (
+	AddressUnknown = AddressType(iota)
+	AddressIPv4
+	AddressIPv6
+	AddressHostname
+)
+
 // Address is host's real network address.
 type Address struct {
 	net.UDPAddr
+	// Hostname is set if address was created from DNS name, IP and port are resolved from it.
+	Hostname string
 }
 
 // NewAddress is constructor.
 func NewAddress(address string) (*Address, error) {
+	hostPart, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to split host and port")
+	}
 	udpAddr, err := net.ResolveUDPAddr("udp", address)
 	if err != nil {
 		return nil, errors.Wrap(err, "Failed to resolve ip address")
 	}
-	return &Address{UDPAddr: *udpAddr}, nil
+	result := &Address{UDPAddr: *udpAddr}
+	if hostPart != "" && net.ParseIP(hostPart) == nil {
+		result.Hostname = hostPart
+	}
+	return result, nil
+}
+
+// Type returns type of the address.
+func (address Address) Type() AddressType {
+	switch {
+	case address.Hostname != "":
+		return AddressHostname
+	case address.IP == nil:
+		return AddressUnknown
+	case address.IP.To4() != nil:
+		return AddressIPv4
+	default:
+		return AddressIPv6
+	}
 }
 
 // Equal checks if address is equal to another.
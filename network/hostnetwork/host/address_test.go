@@ -60,7 +60,7 @@ import (
 func TestNewAddress(t *testing.T) {
 	addrStr := "127.0.0.1:31337"
 	udpAddr, _ := net.ResolveUDPAddr("udp", addrStr)
-	expectedAddr := &Address{*udpAddr}
+	expectedAddr := &Address{UDPAddr: *udpAddr}
 	actualAddr, err := NewAddress(addrStr)
 
 	require.NoError(t, err)
@@ -78,3 +78,31 @@ func TestAddress_Equal(t *testing.T) {
 	require.False(t, addr1.Equal(*addr3))
 	require.False(t, addr3.Equal(*addr1))
 }
+
+func TestNewAddress_IPv6(t *testing.T) {
+	addr, err := NewAddress("[::1]:31337")
+	require.NoError(t, err)
+	require.Equal(t, AddressIPv6, addr.Type())
+	require.Equal(t, "[::1]:31337", addr.String())
+	require.True(t, addr.IP.Equal(net.IPv6loopback))
+}
+
+func TestNewAddress_Hostname(t *testing.T) {
+	addr, err := NewAddress("localhost:31337")
+	require.NoError(t, err)
+	require.Equal(t, AddressHostname, addr.Type())
+	require.Equal(t, "localhost", addr.Hostname)
+	require.True(t, addr.IP.IsLoopback())
+	require.Equal(t, 31337, addr.Port)
+
+	addr, err = NewAddress("127.0.0.1:31337")
+	require.NoError(t, err)
+	require.Equal(t, AddressIPv4, addr.Type())
+}
+
+func TestNewAddress_Invalid(t *testing.T) {
+	_, err := NewAddress("::1:31337")
+	require.Error(t, err)
+	_, err = NewAddress("127.0.0.1")
+	require.Error(t, err)
+}
@@ -77,6 +77,10 @@ type Packet struct {
 	Data       interface{}
 	Error      error
 	IsResponse bool
+
+	// ProtocolVersion is the latest wire format supported by sender. Nodes advertise it in every packet,
+	// so receivers switch to the newer format without explicit negotiation.
+	ProtocolVersion network.ProtocolVersion
 }
 
 func (p *Packet) GetSender() insolar.Reference {
@@ -99,41 +103,57 @@ func (p *Packet) GetRequestID() network.RequestID {
 	return p.RequestID
 }
 
-// SerializePacket converts packet to byte slice.
+const headerSize = 8
+
+// SerializePacket converts packet to byte slice using current protocol version.
 func SerializePacket(q *Packet) ([]byte, error) {
-	var msgBuffer bytes.Buffer
-	enc := gob.NewEncoder(&msgBuffer)
-	err := enc.Encode(q)
+	return SerializePacketVersion(q, network.ProtocolCurrent)
+}
+
+// SerializePacketVersion converts packet to byte slice using passed protocol version.
+//
+// Packet is prefixed with 8 bytes header: uvarint body length in first 7 bytes and protocol version in the last one.
+// Legacy gob packets have zero in the last byte of the header, so they are decoded as ProtocolGob.
+func SerializePacketVersion(q *Packet, version network.ProtocolVersion) ([]byte, error) {
+	var body []byte
+	var err error
+	switch version {
+	case network.ProtocolGob:
+		body, err = marshalGob(q)
+	case network.ProtocolProtobuf:
+		body, err = marshalEnvelope(q)
+	default:
+		return nil, errors.Errorf("unsupported protocol version %d", version)
+	}
 	if err != nil {
 		return nil, errors.Wrap(err, "Failed to serialize packet")
 	}
 
-	length := msgBuffer.Len()
-
-	var lengthBytes [8]byte
-	binary.PutUvarint(lengthBytes[:], uint64(length))
+	var header [headerSize]byte
+	binary.PutUvarint(header[:headerSize-1], uint64(len(body)))
+	header[headerSize-1] = byte(version)
 
-	var result []byte
-	result = append(result, lengthBytes[:]...)
-	result = append(result, msgBuffer.Bytes()...)
+	result := make([]byte, 0, headerSize+len(body))
+	result = append(result, header[:]...)
+	result = append(result, body...)
 
 	return result, nil
 }
 
-// DeserializePacket reads packet from io.Reader.
+// DeserializePacket reads packet from io.Reader. Protocol version is taken from packet header.
 func DeserializePacket(conn io.Reader) (*Packet, error) {
 
-	lengthBytes := make([]byte, 8)
-	if _, err := io.ReadFull(conn, lengthBytes); err != nil {
+	header := make([]byte, headerSize)
+	if _, err := io.ReadFull(conn, header); err != nil {
 		return nil, err
 	}
-	lengthReader := bytes.NewBuffer(lengthBytes)
-	length, err := binary.ReadUvarint(lengthReader)
+	length, err := binary.ReadUvarint(bytes.NewBuffer(header[:headerSize-1]))
 	if err != nil {
 		return nil, io.ErrUnexpectedEOF
 	}
+	version := network.ProtocolVersion(header[headerSize-1])
 
-	log.Debugf("[ DeserializePacket ] packet length %d", length)
+	log.Debugf("[ DeserializePacket ] packet length %d, protocol version %d", length, version)
 	buf := make([]byte, length)
 	if _, err := io.ReadFull(conn, buf); err != nil {
 		log.Error("[ DeserializePacket ] couldn't read packet: ", err)
@@ -141,10 +161,15 @@ func DeserializePacket(conn io.Reader) (*Packet, error) {
 	}
 	log.Debugf("[ DeserializePacket ] read packet")
 
-	msg := &Packet{}
-	dec := gob.NewDecoder(bytes.NewReader(buf))
-
-	err = dec.Decode(msg)
+	var msg *Packet
+	switch version {
+	case network.ProtocolGob:
+		msg, err = unmarshalGob(buf)
+	case network.ProtocolProtobuf:
+		msg, err = unmarshalEnvelope(buf)
+	default:
+		err = errors.Errorf("unsupported protocol version %d", version)
+	}
 	if err != nil {
 		log.Error("[ DeserializePacket ] couldn't decode packet: ", err)
 		return nil, err
@@ -155,6 +180,125 @@ func DeserializePacket(conn io.Reader) (*Packet, error) {
 	return msg, nil
 }
 
+func marshalGob(q *Packet) ([]byte, error) {
+	// legacy nodes ignore unknown ProtocolVersion field
+	advertised := *q
+	advertised.ProtocolVersion = network.ProtocolCurrent
+
+	var buffer bytes.Buffer
+	err := gob.NewEncoder(&buffer).Encode(&advertised)
+	if err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+func unmarshalGob(data []byte) (*Packet, error) {
+	msg := &Packet{}
+	err := gob.NewDecoder(bytes.NewReader(data)).Decode(msg)
+	if err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+func marshalEnvelope(q *Packet) ([]byte, error) {
+	envelope := &Envelope{
+		ProtocolVersion: uint32(network.ProtocolCurrent),
+		Sender:          marshalHost(q.Sender),
+		Receiver:        marshalHost(q.Receiver),
+		Type:            int64(q.Type),
+		RequestID:       uint64(q.RequestID),
+		RemoteAddress:   q.RemoteAddress,
+		TraceID:         q.TraceID,
+		IsResponse:      q.IsResponse,
+	}
+	if q.Error != nil {
+		envelope.Error = q.Error.Error()
+	}
+	if q.Data != nil {
+		dataType, data, err := marshalPayload(q.Data)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to encode packet data")
+		}
+		envelope.DataType = uint32(dataType)
+		envelope.Data = data
+	}
+	return envelope.Marshal()
+}
+
+func unmarshalEnvelope(data []byte) (*Packet, error) {
+	envelope := &Envelope{}
+	err := envelope.Unmarshal(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal envelope")
+	}
+	if network.ProtocolVersion(envelope.ProtocolVersion) < network.ProtocolProtobuf {
+		return nil, errors.Errorf("unexpected envelope protocol version %d", envelope.ProtocolVersion)
+	}
+
+	msg := &Packet{
+		Type:            types.PacketType(envelope.Type),
+		RequestID:       network.RequestID(envelope.RequestID),
+		RemoteAddress:   envelope.RemoteAddress,
+		TraceID:         envelope.TraceID,
+		IsResponse:      envelope.IsResponse,
+		ProtocolVersion: network.ProtocolVersion(envelope.ProtocolVersion),
+	}
+	msg.Sender, err = unmarshalHost(envelope.Sender)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal sender")
+	}
+	msg.Receiver, err = unmarshalHost(envelope.Receiver)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal receiver")
+	}
+	if envelope.Error != "" {
+		msg.Error = errors.New(envelope.Error)
+	}
+	if envelope.DataType != 0 {
+		msg.Data, err = unmarshalPayload(PayloadType(envelope.DataType), envelope.Data)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decode packet data")
+		}
+	}
+	return msg, nil
+}
+
+func marshalHost(h *host.Host) *EnvelopeHost {
+	if h == nil {
+		return nil
+	}
+	result := &EnvelopeHost{
+		NodeID:  h.NodeID.Bytes(),
+		ShortID: uint32(h.ShortID),
+	}
+	if h.Address != nil {
+		result.Address = h.Address.String()
+	}
+	return result
+}
+
+func unmarshalHost(h *EnvelopeHost) (*host.Host, error) {
+	if h == nil {
+		return nil, nil
+	}
+	result := &host.Host{ShortID: insolar.ShortNodeID(h.ShortID)}
+	if len(h.NodeID) != len(result.NodeID) {
+		return nil, errors.Errorf("invalid node id length %d", len(h.NodeID))
+	}
+	copy(result.NodeID[:], h.NodeID)
+	if h.Address != "" {
+		address, err := host.NewAddress(h.Address)
+		if err != nil {
+			return nil, err
+		}
+		result.Address = address
+	}
+	return result, nil
+}
+
+// legacy nodes decode packet data with gob
 func init() {
 	gob.Register(&RequestPulse{})
 	gob.Register(&ResponsePulse{})
@@ -0,0 +1,8018 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: network/hostnetwork/packet/packet.proto
+
+package packet
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type EnvelopeHost struct {
+	NodeID  []byte `protobuf:"bytes,1,opt,name=NodeID,proto3" json:"NodeID,omitempty"`
+	ShortID uint32 `protobuf:"varint,2,opt,name=ShortID,proto3" json:"ShortID,omitempty"`
+	Address string `protobuf:"bytes,3,opt,name=Address,proto3" json:"Address,omitempty"`
+}
+
+func (m *EnvelopeHost) Reset()      { *m = EnvelopeHost{} }
+func (*EnvelopeHost) ProtoMessage() {}
+func (*EnvelopeHost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f826366adfd81c, []int{0}
+}
+func (m *EnvelopeHost) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnvelopeHost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnvelopeHost.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnvelopeHost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnvelopeHost.Merge(m, src)
+}
+func (m *EnvelopeHost) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnvelopeHost) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnvelopeHost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnvelopeHost proto.InternalMessageInfo
+
+type Envelope struct {
+	ProtocolVersion uint32        `protobuf:"varint,1,opt,name=ProtocolVersion,proto3" json:"ProtocolVersion,omitempty"`
+	Sender          *EnvelopeHost `protobuf:"bytes,2,opt,name=Sender,proto3" json:"Sender,omitempty"`
+	Receiver        *EnvelopeHost `protobuf:"bytes,3,opt,name=Receiver,proto3" json:"Receiver,omitempty"`
+	Type            int64         `protobuf:"varint,4,opt,name=Type,proto3" json:"Type,omitempty"`
+	RequestID       uint64        `protobuf:"varint,5,opt,name=RequestID,proto3" json:"RequestID,omitempty"`
+	RemoteAddress   string        `protobuf:"bytes,6,opt,name=RemoteAddress,proto3" json:"RemoteAddress,omitempty"`
+	TraceID         string        `protobuf:"bytes,7,opt,name=TraceID,proto3" json:"TraceID,omitempty"`
+	IsResponse      bool          `protobuf:"varint,8,opt,name=IsResponse,proto3" json:"IsResponse,omitempty"`
+	Error           string        `protobuf:"bytes,9,opt,name=Error,proto3" json:"Error,omitempty"`
+	// Data is protobuf encoded packet payload of DataType
+	Data     []byte `protobuf:"bytes,10,opt,name=Data,proto3" json:"Data,omitempty"`
+	DataType uint32 `protobuf:"varint,11,opt,name=DataType,proto3" json:"DataType,omitempty"`
+}
+
+func (m *Envelope) Reset()      { *m = Envelope{} }
+func (*Envelope) ProtoMessage() {}
+func (*Envelope) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f826366adfd81c, []int{1}
+}
+func (m *Envelope) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Envelope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Envelope.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Envelope) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Envelope.Merge(m, src)
+}
+func (m *Envelope) XXX_Size() int {
+	return m.Size()
+}
+func (m *Envelope) XXX_DiscardUnknown() {
+	xxx_messageInfo_Envelope.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Envelope proto.InternalMessageInfo
+
+type PayloadPulseSign struct {
+	Key             string `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	PulseNumber     uint32 `protobuf:"varint,2,opt,name=PulseNumber,proto3" json:"PulseNumber,omitempty"`
+	ChosenPublicKey string `protobuf:"bytes,3,opt,name=ChosenPublicKey,proto3" json:"ChosenPublicKey,omitempty"`
+	Entropy         []byte `protobuf:"bytes,4,opt,name=Entropy,proto3" json:"Entropy,omitempty"`
+	Signature       []byte `protobuf:"bytes,5,opt,name=Signature,proto3" json:"Signature,omitempty"`
+}
+
+func (m *PayloadPulseSign) Reset()      { *m = PayloadPulseSign{} }
+func (*PayloadPulseSign) ProtoMessage() {}
+func (*PayloadPulseSign) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f826366adfd81c, []int{2}
+}
+func (m *PayloadPulseSign) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayloadPulseSign) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayloadPulseSign.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PayloadPulseSign) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayloadPulseSign.Merge(m, src)
+}
+func (m *PayloadPulseSign) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayloadPulseSign) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayloadPulseSign.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayloadPulseSign proto.InternalMessageInfo
+
+type PayloadPulse struct {
+	PulseNumber      uint32              `protobuf:"varint,1,opt,name=PulseNumber,proto3" json:"PulseNumber,omitempty"`
+	PrevPulseNumber  uint32              `protobuf:"varint,2,opt,name=PrevPulseNumber,proto3" json:"PrevPulseNumber,omitempty"`
+	NextPulseNumber  uint32              `protobuf:"varint,3,opt,name=NextPulseNumber,proto3" json:"NextPulseNumber,omitempty"`
+	PulseTimestamp   int64               `protobuf:"varint,4,opt,name=PulseTimestamp,proto3" json:"PulseTimestamp,omitempty"`
+	EpochPulseNumber int64               `protobuf:"varint,5,opt,name=EpochPulseNumber,proto3" json:"EpochPulseNumber,omitempty"`
+	OriginID         []byte              `protobuf:"bytes,6,opt,name=OriginID,proto3" json:"OriginID,omitempty"`
+	Entropy          []byte              `protobuf:"bytes,7,opt,name=Entropy,proto3" json:"Entropy,omitempty"`
+	Signs            []*PayloadPulseSign `protobuf:"bytes,8,rep,name=Signs,proto3" json:"Signs,omitempty"`
+}
+
+func (m *PayloadPulse) Reset()      { *m = PayloadPulse{} }
+func (*PayloadPulse) ProtoMessage() {}
+func (*PayloadPulse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f826366adfd81c, []int{3}
+}
+func (m *PayloadPulse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayloadPulse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayloadPulse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PayloadPulse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayloadPulse.Merge(m, src)
+}
+func (m *PayloadPulse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayloadPulse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayloadPulse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayloadPulse proto.InternalMessageInfo
+
+type PayloadRequestPulse struct {
+	Pulse *PayloadPulse `protobuf:"bytes,1,opt,name=Pulse,proto3" json:"Pulse,omitempty"`
+}
+
+func (m *PayloadRequestPulse) Reset()      { *m = PayloadRequestPulse{} }
+func (*PayloadRequestPulse) ProtoMessage() {}
+func (*PayloadRequestPulse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f826366adfd81c, []int{4}
+}
+func (m *PayloadRequestPulse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayloadRequestPulse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayloadRequestPulse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PayloadRequestPulse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayloadRequestPulse.Merge(m, src)
+}
+func (m *PayloadRequestPulse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayloadRequestPulse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayloadRequestPulse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayloadRequestPulse proto.InternalMessageInfo
+
+type PayloadResponsePulse struct {
+	Success bool   `protobuf:"varint,1,opt,name=Success,proto3" json:"Success,omitempty"`
+	Error   string `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (m *PayloadResponsePulse) Reset()      { *m = PayloadResponsePulse{} }
+func (*PayloadResponsePulse) ProtoMessage() {}
+func (*PayloadResponsePulse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f826366adfd81c, []int{5}
+}
+func (m *PayloadResponsePulse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayloadResponsePulse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayloadResponsePulse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PayloadResponsePulse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayloadResponsePulse.Merge(m, src)
+}
+func (m *PayloadResponsePulse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayloadResponsePulse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayloadResponsePulse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayloadResponsePulse proto.InternalMessageInfo
+
+type PayloadRequestRPC struct {
+	Method string   `protobuf:"bytes,1,opt,name=Method,proto3" json:"Method,omitempty"`
+	Data   [][]byte `protobuf:"bytes,2,rep,name=Data,proto3" json:"Data,omitempty"`
+}
+
+func (m *PayloadRequestRPC) Reset()      { *m = PayloadRequestRPC{} }
+func (*PayloadRequestRPC) ProtoMessage() {}
+func (*PayloadRequestRPC) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f826366adfd81c, []int{6}
+}
+func (m *PayloadRequestRPC) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayloadRequestRPC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayloadRequestRPC.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PayloadRequestRPC) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayloadRequestRPC.Merge(m, src)
+}
+func (m *PayloadRequestRPC) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayloadRequestRPC) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayloadRequestRPC.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayloadRequestRPC proto.InternalMessageInfo
+
+type PayloadResponseRPC struct {
+	Success bool   `protobuf:"varint,1,opt,name=Success,proto3" json:"Success,omitempty"`
+	Result  []byte `protobuf:"bytes,2,opt,name=Result,proto3" json:"Result,omitempty"`
+	Error   string `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (m *PayloadResponseRPC) Reset()      { *m = PayloadResponseRPC{} }
+func (*PayloadResponseRPC) ProtoMessage() {}
+func (*PayloadResponseRPC) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f826366adfd81c, []int{7}
+}
+func (m *PayloadResponseRPC) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayloadResponseRPC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayloadResponseRPC.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PayloadResponseRPC) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayloadResponseRPC.Merge(m, src)
+}
+func (m *PayloadResponseRPC) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayloadResponseRPC) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayloadResponseRPC.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayloadResponseRPC proto.InternalMessageInfo
+
+type PayloadRequestCascade struct {
+	TraceID           string             `protobuf:"bytes,1,opt,name=TraceID,proto3" json:"TraceID,omitempty"`
+	RPC               *PayloadRequestRPC `protobuf:"bytes,2,opt,name=RPC,proto3" json:"RPC,omitempty"`
+	NodeIds           [][]byte           `protobuf:"bytes,3,rep,name=NodeIds,proto3" json:"NodeIds,omitempty"`
+	Entropy           []byte             `protobuf:"bytes,4,opt,name=Entropy,proto3" json:"Entropy,omitempty"`
+	ReplicationFactor uint64             `protobuf:"varint,5,opt,name=ReplicationFactor,proto3" json:"ReplicationFactor,omitempty"`
+}
+
+func (m *PayloadRequestCascade) Reset()      { *m = PayloadRequestCascade{} }
+func (*PayloadRequestCascade) ProtoMessage() {}
+func (*PayloadRequestCascade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f826366adfd81c, []int{8}
+}
+func (m *PayloadRequestCascade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayloadRequestCascade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayloadRequestCascade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PayloadRequestCascade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayloadRequestCascade.Merge(m, src)
+}
+func (m *PayloadRequestCascade) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayloadRequestCascade) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayloadRequestCascade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayloadRequestCascade proto.InternalMessageInfo
+
+type PayloadResponseCascade struct {
+	Success bool   `protobuf:"varint,1,opt,name=Success,proto3" json:"Success,omitempty"`
+	Error   string `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (m *PayloadResponseCascade) Reset()      { *m = PayloadResponseCascade{} }
+func (*PayloadResponseCascade) ProtoMessage() {}
+func (*PayloadResponseCascade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f826366adfd81c, []int{9}
+}
+func (m *PayloadResponseCascade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayloadResponseCascade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayloadResponseCascade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PayloadResponseCascade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayloadResponseCascade.Merge(m, src)
+}
+func (m *PayloadResponseCascade) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayloadResponseCascade) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayloadResponseCascade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayloadResponseCascade proto.InternalMessageInfo
+
+type PayloadChallengeRequest struct {
+	SessionID uint64 `protobuf:"varint,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	Nonce     []byte `protobuf:"bytes,2,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+}
+
+func (m *PayloadChallengeRequest) Reset()      { *m = PayloadChallengeRequest{} }
+func (*PayloadChallengeRequest) ProtoMessage() {}
+func (*PayloadChallengeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f826366adfd81c, []int{10}
+}
+func (m *PayloadChallengeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayloadChallengeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayloadChallengeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PayloadChallengeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayloadChallengeRequest.Merge(m, src)
+}
+func (m *PayloadChallengeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayloadChallengeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayloadChallengeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayloadChallengeRequest proto.InternalMessageInfo
+
+type PayloadSignedChallengeResponse struct {
+	Success           bool   `protobuf:"varint,1,opt,name=Success,proto3" json:"Success,omitempty"`
+	Error             string `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+	HasPayload        bool   `protobuf:"varint,3,opt,name=HasPayload,proto3" json:"HasPayload,omitempty"`
+	SignedNonce       []byte `protobuf:"bytes,4,opt,name=SignedNonce,proto3" json:"SignedNonce,omitempty"`
+	XorDiscoveryNonce []byte `protobuf:"bytes,5,opt,name=XorDiscoveryNonce,proto3" json:"XorDiscoveryNonce,omitempty"`
+	DiscoveryNonce    []byte `protobuf:"bytes,6,opt,name=DiscoveryNonce,proto3" json:"DiscoveryNonce,omitempty"`
+}
+
+func (m *PayloadSignedChallengeResponse) Reset()      { *m = PayloadSignedChallengeResponse{} }
+func (*PayloadSignedChallengeResponse) ProtoMessage() {}
+func (*PayloadSignedChallengeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f826366adfd81c, []int{11}
+}
+func (m *PayloadSignedChallengeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayloadSignedChallengeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayloadSignedChallengeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PayloadSignedChallengeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayloadSignedChallengeResponse.Merge(m, src)
+}
+func (m *PayloadSignedChallengeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayloadSignedChallengeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayloadSignedChallengeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayloadSignedChallengeResponse proto.InternalMessageInfo
+
+type PayloadSignedChallengeRequest struct {
+	SessionID            uint64 `protobuf:"varint,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	SignedDiscoveryNonce []byte `protobuf:"bytes,2,opt,name=SignedDiscoveryNonce,proto3" json:"SignedDiscoveryNonce,omitempty"`
+	XorNonce             []byte `protobuf:"bytes,3,opt,name=XorNonce,proto3" json:"XorNonce,omitempty"`
+}
+
+func (m *PayloadSignedChallengeRequest) Reset()      { *m = PayloadSignedChallengeRequest{} }
+func (*PayloadSignedChallengeRequest) ProtoMessage() {}
+func (*PayloadSignedChallengeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f826366adfd81c, []int{12}
+}
+func (m *PayloadSignedChallengeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayloadSignedChallengeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayloadSignedChallengeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PayloadSignedChallengeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayloadSignedChallengeRequest.Merge(m, src)
+}
+func (m *PayloadSignedChallengeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayloadSignedChallengeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayloadSignedChallengeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayloadSignedChallengeRequest proto.InternalMessageInfo
+
+type PayloadChallengeResponse struct {
+	Success       bool   `protobuf:"varint,1,opt,name=Success,proto3" json:"Success,omitempty"`
+	Error         string `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+	HasPayload    bool   `protobuf:"varint,3,opt,name=HasPayload,proto3" json:"HasPayload,omitempty"`
+	AssignShortID uint32 `protobuf:"varint,4,opt,name=AssignShortID,proto3" json:"AssignShortID,omitempty"`
+}
+
+func (m *PayloadChallengeResponse) Reset()      { *m = PayloadChallengeResponse{} }
+func (*PayloadChallengeResponse) ProtoMessage() {}
+func (*PayloadChallengeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f826366adfd81c, []int{13}
+}
+func (m *PayloadChallengeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayloadChallengeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayloadChallengeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PayloadChallengeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayloadChallengeResponse.Merge(m, src)
+}
+func (m *PayloadChallengeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayloadChallengeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayloadChallengeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayloadChallengeResponse proto.InternalMessageInfo
+
+type PayloadNodeBootstrapRequest struct {
+	ProtocolVersion uint32 `protobuf:"varint,1,opt,name=ProtocolVersion,proto3" json:"ProtocolVersion,omitempty"`
+}
+
+func (m *PayloadNodeBootstrapRequest) Reset()      { *m = PayloadNodeBootstrapRequest{} }
+func (*PayloadNodeBootstrapRequest) ProtoMessage() {}
+func (*PayloadNodeBootstrapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f826366adfd81c, []int{14}
+}
+func (m *PayloadNodeBootstrapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayloadNodeBootstrapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayloadNodeBootstrapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PayloadNodeBootstrapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayloadNodeBootstrapRequest.Merge(m, src)
+}
+func (m *PayloadNodeBootstrapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayloadNodeBootstrapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayloadNodeBootstrapRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayloadNodeBootstrapRequest proto.InternalMessageInfo
+
+type PayloadNodeBootstrapResponse struct {
+	Code            uint32 `protobuf:"varint,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	RedirectHost    string `protobuf:"bytes,2,opt,name=RedirectHost,proto3" json:"RedirectHost,omitempty"`
+	RejectReason    string `protobuf:"bytes,3,opt,name=RejectReason,proto3" json:"RejectReason,omitempty"`
+	NetworkSize     int64  `protobuf:"varint,4,opt,name=NetworkSize,proto3" json:"NetworkSize,omitempty"`
+	ProtocolVersion uint32 `protobuf:"varint,5,opt,name=ProtocolVersion,proto3" json:"ProtocolVersion,omitempty"`
+}
+
+func (m *PayloadNodeBootstrapResponse) Reset()      { *m = PayloadNodeBootstrapResponse{} }
+func (*PayloadNodeBootstrapResponse) ProtoMessage() {}
+func (*PayloadNodeBootstrapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f826366adfd81c, []int{15}
+}
+func (m *PayloadNodeBootstrapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayloadNodeBootstrapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayloadNodeBootstrapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PayloadNodeBootstrapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayloadNodeBootstrapResponse.Merge(m, src)
+}
+func (m *PayloadNodeBootstrapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayloadNodeBootstrapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayloadNodeBootstrapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayloadNodeBootstrapResponse proto.InternalMessageInfo
+
+type PayloadNode struct {
+	ID      []byte `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	SID     uint32 `protobuf:"varint,2,opt,name=SID,proto3" json:"SID,omitempty"`
+	Role    uint32 `protobuf:"varint,3,opt,name=Role,proto3" json:"Role,omitempty"`
+	PK      []byte `protobuf:"bytes,4,opt,name=PK,proto3" json:"PK,omitempty"`
+	Address string `protobuf:"bytes,5,opt,name=Address,proto3" json:"Address,omitempty"`
+	Version string `protobuf:"bytes,6,opt,name=Version,proto3" json:"Version,omitempty"`
+}
+
+func (m *PayloadNode) Reset()      { *m = PayloadNode{} }
+func (*PayloadNode) ProtoMessage() {}
+func (*PayloadNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f826366adfd81c, []int{16}
+}
+func (m *PayloadNode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayloadNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayloadNode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PayloadNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayloadNode.Merge(m, src)
+}
+func (m *PayloadNode) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayloadNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayloadNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayloadNode proto.InternalMessageInfo
+
+type PayloadGenesisRequest struct {
+	LastPulse uint32       `protobuf:"varint,1,opt,name=LastPulse,proto3" json:"LastPulse,omitempty"`
+	Discovery *PayloadNode `protobuf:"bytes,2,opt,name=Discovery,proto3" json:"Discovery,omitempty"`
+}
+
+func (m *PayloadGenesisRequest) Reset()      { *m = PayloadGenesisRequest{} }
+func (*PayloadGenesisRequest) ProtoMessage() {}
+func (*PayloadGenesisRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f826366adfd81c, []int{17}
+}
+func (m *PayloadGenesisRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayloadGenesisRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayloadGenesisRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PayloadGenesisRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayloadGenesisRequest.Merge(m, src)
+}
+func (m *PayloadGenesisRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayloadGenesisRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayloadGenesisRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayloadGenesisRequest proto.InternalMessageInfo
+
+type PayloadGenesisResponse struct {
+	Response *PayloadGenesisRequest `protobuf:"bytes,1,opt,name=Response,proto3" json:"Response,omitempty"`
+	Error    string                 `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (m *PayloadGenesisResponse) Reset()      { *m = PayloadGenesisResponse{} }
+func (*PayloadGenesisResponse) ProtoMessage() {}
+func (*PayloadGenesisResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f826366adfd81c, []int{18}
+}
+func (m *PayloadGenesisResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayloadGenesisResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayloadGenesisResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PayloadGenesisResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayloadGenesisResponse.Merge(m, src)
+}
+func (m *PayloadGenesisResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayloadGenesisResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayloadGenesisResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayloadGenesisResponse proto.InternalMessageInfo
+
+type PayloadStartSessionRequest struct {
+}
+
+func (m *PayloadStartSessionRequest) Reset()      { *m = PayloadStartSessionRequest{} }
+func (*PayloadStartSessionRequest) ProtoMessage() {}
+func (*PayloadStartSessionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f826366adfd81c, []int{19}
+}
+func (m *PayloadStartSessionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayloadStartSessionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayloadStartSessionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PayloadStartSessionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayloadStartSessionRequest.Merge(m, src)
+}
+func (m *PayloadStartSessionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayloadStartSessionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayloadStartSessionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayloadStartSessionRequest proto.InternalMessageInfo
+
+type PayloadStartSessionResponse struct {
+	SessionID uint64 `protobuf:"varint,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+}
+
+func (m *PayloadStartSessionResponse) Reset()      { *m = PayloadStartSessionResponse{} }
+func (*PayloadStartSessionResponse) ProtoMessage() {}
+func (*PayloadStartSessionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f826366adfd81c, []int{20}
+}
+func (m *PayloadStartSessionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayloadStartSessionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayloadStartSessionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PayloadStartSessionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayloadStartSessionResponse.Merge(m, src)
+}
+func (m *PayloadStartSessionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayloadStartSessionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayloadStartSessionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayloadStartSessionResponse proto.InternalMessageInfo
+
+type PayloadAuthorizationRequest struct {
+	Certificate []byte `protobuf:"bytes,1,opt,name=Certificate,proto3" json:"Certificate,omitempty"`
+}
+
+func (m *PayloadAuthorizationRequest) Reset()      { *m = PayloadAuthorizationRequest{} }
+func (*PayloadAuthorizationRequest) ProtoMessage() {}
+func (*PayloadAuthorizationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f826366adfd81c, []int{21}
+}
+func (m *PayloadAuthorizationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayloadAuthorizationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayloadAuthorizationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PayloadAuthorizationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayloadAuthorizationRequest.Merge(m, src)
+}
+func (m *PayloadAuthorizationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayloadAuthorizationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayloadAuthorizationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayloadAuthorizationRequest proto.InternalMessageInfo
+
+type PayloadAuthorizationResponse struct {
+	Code      uint32 `protobuf:"varint,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Error     string `protobuf:"bytes,2,opt,name=Error,proto3" json:"Error,omitempty"`
+	SessionID uint64 `protobuf:"varint,3,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+}
+
+func (m *PayloadAuthorizationResponse) Reset()      { *m = PayloadAuthorizationResponse{} }
+func (*PayloadAuthorizationResponse) ProtoMessage() {}
+func (*PayloadAuthorizationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f826366adfd81c, []int{22}
+}
+func (m *PayloadAuthorizationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayloadAuthorizationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayloadAuthorizationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PayloadAuthorizationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayloadAuthorizationResponse.Merge(m, src)
+}
+func (m *PayloadAuthorizationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayloadAuthorizationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayloadAuthorizationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayloadAuthorizationResponse proto.InternalMessageInfo
+
+type PayloadRegistrationRequest struct {
+	SessionID uint64 `protobuf:"varint,1,opt,name=SessionID,proto3" json:"SessionID,omitempty"`
+	Version   string `protobuf:"bytes,2,opt,name=Version,proto3" json:"Version,omitempty"`
+	JoinClaim []byte `protobuf:"bytes,3,opt,name=JoinClaim,proto3" json:"JoinClaim,omitempty"`
+}
+
+func (m *PayloadRegistrationRequest) Reset()      { *m = PayloadRegistrationRequest{} }
+func (*PayloadRegistrationRequest) ProtoMessage() {}
+func (*PayloadRegistrationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f826366adfd81c, []int{23}
+}
+func (m *PayloadRegistrationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayloadRegistrationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayloadRegistrationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PayloadRegistrationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayloadRegistrationRequest.Merge(m, src)
+}
+func (m *PayloadRegistrationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayloadRegistrationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayloadRegistrationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayloadRegistrationRequest proto.InternalMessageInfo
+
+type PayloadRegistrationResponse struct {
+	Code    uint32 `protobuf:"varint,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	RetryIn int64  `protobuf:"varint,2,opt,name=RetryIn,proto3" json:"RetryIn,omitempty"`
+	Error   string `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (m *PayloadRegistrationResponse) Reset()      { *m = PayloadRegistrationResponse{} }
+func (*PayloadRegistrationResponse) ProtoMessage() {}
+func (*PayloadRegistrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f826366adfd81c, []int{24}
+}
+func (m *PayloadRegistrationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayloadRegistrationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayloadRegistrationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PayloadRegistrationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayloadRegistrationResponse.Merge(m, src)
+}
+func (m *PayloadRegistrationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayloadRegistrationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayloadRegistrationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayloadRegistrationResponse proto.InternalMessageInfo
+
+type PayloadTest struct {
+	Data []byte `protobuf:"bytes,1,opt,name=Data,proto3" json:"Data,omitempty"`
+}
+
+func (m *PayloadTest) Reset()      { *m = PayloadTest{} }
+func (*PayloadTest) ProtoMessage() {}
+func (*PayloadTest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3f826366adfd81c, []int{25}
+}
+func (m *PayloadTest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayloadTest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayloadTest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PayloadTest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayloadTest.Merge(m, src)
+}
+func (m *PayloadTest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayloadTest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayloadTest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayloadTest proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*EnvelopeHost)(nil), "packet.EnvelopeHost")
+	proto.RegisterType((*Envelope)(nil), "packet.Envelope")
+	proto.RegisterType((*PayloadPulseSign)(nil), "packet.PayloadPulseSign")
+	proto.RegisterType((*PayloadPulse)(nil), "packet.PayloadPulse")
+	proto.RegisterType((*PayloadRequestPulse)(nil), "packet.PayloadRequestPulse")
+	proto.RegisterType((*PayloadResponsePulse)(nil), "packet.PayloadResponsePulse")
+	proto.RegisterType((*PayloadRequestRPC)(nil), "packet.PayloadRequestRPC")
+	proto.RegisterType((*PayloadResponseRPC)(nil), "packet.PayloadResponseRPC")
+	proto.RegisterType((*PayloadRequestCascade)(nil), "packet.PayloadRequestCascade")
+	proto.RegisterType((*PayloadResponseCascade)(nil), "packet.PayloadResponseCascade")
+	proto.RegisterType((*PayloadChallengeRequest)(nil), "packet.PayloadChallengeRequest")
+	proto.RegisterType((*PayloadSignedChallengeResponse)(nil), "packet.PayloadSignedChallengeResponse")
+	proto.RegisterType((*PayloadSignedChallengeRequest)(nil), "packet.PayloadSignedChallengeRequest")
+	proto.RegisterType((*PayloadChallengeResponse)(nil), "packet.PayloadChallengeResponse")
+	proto.RegisterType((*PayloadNodeBootstrapRequest)(nil), "packet.PayloadNodeBootstrapRequest")
+	proto.RegisterType((*PayloadNodeBootstrapResponse)(nil), "packet.PayloadNodeBootstrapResponse")
+	proto.RegisterType((*PayloadNode)(nil), "packet.PayloadNode")
+	proto.RegisterType((*PayloadGenesisRequest)(nil), "packet.PayloadGenesisRequest")
+	proto.RegisterType((*PayloadGenesisResponse)(nil), "packet.PayloadGenesisResponse")
+	proto.RegisterType((*PayloadStartSessionRequest)(nil), "packet.PayloadStartSessionRequest")
+	proto.RegisterType((*PayloadStartSessionResponse)(nil), "packet.PayloadStartSessionResponse")
+	proto.RegisterType((*PayloadAuthorizationRequest)(nil), "packet.PayloadAuthorizationRequest")
+	proto.RegisterType((*PayloadAuthorizationResponse)(nil), "packet.PayloadAuthorizationResponse")
+	proto.RegisterType((*PayloadRegistrationRequest)(nil), "packet.PayloadRegistrationRequest")
+	proto.RegisterType((*PayloadRegistrationResponse)(nil), "packet.PayloadRegistrationResponse")
+	proto.RegisterType((*PayloadTest)(nil), "packet.PayloadTest")
+}
+
+func init() {
+	proto.RegisterFile("network/hostnetwork/packet/packet.proto", fileDescriptor_c3f826366adfd81c)
+}
+
+var fileDescriptor_c3f826366adfd81c = []byte{
+	// 1273 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0x78, 0xe3, 0xc4, 0x79, 0x71, 0x4a, 0xbb, 0x0d, 0x61, 0x69, 0xd3, 0x95, 0x59, 0x55,
+	0x60, 0x95, 0x92, 0x42, 0x39, 0x21, 0x90, 0xaa, 0xd4, 0x49, 0xdb, 0x50, 0x1a, 0xac, 0x49, 0x84,
+	0x2a, 0xc4, 0x65, 0xb3, 0x7e, 0xb5, 0x97, 0x3a, 0x3b, 0x66, 0x66, 0x1c, 0x48, 0x4f, 0xdc, 0x38,
+	0x21, 0xf5, 0x63, 0xf4, 0x53, 0x20, 0x4e, 0xa8, 0xc7, 0x1e, 0x7b, 0x24, 0xee, 0xa5, 0x07, 0x0e,
+	0x3d, 0x72, 0x44, 0xf3, 0x67, 0xd7, 0xbb, 0x6b, 0xd7, 0x6a, 0x0f, 0x9c, 0x3c, 0xef, 0xcd, 0x9b,
+	0x37, 0xef, 0xfd, 0xe6, 0xbd, 0xdf, 0x3e, 0xc3, 0x47, 0x09, 0xca, 0x9f, 0x19, 0x7f, 0x78, 0xad,
+	0xcf, 0x84, 0x4c, 0xd7, 0xc3, 0x30, 0x7a, 0x88, 0xd2, 0xfe, 0x6c, 0x0e, 0x39, 0x93, 0xcc, 0x5d,
+	0x34, 0xd2, 0x85, 0x4f, 0x7a, 0xb1, 0xec, 0x8f, 0x0e, 0x37, 0x23, 0x76, 0x74, 0xad, 0xc7, 0x7a,
+	0xec, 0x9a, 0xde, 0x3e, 0x1c, 0x3d, 0xd0, 0x92, 0x16, 0xf4, 0xca, 0x1c, 0x0b, 0xbe, 0x87, 0xc6,
+	0x4e, 0x72, 0x8c, 0x03, 0x36, 0xc4, 0x3b, 0x4c, 0x48, 0x77, 0x1d, 0x16, 0xf7, 0x58, 0x17, 0x77,
+	0xb7, 0x3d, 0xd2, 0x24, 0xad, 0x06, 0xb5, 0x92, 0xeb, 0xc1, 0xd2, 0x7e, 0x9f, 0x71, 0xb9, 0xbb,
+	0xed, 0x55, 0x9b, 0xa4, 0xb5, 0x4a, 0x53, 0x51, 0xed, 0x6c, 0x75, 0xbb, 0x1c, 0x85, 0xf0, 0x9c,
+	0x26, 0x69, 0x2d, 0xd3, 0x54, 0x0c, 0xfe, 0xa9, 0x42, 0x3d, 0x75, 0xee, 0xb6, 0xe0, 0x9d, 0x8e,
+	0xba, 0x31, 0x62, 0x83, 0xef, 0x90, 0x8b, 0x98, 0x25, 0xfa, 0x86, 0x55, 0x5a, 0x56, 0xbb, 0x57,
+	0x61, 0x71, 0x1f, 0x93, 0x2e, 0x72, 0x7d, 0xd3, 0xca, 0xf5, 0xb5, 0x4d, 0x9b, 0x68, 0x3e, 0x50,
+	0x6a, 0x6d, 0xdc, 0x4f, 0xa1, 0x4e, 0x31, 0xc2, 0xf8, 0x18, 0xb9, 0xe7, 0xcc, 0xb1, 0xcf, 0xac,
+	0x5c, 0x17, 0x16, 0x0e, 0x4e, 0x86, 0xe8, 0x2d, 0x34, 0x49, 0xcb, 0xa1, 0x7a, 0xed, 0x6e, 0xc0,
+	0x32, 0xc5, 0x9f, 0x46, 0x28, 0x54, 0x82, 0xb5, 0x26, 0x69, 0x2d, 0xd0, 0x89, 0xc2, 0xbd, 0x0c,
+	0xab, 0x14, 0x8f, 0x98, 0xc4, 0x34, 0xd1, 0x45, 0x9d, 0x68, 0x51, 0xa9, 0x80, 0x38, 0xe0, 0x61,
+	0xa4, 0xb0, 0x5b, 0x32, 0x40, 0x58, 0xd1, 0xf5, 0x01, 0x76, 0x05, 0x45, 0x31, 0x64, 0x89, 0x40,
+	0xaf, 0xde, 0x24, 0xad, 0x3a, 0xcd, 0x69, 0xdc, 0x35, 0xa8, 0xed, 0x70, 0xce, 0xb8, 0xb7, 0xac,
+	0xcf, 0x19, 0x41, 0xc5, 0xb9, 0x1d, 0xca, 0xd0, 0x03, 0xfd, 0x10, 0x7a, 0xed, 0x5e, 0x80, 0xba,
+	0xfa, 0xd5, 0xf1, 0xaf, 0x68, 0xf8, 0x32, 0x39, 0x78, 0x42, 0xe0, 0x6c, 0x27, 0x3c, 0x19, 0xb0,
+	0xb0, 0xdb, 0x19, 0x0d, 0x04, 0xee, 0xc7, 0xbd, 0xc4, 0x3d, 0x0b, 0xce, 0x5d, 0x3c, 0xd1, 0x50,
+	0x2f, 0x53, 0xb5, 0x74, 0x9b, 0xb0, 0xa2, 0xb7, 0xf7, 0x46, 0x47, 0x87, 0x16, 0xe3, 0x55, 0x9a,
+	0x57, 0xa9, 0xa7, 0x6a, 0xf7, 0x99, 0xc0, 0xa4, 0x33, 0x3a, 0x1c, 0xc4, 0x91, 0x3a, 0x6f, 0x5e,
+	0xb6, 0xac, 0x56, 0x29, 0xef, 0x24, 0x92, 0xb3, 0xe1, 0x89, 0x46, 0xb3, 0x41, 0x53, 0x51, 0x01,
+	0xaa, 0xee, 0x0f, 0xe5, 0x88, 0xa3, 0x06, 0xb4, 0x41, 0x27, 0x8a, 0xe0, 0x8f, 0x2a, 0x34, 0xf2,
+	0xa1, 0x96, 0x83, 0x22, 0x33, 0x83, 0xea, 0x70, 0x3c, 0x9e, 0x0e, 0xbd, 0xac, 0x56, 0x96, 0x7b,
+	0xf8, 0x8b, 0xcc, 0x5b, 0x3a, 0xc6, 0xb2, 0xa4, 0x76, 0x3f, 0x84, 0x33, 0x5a, 0x3c, 0x88, 0x8f,
+	0x50, 0xc8, 0xf0, 0x68, 0x68, 0x6b, 0xa2, 0xa4, 0x75, 0xaf, 0xc0, 0xd9, 0x9d, 0x21, 0x8b, 0xfa,
+	0x79, 0x97, 0x35, 0x6d, 0x39, 0xa5, 0x57, 0x2f, 0xf4, 0x2d, 0x8f, 0x7b, 0x71, 0xb2, 0xbb, 0xad,
+	0xcb, 0xa4, 0x41, 0x33, 0x39, 0x0f, 0xd7, 0x52, 0x11, 0xae, 0x4d, 0xa8, 0x29, 0x74, 0x84, 0x57,
+	0x6f, 0x3a, 0xad, 0x95, 0xeb, 0x5e, 0x5a, 0xc2, 0xe5, 0xf7, 0xa4, 0xc6, 0x2c, 0xd8, 0x82, 0xf3,
+	0x76, 0xcb, 0x56, 0xa9, 0x81, 0xf1, 0x0a, 0xd4, 0xf4, 0xc2, 0x23, 0xc5, 0x4e, 0xc8, 0xbb, 0xa1,
+	0xc6, 0x24, 0xb8, 0x05, 0x6b, 0x99, 0x0b, 0x53, 0x87, 0xc6, 0x87, 0xea, 0xf4, 0x51, 0x14, 0xa9,
+	0x32, 0x27, 0xba, 0x52, 0x53, 0x71, 0x52, 0xa6, 0xd5, 0x5c, 0x99, 0x06, 0x37, 0xe0, 0x5c, 0x31,
+	0x14, 0xda, 0x69, 0x2b, 0x1a, 0xb9, 0x87, 0xb2, 0xcf, 0xba, 0xb6, 0xf2, 0xac, 0x94, 0xd5, 0x74,
+	0xb5, 0xe9, 0xa4, 0x35, 0x1d, 0xfc, 0x00, 0x6e, 0x29, 0x10, 0xe5, 0xe1, 0xf5, 0x61, 0xac, 0xc3,
+	0x22, 0x45, 0x31, 0x1a, 0x48, 0x1d, 0x47, 0x83, 0x5a, 0x69, 0x12, 0x9e, 0x93, 0x0f, 0xef, 0x4f,
+	0x02, 0xef, 0x16, 0xe3, 0x6b, 0x87, 0x22, 0x0a, 0xbb, 0x98, 0xef, 0x57, 0x52, 0xec, 0xd7, 0x8f,
+	0xc1, 0xa1, 0x9d, 0xb6, 0xa5, 0x9f, 0xf7, 0x4b, 0x20, 0x4e, 0xb2, 0xa4, 0x8e, 0x0d, 0x54, 0x73,
+	0x64, 0x57, 0xf1, 0x9f, 0xca, 0x2a, 0x15, 0xe7, 0x74, 0xc7, 0x55, 0x38, 0x47, 0x71, 0x38, 0x88,
+	0xa3, 0x50, 0xc6, 0x2c, 0xb9, 0x15, 0x46, 0x92, 0x71, 0x4b, 0x3b, 0xd3, 0x1b, 0xc1, 0x1d, 0x58,
+	0x2f, 0x01, 0x94, 0x4b, 0xe1, 0xad, 0xde, 0xea, 0x1e, 0xbc, 0x67, 0x3d, 0xb5, 0xfb, 0xe1, 0x60,
+	0x80, 0x49, 0x0f, 0x6d, 0x3a, 0xba, 0x61, 0x51, 0x28, 0x02, 0xb6, 0x78, 0x2c, 0xd0, 0x89, 0x42,
+	0xb9, 0xdb, 0x63, 0x49, 0x84, 0x16, 0x72, 0x23, 0x04, 0x2f, 0x09, 0xf8, 0xd6, 0x9f, 0x2a, 0x4b,
+	0xcc, 0x7b, 0xb5, 0xd4, 0xf6, 0x96, 0x11, 0x2a, 0xaa, 0xbc, 0x13, 0x0a, 0xeb, 0x54, 0xbf, 0x64,
+	0x9d, 0xe6, 0x34, 0x8a, 0x28, 0xcc, 0x55, 0x26, 0x1c, 0x83, 0x6b, 0x5e, 0xa5, 0xb0, 0xbd, 0xcf,
+	0xf8, 0x76, 0x2c, 0x22, 0x76, 0x8c, 0xfc, 0xc4, 0xd8, 0x19, 0x06, 0x9a, 0xde, 0x50, 0x14, 0x50,
+	0x32, 0x35, 0x4d, 0x5b, 0xd2, 0x06, 0xbf, 0x13, 0xb8, 0xf4, 0xba, 0x54, 0xdf, 0x04, 0xc0, 0xeb,
+	0xb0, 0x66, 0xce, 0x95, 0x6e, 0x33, 0x78, 0xce, 0xdc, 0x53, 0x54, 0x72, 0x9f, 0x71, 0x63, 0xe7,
+	0x18, 0x2a, 0x49, 0xe5, 0xe0, 0x31, 0x01, 0x6f, 0xfa, 0x29, 0xff, 0x27, 0xd0, 0x2f, 0xc3, 0xea,
+	0x96, 0x10, 0x71, 0x2f, 0x49, 0x47, 0x80, 0x05, 0xcd, 0xa7, 0x45, 0x65, 0x70, 0x1b, 0x2e, 0xda,
+	0x03, 0xaa, 0x01, 0x6e, 0x32, 0x26, 0x85, 0xe4, 0xe1, 0x30, 0xc5, 0xe7, 0x8d, 0x07, 0x80, 0xe0,
+	0x2f, 0x02, 0x1b, 0xb3, 0x3d, 0xd9, 0xfc, 0x5c, 0x58, 0x68, 0xb3, 0x2e, 0xda, 0xf3, 0x7a, 0xed,
+	0x06, 0xd0, 0xa0, 0xd8, 0x8d, 0x39, 0x46, 0x52, 0x7d, 0xef, 0x6d, 0x82, 0x05, 0x9d, 0xb1, 0xf9,
+	0x11, 0x23, 0x49, 0x31, 0x14, 0x2c, 0xb1, 0x44, 0x51, 0xd0, 0xa9, 0x02, 0xdb, 0x33, 0x63, 0xd6,
+	0x7e, 0xfc, 0x28, 0x1d, 0x12, 0xf2, 0xaa, 0x59, 0x89, 0xd4, 0x66, 0x27, 0xf2, 0x1b, 0x81, 0x95,
+	0x5c, 0x22, 0xee, 0x19, 0xa8, 0x66, 0x83, 0x55, 0x75, 0x77, 0x5b, 0x7d, 0x9c, 0xf7, 0xb3, 0x81,
+	0x4a, 0x2d, 0x55, 0x66, 0x94, 0x0d, 0xd0, 0x7e, 0xb0, 0xf4, 0x5a, 0x9d, 0xea, 0xdc, 0xb5, 0x95,
+	0x5e, 0xed, 0xdc, 0xcd, 0x0f, 0x5c, 0xb5, 0xc2, 0xc0, 0xa5, 0x76, 0xd2, 0x88, 0xcc, 0x84, 0x92,
+	0x8a, 0x41, 0x3f, 0x23, 0xc1, 0xdb, 0x98, 0xa0, 0x88, 0x45, 0xae, 0x6a, 0xbf, 0x09, 0xed, 0xe7,
+	0xc3, 0xe2, 0x39, 0x51, 0xb8, 0x9f, 0xc1, 0x72, 0x56, 0x93, 0x96, 0x0e, 0xcf, 0x97, 0xe8, 0x50,
+	0x25, 0x46, 0x27, 0x56, 0x41, 0x0c, 0xeb, 0xe5, 0x9b, 0xec, 0xab, 0x7d, 0x01, 0xf5, 0x74, 0x6d,
+	0xbf, 0x4f, 0x97, 0x4a, 0xbe, 0x8a, 0xb1, 0xd1, 0xfa, 0xf4, 0x80, 0x54, 0x60, 0xb3, 0x0d, 0xb8,
+	0x90, 0xb6, 0xa4, 0x0c, 0xb9, 0xb4, 0xcd, 0x66, 0x4f, 0x07, 0x5f, 0xc2, 0xc5, 0x99, 0xbb, 0xd6,
+	0xe5, 0xdc, 0x76, 0x0d, 0x6e, 0x64, 0x87, 0xb7, 0x46, 0xb2, 0xcf, 0x78, 0xfc, 0x28, 0x94, 0x13,
+	0xdf, 0xaa, 0x48, 0xda, 0xc8, 0x65, 0xfc, 0x40, 0x11, 0x35, 0xda, 0x17, 0xcd, 0xab, 0x82, 0x07,
+	0xb0, 0x31, 0xdb, 0xc1, 0x9c, 0x12, 0x9e, 0xdd, 0x9c, 0x85, 0x40, 0x9d, 0x72, 0xa0, 0x3c, 0xc3,
+	0x80, 0x62, 0x2f, 0x56, 0x6d, 0x92, 0x8f, 0x73, 0x3e, 0x27, 0xe5, 0xca, 0xa5, 0x5a, 0x28, 0x17,
+	0x75, 0xee, 0x6b, 0x16, 0x27, 0xed, 0x41, 0x18, 0x1f, 0x59, 0xea, 0x99, 0x28, 0x82, 0x10, 0x2e,
+	0xce, 0xbc, 0x73, 0x4e, 0x6a, 0x1e, 0x2c, 0x51, 0x94, 0xfc, 0x64, 0xd7, 0x5c, 0xe5, 0xd0, 0x54,
+	0x7c, 0xcd, 0x57, 0xfb, 0x83, 0xac, 0x71, 0x0e, 0x54, 0x1e, 0xe9, 0xd8, 0x40, 0x26, 0xa3, 0xf0,
+	0xcd, 0xaf, 0x9e, 0x9e, 0xfa, 0x95, 0x67, 0xa7, 0x7e, 0xe5, 0xf9, 0xa9, 0x5f, 0x79, 0x75, 0xea,
+	0x93, 0x7f, 0x4f, 0xfd, 0xca, 0xaf, 0x63, 0x9f, 0x3c, 0x19, 0xfb, 0xe4, 0xe9, 0xd8, 0x27, 0xcf,
+	0xc6, 0x3e, 0xf9, 0x7b, 0xec, 0x93, 0x97, 0x63, 0xbf, 0xf2, 0x6a, 0xec, 0x93, 0xc7, 0x2f, 0xfc,
+	0xca, 0xb3, 0x17, 0x7e, 0xe5, 0xf9, 0x0b, 0xbf, 0x72, 0xb8, 0xa8, 0xff, 0xfe, 0x7c, 0xfe, 0xdf,
+	0x00, 0x12, 0x48, 0x5b, 0xc4, 0x60, 0x0d, 0x00, 0x00,
+}
+
+func (this *EnvelopeHost) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EnvelopeHost)
+	if !ok {
+		that2, ok := that.(EnvelopeHost)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.NodeID, that1.NodeID) {
+		return false
+	}
+	if this.ShortID != that1.ShortID {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	return true
+}
+func (this *Envelope) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Envelope)
+	if !ok {
+		that2, ok := that.(Envelope)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProtocolVersion != that1.ProtocolVersion {
+		return false
+	}
+	if !this.Sender.Equal(that1.Sender) {
+		return false
+	}
+	if !this.Receiver.Equal(that1.Receiver) {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.RequestID != that1.RequestID {
+		return false
+	}
+	if this.RemoteAddress != that1.RemoteAddress {
+		return false
+	}
+	if this.TraceID != that1.TraceID {
+		return false
+	}
+	if this.IsResponse != that1.IsResponse {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	if this.DataType != that1.DataType {
+		return false
+	}
+	return true
+}
+func (this *PayloadPulseSign) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PayloadPulseSign)
+	if !ok {
+		that2, ok := that.(PayloadPulseSign)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if this.PulseNumber != that1.PulseNumber {
+		return false
+	}
+	if this.ChosenPublicKey != that1.ChosenPublicKey {
+		return false
+	}
+	if !bytes.Equal(this.Entropy, that1.Entropy) {
+		return false
+	}
+	if !bytes.Equal(this.Signature, that1.Signature) {
+		return false
+	}
+	return true
+}
+func (this *PayloadPulse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PayloadPulse)
+	if !ok {
+		that2, ok := that.(PayloadPulse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PulseNumber != that1.PulseNumber {
+		return false
+	}
+	if this.PrevPulseNumber != that1.PrevPulseNumber {
+		return false
+	}
+	if this.NextPulseNumber != that1.NextPulseNumber {
+		return false
+	}
+	if this.PulseTimestamp != that1.PulseTimestamp {
+		return false
+	}
+	if this.EpochPulseNumber != that1.EpochPulseNumber {
+		return false
+	}
+	if !bytes.Equal(this.OriginID, that1.OriginID) {
+		return false
+	}
+	if !bytes.Equal(this.Entropy, that1.Entropy) {
+		return false
+	}
+	if len(this.Signs) != len(that1.Signs) {
+		return false
+	}
+	for i := range this.Signs {
+		if !this.Signs[i].Equal(that1.Signs[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *PayloadRequestPulse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PayloadRequestPulse)
+	if !ok {
+		that2, ok := that.(PayloadRequestPulse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Pulse.Equal(that1.Pulse) {
+		return false
+	}
+	return true
+}
+func (this *PayloadResponsePulse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PayloadResponsePulse)
+	if !ok {
+		that2, ok := that.(PayloadResponsePulse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Success != that1.Success {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
+func (this *PayloadRequestRPC) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PayloadRequestRPC)
+	if !ok {
+		that2, ok := that.(PayloadRequestRPC)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Method != that1.Method {
+		return false
+	}
+	if len(this.Data) != len(that1.Data) {
+		return false
+	}
+	for i := range this.Data {
+		if !bytes.Equal(this.Data[i], that1.Data[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *PayloadResponseRPC) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PayloadResponseRPC)
+	if !ok {
+		that2, ok := that.(PayloadResponseRPC)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Success != that1.Success {
+		return false
+	}
+	if !bytes.Equal(this.Result, that1.Result) {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
+func (this *PayloadRequestCascade) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PayloadRequestCascade)
+	if !ok {
+		that2, ok := that.(PayloadRequestCascade)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TraceID != that1.TraceID {
+		return false
+	}
+	if !this.RPC.Equal(that1.RPC) {
+		return false
+	}
+	if len(this.NodeIds) != len(that1.NodeIds) {
+		return false
+	}
+	for i := range this.NodeIds {
+		if !bytes.Equal(this.NodeIds[i], that1.NodeIds[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.Entropy, that1.Entropy) {
+		return false
+	}
+	if this.ReplicationFactor != that1.ReplicationFactor {
+		return false
+	}
+	return true
+}
+func (this *PayloadResponseCascade) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PayloadResponseCascade)
+	if !ok {
+		that2, ok := that.(PayloadResponseCascade)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Success != that1.Success {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
+func (this *PayloadChallengeRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PayloadChallengeRequest)
+	if !ok {
+		that2, ok := that.(PayloadChallengeRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SessionID != that1.SessionID {
+		return false
+	}
+	if !bytes.Equal(this.Nonce, that1.Nonce) {
+		return false
+	}
+	return true
+}
+func (this *PayloadSignedChallengeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PayloadSignedChallengeResponse)
+	if !ok {
+		that2, ok := that.(PayloadSignedChallengeResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Success != that1.Success {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	if this.HasPayload != that1.HasPayload {
+		return false
+	}
+	if !bytes.Equal(this.SignedNonce, that1.SignedNonce) {
+		return false
+	}
+	if !bytes.Equal(this.XorDiscoveryNonce, that1.XorDiscoveryNonce) {
+		return false
+	}
+	if !bytes.Equal(this.DiscoveryNonce, that1.DiscoveryNonce) {
+		return false
+	}
+	return true
+}
+func (this *PayloadSignedChallengeRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PayloadSignedChallengeRequest)
+	if !ok {
+		that2, ok := that.(PayloadSignedChallengeRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SessionID != that1.SessionID {
+		return false
+	}
+	if !bytes.Equal(this.SignedDiscoveryNonce, that1.SignedDiscoveryNonce) {
+		return false
+	}
+	if !bytes.Equal(this.XorNonce, that1.XorNonce) {
+		return false
+	}
+	return true
+}
+func (this *PayloadChallengeResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PayloadChallengeResponse)
+	if !ok {
+		that2, ok := that.(PayloadChallengeResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Success != that1.Success {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	if this.HasPayload != that1.HasPayload {
+		return false
+	}
+	if this.AssignShortID != that1.AssignShortID {
+		return false
+	}
+	return true
+}
+func (this *PayloadNodeBootstrapRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PayloadNodeBootstrapRequest)
+	if !ok {
+		that2, ok := that.(PayloadNodeBootstrapRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProtocolVersion != that1.ProtocolVersion {
+		return false
+	}
+	return true
+}
+func (this *PayloadNodeBootstrapResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PayloadNodeBootstrapResponse)
+	if !ok {
+		that2, ok := that.(PayloadNodeBootstrapResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Code != that1.Code {
+		return false
+	}
+	if this.RedirectHost != that1.RedirectHost {
+		return false
+	}
+	if this.RejectReason != that1.RejectReason {
+		return false
+	}
+	if this.NetworkSize != that1.NetworkSize {
+		return false
+	}
+	if this.ProtocolVersion != that1.ProtocolVersion {
+		return false
+	}
+	return true
+}
+func (this *PayloadNode) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PayloadNode)
+	if !ok {
+		that2, ok := that.(PayloadNode)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.ID, that1.ID) {
+		return false
+	}
+	if this.SID != that1.SID {
+		return false
+	}
+	if this.Role != that1.Role {
+		return false
+	}
+	if !bytes.Equal(this.PK, that1.PK) {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	return true
+}
+func (this *PayloadGenesisRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PayloadGenesisRequest)
+	if !ok {
+		that2, ok := that.(PayloadGenesisRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.LastPulse != that1.LastPulse {
+		return false
+	}
+	if !this.Discovery.Equal(that1.Discovery) {
+		return false
+	}
+	return true
+}
+func (this *PayloadGenesisResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PayloadGenesisResponse)
+	if !ok {
+		that2, ok := that.(PayloadGenesisResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Response.Equal(that1.Response) {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
+func (this *PayloadStartSessionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PayloadStartSessionRequest)
+	if !ok {
+		that2, ok := that.(PayloadStartSessionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *PayloadStartSessionResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PayloadStartSessionResponse)
+	if !ok {
+		that2, ok := that.(PayloadStartSessionResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SessionID != that1.SessionID {
+		return false
+	}
+	return true
+}
+func (this *PayloadAuthorizationRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PayloadAuthorizationRequest)
+	if !ok {
+		that2, ok := that.(PayloadAuthorizationRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Certificate, that1.Certificate) {
+		return false
+	}
+	return true
+}
+func (this *PayloadAuthorizationResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PayloadAuthorizationResponse)
+	if !ok {
+		that2, ok := that.(PayloadAuthorizationResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Code != that1.Code {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	if this.SessionID != that1.SessionID {
+		return false
+	}
+	return true
+}
+func (this *PayloadRegistrationRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PayloadRegistrationRequest)
+	if !ok {
+		that2, ok := that.(PayloadRegistrationRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SessionID != that1.SessionID {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if !bytes.Equal(this.JoinClaim, that1.JoinClaim) {
+		return false
+	}
+	return true
+}
+func (this *PayloadRegistrationResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PayloadRegistrationResponse)
+	if !ok {
+		that2, ok := that.(PayloadRegistrationResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Code != that1.Code {
+		return false
+	}
+	if this.RetryIn != that1.RetryIn {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
+func (this *PayloadTest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PayloadTest)
+	if !ok {
+		that2, ok := that.(PayloadTest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	return true
+}
+func (this *EnvelopeHost) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&packet.EnvelopeHost{")
+	s = append(s, "NodeID: "+fmt.Sprintf("%#v", this.NodeID)+",\n")
+	s = append(s, "ShortID: "+fmt.Sprintf("%#v", this.ShortID)+",\n")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Envelope) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 15)
+	s = append(s, "&packet.Envelope{")
+	s = append(s, "ProtocolVersion: "+fmt.Sprintf("%#v", this.ProtocolVersion)+",\n")
+	if this.Sender != nil {
+		s = append(s, "Sender: "+fmt.Sprintf("%#v", this.Sender)+",\n")
+	}
+	if this.Receiver != nil {
+		s = append(s, "Receiver: "+fmt.Sprintf("%#v", this.Receiver)+",\n")
+	}
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "RequestID: "+fmt.Sprintf("%#v", this.RequestID)+",\n")
+	s = append(s, "RemoteAddress: "+fmt.Sprintf("%#v", this.RemoteAddress)+",\n")
+	s = append(s, "TraceID: "+fmt.Sprintf("%#v", this.TraceID)+",\n")
+	s = append(s, "IsResponse: "+fmt.Sprintf("%#v", this.IsResponse)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "DataType: "+fmt.Sprintf("%#v", this.DataType)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PayloadPulseSign) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&packet.PayloadPulseSign{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "PulseNumber: "+fmt.Sprintf("%#v", this.PulseNumber)+",\n")
+	s = append(s, "ChosenPublicKey: "+fmt.Sprintf("%#v", this.ChosenPublicKey)+",\n")
+	s = append(s, "Entropy: "+fmt.Sprintf("%#v", this.Entropy)+",\n")
+	s = append(s, "Signature: "+fmt.Sprintf("%#v", this.Signature)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PayloadPulse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&packet.PayloadPulse{")
+	s = append(s, "PulseNumber: "+fmt.Sprintf("%#v", this.PulseNumber)+",\n")
+	s = append(s, "PrevPulseNumber: "+fmt.Sprintf("%#v", this.PrevPulseNumber)+",\n")
+	s = append(s, "NextPulseNumber: "+fmt.Sprintf("%#v", this.NextPulseNumber)+",\n")
+	s = append(s, "PulseTimestamp: "+fmt.Sprintf("%#v", this.PulseTimestamp)+",\n")
+	s = append(s, "EpochPulseNumber: "+fmt.Sprintf("%#v", this.EpochPulseNumber)+",\n")
+	s = append(s, "OriginID: "+fmt.Sprintf("%#v", this.OriginID)+",\n")
+	s = append(s, "Entropy: "+fmt.Sprintf("%#v", this.Entropy)+",\n")
+	if this.Signs != nil {
+		s = append(s, "Signs: "+fmt.Sprintf("%#v", this.Signs)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PayloadRequestPulse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&packet.PayloadRequestPulse{")
+	if this.Pulse != nil {
+		s = append(s, "Pulse: "+fmt.Sprintf("%#v", this.Pulse)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PayloadResponsePulse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&packet.PayloadResponsePulse{")
+	s = append(s, "Success: "+fmt.Sprintf("%#v", this.Success)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PayloadRequestRPC) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&packet.PayloadRequestRPC{")
+	s = append(s, "Method: "+fmt.Sprintf("%#v", this.Method)+",\n")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PayloadResponseRPC) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&packet.PayloadResponseRPC{")
+	s = append(s, "Success: "+fmt.Sprintf("%#v", this.Success)+",\n")
+	s = append(s, "Result: "+fmt.Sprintf("%#v", this.Result)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PayloadRequestCascade) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&packet.PayloadRequestCascade{")
+	s = append(s, "TraceID: "+fmt.Sprintf("%#v", this.TraceID)+",\n")
+	if this.RPC != nil {
+		s = append(s, "RPC: "+fmt.Sprintf("%#v", this.RPC)+",\n")
+	}
+	s = append(s, "NodeIds: "+fmt.Sprintf("%#v", this.NodeIds)+",\n")
+	s = append(s, "Entropy: "+fmt.Sprintf("%#v", this.Entropy)+",\n")
+	s = append(s, "ReplicationFactor: "+fmt.Sprintf("%#v", this.ReplicationFactor)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PayloadResponseCascade) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&packet.PayloadResponseCascade{")
+	s = append(s, "Success: "+fmt.Sprintf("%#v", this.Success)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PayloadChallengeRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&packet.PayloadChallengeRequest{")
+	s = append(s, "SessionID: "+fmt.Sprintf("%#v", this.SessionID)+",\n")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PayloadSignedChallengeResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&packet.PayloadSignedChallengeResponse{")
+	s = append(s, "Success: "+fmt.Sprintf("%#v", this.Success)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "HasPayload: "+fmt.Sprintf("%#v", this.HasPayload)+",\n")
+	s = append(s, "SignedNonce: "+fmt.Sprintf("%#v", this.SignedNonce)+",\n")
+	s = append(s, "XorDiscoveryNonce: "+fmt.Sprintf("%#v", this.XorDiscoveryNonce)+",\n")
+	s = append(s, "DiscoveryNonce: "+fmt.Sprintf("%#v", this.DiscoveryNonce)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PayloadSignedChallengeRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&packet.PayloadSignedChallengeRequest{")
+	s = append(s, "SessionID: "+fmt.Sprintf("%#v", this.SessionID)+",\n")
+	s = append(s, "SignedDiscoveryNonce: "+fmt.Sprintf("%#v", this.SignedDiscoveryNonce)+",\n")
+	s = append(s, "XorNonce: "+fmt.Sprintf("%#v", this.XorNonce)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PayloadChallengeResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&packet.PayloadChallengeResponse{")
+	s = append(s, "Success: "+fmt.Sprintf("%#v", this.Success)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "HasPayload: "+fmt.Sprintf("%#v", this.HasPayload)+",\n")
+	s = append(s, "AssignShortID: "+fmt.Sprintf("%#v", this.AssignShortID)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PayloadNodeBootstrapRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&packet.PayloadNodeBootstrapRequest{")
+	s = append(s, "ProtocolVersion: "+fmt.Sprintf("%#v", this.ProtocolVersion)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PayloadNodeBootstrapResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&packet.PayloadNodeBootstrapResponse{")
+	s = append(s, "Code: "+fmt.Sprintf("%#v", this.Code)+",\n")
+	s = append(s, "RedirectHost: "+fmt.Sprintf("%#v", this.RedirectHost)+",\n")
+	s = append(s, "RejectReason: "+fmt.Sprintf("%#v", this.RejectReason)+",\n")
+	s = append(s, "NetworkSize: "+fmt.Sprintf("%#v", this.NetworkSize)+",\n")
+	s = append(s, "ProtocolVersion: "+fmt.Sprintf("%#v", this.ProtocolVersion)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PayloadNode) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&packet.PayloadNode{")
+	s = append(s, "ID: "+fmt.Sprintf("%#v", this.ID)+",\n")
+	s = append(s, "SID: "+fmt.Sprintf("%#v", this.SID)+",\n")
+	s = append(s, "Role: "+fmt.Sprintf("%#v", this.Role)+",\n")
+	s = append(s, "PK: "+fmt.Sprintf("%#v", this.PK)+",\n")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PayloadGenesisRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&packet.PayloadGenesisRequest{")
+	s = append(s, "LastPulse: "+fmt.Sprintf("%#v", this.LastPulse)+",\n")
+	if this.Discovery != nil {
+		s = append(s, "Discovery: "+fmt.Sprintf("%#v", this.Discovery)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PayloadGenesisResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&packet.PayloadGenesisResponse{")
+	if this.Response != nil {
+		s = append(s, "Response: "+fmt.Sprintf("%#v", this.Response)+",\n")
+	}
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PayloadStartSessionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&packet.PayloadStartSessionRequest{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PayloadStartSessionResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&packet.PayloadStartSessionResponse{")
+	s = append(s, "SessionID: "+fmt.Sprintf("%#v", this.SessionID)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PayloadAuthorizationRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&packet.PayloadAuthorizationRequest{")
+	s = append(s, "Certificate: "+fmt.Sprintf("%#v", this.Certificate)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PayloadAuthorizationResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&packet.PayloadAuthorizationResponse{")
+	s = append(s, "Code: "+fmt.Sprintf("%#v", this.Code)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "SessionID: "+fmt.Sprintf("%#v", this.SessionID)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PayloadRegistrationRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&packet.PayloadRegistrationRequest{")
+	s = append(s, "SessionID: "+fmt.Sprintf("%#v", this.SessionID)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "JoinClaim: "+fmt.Sprintf("%#v", this.JoinClaim)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PayloadRegistrationResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&packet.PayloadRegistrationResponse{")
+	s = append(s, "Code: "+fmt.Sprintf("%#v", this.Code)+",\n")
+	s = append(s, "RetryIn: "+fmt.Sprintf("%#v", this.RetryIn)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PayloadTest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&packet.PayloadTest{")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringPacket(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *EnvelopeHost) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnvelopeHost) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.NodeID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.NodeID)))
+		i += copy(dAtA[i:], m.NodeID)
+	}
+	if m.ShortID != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(m.ShortID))
+	}
+	if len(m.Address) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
+	}
+	return i, nil
+}
+
+func (m *Envelope) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Envelope) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ProtocolVersion != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(m.ProtocolVersion))
+	}
+	if m.Sender != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(m.Sender.Size()))
+		n1, err := m.Sender.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if m.Receiver != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(m.Receiver.Size()))
+		n2, err := m.Receiver.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if m.Type != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(m.Type))
+	}
+	if m.RequestID != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(m.RequestID))
+	}
+	if len(m.RemoteAddress) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.RemoteAddress)))
+		i += copy(dAtA[i:], m.RemoteAddress)
+	}
+	if len(m.TraceID) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.TraceID)))
+		i += copy(dAtA[i:], m.TraceID)
+	}
+	if m.IsResponse {
+		dAtA[i] = 0x40
+		i++
+		if m.IsResponse {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	if len(m.Data) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	if m.DataType != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(m.DataType))
+	}
+	return i, nil
+}
+
+func (m *PayloadPulseSign) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayloadPulseSign) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Key)))
+		i += copy(dAtA[i:], m.Key)
+	}
+	if m.PulseNumber != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(m.PulseNumber))
+	}
+	if len(m.ChosenPublicKey) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.ChosenPublicKey)))
+		i += copy(dAtA[i:], m.ChosenPublicKey)
+	}
+	if len(m.Entropy) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Entropy)))
+		i += copy(dAtA[i:], m.Entropy)
+	}
+	if len(m.Signature) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Signature)))
+		i += copy(dAtA[i:], m.Signature)
+	}
+	return i, nil
+}
+
+func (m *PayloadPulse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayloadPulse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.PulseNumber != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(m.PulseNumber))
+	}
+	if m.PrevPulseNumber != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(m.PrevPulseNumber))
+	}
+	if m.NextPulseNumber != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(m.NextPulseNumber))
+	}
+	if m.PulseTimestamp != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(m.PulseTimestamp))
+	}
+	if m.EpochPulseNumber != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(m.EpochPulseNumber))
+	}
+	if len(m.OriginID) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.OriginID)))
+		i += copy(dAtA[i:], m.OriginID)
+	}
+	if len(m.Entropy) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Entropy)))
+		i += copy(dAtA[i:], m.Entropy)
+	}
+	if len(m.Signs) > 0 {
+		for _, msg := range m.Signs {
+			dAtA[i] = 0x42
+			i++
+			i = encodeVarintPacket(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *PayloadRequestPulse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayloadRequestPulse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Pulse != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(m.Pulse.Size()))
+		n3, err := m.Pulse.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	return i, nil
+}
+
+func (m *PayloadResponsePulse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayloadResponsePulse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Success {
+		dAtA[i] = 0x8
+		i++
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func (m *PayloadRequestRPC) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayloadRequestRPC) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Method) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Method)))
+		i += copy(dAtA[i:], m.Method)
+	}
+	if len(m.Data) > 0 {
+		for _, b := range m.Data {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPacket(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	return i, nil
+}
+
+func (m *PayloadResponseRPC) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayloadResponseRPC) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Success {
+		dAtA[i] = 0x8
+		i++
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Result) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Result)))
+		i += copy(dAtA[i:], m.Result)
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func (m *PayloadRequestCascade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayloadRequestCascade) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.TraceID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.TraceID)))
+		i += copy(dAtA[i:], m.TraceID)
+	}
+	if m.RPC != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(m.RPC.Size()))
+		n4, err := m.RPC.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if len(m.NodeIds) > 0 {
+		for _, b := range m.NodeIds {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintPacket(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if len(m.Entropy) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Entropy)))
+		i += copy(dAtA[i:], m.Entropy)
+	}
+	if m.ReplicationFactor != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(m.ReplicationFactor))
+	}
+	return i, nil
+}
+
+func (m *PayloadResponseCascade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayloadResponseCascade) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Success {
+		dAtA[i] = 0x8
+		i++
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func (m *PayloadChallengeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayloadChallengeRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.SessionID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(m.SessionID))
+	}
+	if len(m.Nonce) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Nonce)))
+		i += copy(dAtA[i:], m.Nonce)
+	}
+	return i, nil
+}
+
+func (m *PayloadSignedChallengeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayloadSignedChallengeResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Success {
+		dAtA[i] = 0x8
+		i++
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	if m.HasPayload {
+		dAtA[i] = 0x18
+		i++
+		if m.HasPayload {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.SignedNonce) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.SignedNonce)))
+		i += copy(dAtA[i:], m.SignedNonce)
+	}
+	if len(m.XorDiscoveryNonce) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.XorDiscoveryNonce)))
+		i += copy(dAtA[i:], m.XorDiscoveryNonce)
+	}
+	if len(m.DiscoveryNonce) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.DiscoveryNonce)))
+		i += copy(dAtA[i:], m.DiscoveryNonce)
+	}
+	return i, nil
+}
+
+func (m *PayloadSignedChallengeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayloadSignedChallengeRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.SessionID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(m.SessionID))
+	}
+	if len(m.SignedDiscoveryNonce) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.SignedDiscoveryNonce)))
+		i += copy(dAtA[i:], m.SignedDiscoveryNonce)
+	}
+	if len(m.XorNonce) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.XorNonce)))
+		i += copy(dAtA[i:], m.XorNonce)
+	}
+	return i, nil
+}
+
+func (m *PayloadChallengeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayloadChallengeResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Success {
+		dAtA[i] = 0x8
+		i++
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	if m.HasPayload {
+		dAtA[i] = 0x18
+		i++
+		if m.HasPayload {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.AssignShortID != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(m.AssignShortID))
+	}
+	return i, nil
+}
+
+func (m *PayloadNodeBootstrapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayloadNodeBootstrapRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ProtocolVersion != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(m.ProtocolVersion))
+	}
+	return i, nil
+}
+
+func (m *PayloadNodeBootstrapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayloadNodeBootstrapResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(m.Code))
+	}
+	if len(m.RedirectHost) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.RedirectHost)))
+		i += copy(dAtA[i:], m.RedirectHost)
+	}
+	if len(m.RejectReason) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.RejectReason)))
+		i += copy(dAtA[i:], m.RejectReason)
+	}
+	if m.NetworkSize != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(m.NetworkSize))
+	}
+	if m.ProtocolVersion != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(m.ProtocolVersion))
+	}
+	return i, nil
+}
+
+func (m *PayloadNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayloadNode) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
+	}
+	if m.SID != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(m.SID))
+	}
+	if m.Role != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(m.Role))
+	}
+	if len(m.PK) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.PK)))
+		i += copy(dAtA[i:], m.PK)
+	}
+	if len(m.Address) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
+	}
+	if len(m.Version) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Version)))
+		i += copy(dAtA[i:], m.Version)
+	}
+	return i, nil
+}
+
+func (m *PayloadGenesisRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayloadGenesisRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.LastPulse != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(m.LastPulse))
+	}
+	if m.Discovery != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(m.Discovery.Size()))
+		n5, err := m.Discovery.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	return i, nil
+}
+
+func (m *PayloadGenesisResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayloadGenesisResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Response != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(m.Response.Size()))
+		n6, err := m.Response.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func (m *PayloadStartSessionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayloadStartSessionRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *PayloadStartSessionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayloadStartSessionResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.SessionID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(m.SessionID))
+	}
+	return i, nil
+}
+
+func (m *PayloadAuthorizationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayloadAuthorizationRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Certificate) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Certificate)))
+		i += copy(dAtA[i:], m.Certificate)
+	}
+	return i, nil
+}
+
+func (m *PayloadAuthorizationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayloadAuthorizationResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(m.Code))
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	if m.SessionID != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(m.SessionID))
+	}
+	return i, nil
+}
+
+func (m *PayloadRegistrationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayloadRegistrationRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.SessionID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(m.SessionID))
+	}
+	if len(m.Version) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Version)))
+		i += copy(dAtA[i:], m.Version)
+	}
+	if len(m.JoinClaim) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.JoinClaim)))
+		i += copy(dAtA[i:], m.JoinClaim)
+	}
+	return i, nil
+}
+
+func (m *PayloadRegistrationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayloadRegistrationResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Code != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(m.Code))
+	}
+	if m.RetryIn != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(m.RetryIn))
+	}
+	if len(m.Error) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Error)))
+		i += copy(dAtA[i:], m.Error)
+	}
+	return i, nil
+}
+
+func (m *PayloadTest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayloadTest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	return i, nil
+}
+
+func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *EnvelopeHost) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeID)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.ShortID != 0 {
+		n += 1 + sovPacket(uint64(m.ShortID))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *Envelope) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProtocolVersion != 0 {
+		n += 1 + sovPacket(uint64(m.ProtocolVersion))
+	}
+	if m.Sender != nil {
+		l = m.Sender.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Receiver != nil {
+		l = m.Receiver.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovPacket(uint64(m.Type))
+	}
+	if m.RequestID != 0 {
+		n += 1 + sovPacket(uint64(m.RequestID))
+	}
+	l = len(m.RemoteAddress)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.TraceID)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.IsResponse {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.DataType != 0 {
+		n += 1 + sovPacket(uint64(m.DataType))
+	}
+	return n
+}
+
+func (m *PayloadPulseSign) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.PulseNumber != 0 {
+		n += 1 + sovPacket(uint64(m.PulseNumber))
+	}
+	l = len(m.ChosenPublicKey)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Entropy)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *PayloadPulse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PulseNumber != 0 {
+		n += 1 + sovPacket(uint64(m.PulseNumber))
+	}
+	if m.PrevPulseNumber != 0 {
+		n += 1 + sovPacket(uint64(m.PrevPulseNumber))
+	}
+	if m.NextPulseNumber != 0 {
+		n += 1 + sovPacket(uint64(m.NextPulseNumber))
+	}
+	if m.PulseTimestamp != 0 {
+		n += 1 + sovPacket(uint64(m.PulseTimestamp))
+	}
+	if m.EpochPulseNumber != 0 {
+		n += 1 + sovPacket(uint64(m.EpochPulseNumber))
+	}
+	l = len(m.OriginID)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Entropy)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if len(m.Signs) > 0 {
+		for _, e := range m.Signs {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PayloadRequestPulse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pulse != nil {
+		l = m.Pulse.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *PayloadResponsePulse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *PayloadRequestRPC) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if len(m.Data) > 0 {
+		for _, b := range m.Data {
+			l = len(b)
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PayloadResponseRPC) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *PayloadRequestCascade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TraceID)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.RPC != nil {
+		l = m.RPC.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if len(m.NodeIds) > 0 {
+		for _, b := range m.NodeIds {
+			l = len(b)
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	l = len(m.Entropy)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.ReplicationFactor != 0 {
+		n += 1 + sovPacket(uint64(m.ReplicationFactor))
+	}
+	return n
+}
+
+func (m *PayloadResponseCascade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *PayloadChallengeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SessionID != 0 {
+		n += 1 + sovPacket(uint64(m.SessionID))
+	}
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *PayloadSignedChallengeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.HasPayload {
+		n += 2
+	}
+	l = len(m.SignedNonce)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.XorDiscoveryNonce)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.DiscoveryNonce)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *PayloadSignedChallengeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SessionID != 0 {
+		n += 1 + sovPacket(uint64(m.SessionID))
+	}
+	l = len(m.SignedDiscoveryNonce)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.XorNonce)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *PayloadChallengeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.HasPayload {
+		n += 2
+	}
+	if m.AssignShortID != 0 {
+		n += 1 + sovPacket(uint64(m.AssignShortID))
+	}
+	return n
+}
+
+func (m *PayloadNodeBootstrapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProtocolVersion != 0 {
+		n += 1 + sovPacket(uint64(m.ProtocolVersion))
+	}
+	return n
+}
+
+func (m *PayloadNodeBootstrapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPacket(uint64(m.Code))
+	}
+	l = len(m.RedirectHost)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.RejectReason)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.NetworkSize != 0 {
+		n += 1 + sovPacket(uint64(m.NetworkSize))
+	}
+	if m.ProtocolVersion != 0 {
+		n += 1 + sovPacket(uint64(m.ProtocolVersion))
+	}
+	return n
+}
+
+func (m *PayloadNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.SID != 0 {
+		n += 1 + sovPacket(uint64(m.SID))
+	}
+	if m.Role != 0 {
+		n += 1 + sovPacket(uint64(m.Role))
+	}
+	l = len(m.PK)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *PayloadGenesisRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LastPulse != 0 {
+		n += 1 + sovPacket(uint64(m.LastPulse))
+	}
+	if m.Discovery != nil {
+		l = m.Discovery.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *PayloadGenesisResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Response != nil {
+		l = m.Response.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *PayloadStartSessionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PayloadStartSessionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SessionID != 0 {
+		n += 1 + sovPacket(uint64(m.SessionID))
+	}
+	return n
+}
+
+func (m *PayloadAuthorizationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Certificate)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *PayloadAuthorizationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPacket(uint64(m.Code))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.SessionID != 0 {
+		n += 1 + sovPacket(uint64(m.SessionID))
+	}
+	return n
+}
+
+func (m *PayloadRegistrationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SessionID != 0 {
+		n += 1 + sovPacket(uint64(m.SessionID))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.JoinClaim)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *PayloadRegistrationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovPacket(uint64(m.Code))
+	}
+	if m.RetryIn != 0 {
+		n += 1 + sovPacket(uint64(m.RetryIn))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *PayloadTest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func sovPacket(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozPacket(x uint64) (n int) {
+	return sovPacket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *EnvelopeHost) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EnvelopeHost{`,
+		`NodeID:` + fmt.Sprintf("%v", this.NodeID) + `,`,
+		`ShortID:` + fmt.Sprintf("%v", this.ShortID) + `,`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Envelope) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Envelope{`,
+		`ProtocolVersion:` + fmt.Sprintf("%v", this.ProtocolVersion) + `,`,
+		`Sender:` + strings.Replace(fmt.Sprintf("%v", this.Sender), "EnvelopeHost", "EnvelopeHost", 1) + `,`,
+		`Receiver:` + strings.Replace(fmt.Sprintf("%v", this.Receiver), "EnvelopeHost", "EnvelopeHost", 1) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`RequestID:` + fmt.Sprintf("%v", this.RequestID) + `,`,
+		`RemoteAddress:` + fmt.Sprintf("%v", this.RemoteAddress) + `,`,
+		`TraceID:` + fmt.Sprintf("%v", this.TraceID) + `,`,
+		`IsResponse:` + fmt.Sprintf("%v", this.IsResponse) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`DataType:` + fmt.Sprintf("%v", this.DataType) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PayloadPulseSign) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PayloadPulseSign{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`PulseNumber:` + fmt.Sprintf("%v", this.PulseNumber) + `,`,
+		`ChosenPublicKey:` + fmt.Sprintf("%v", this.ChosenPublicKey) + `,`,
+		`Entropy:` + fmt.Sprintf("%v", this.Entropy) + `,`,
+		`Signature:` + fmt.Sprintf("%v", this.Signature) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PayloadPulse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PayloadPulse{`,
+		`PulseNumber:` + fmt.Sprintf("%v", this.PulseNumber) + `,`,
+		`PrevPulseNumber:` + fmt.Sprintf("%v", this.PrevPulseNumber) + `,`,
+		`NextPulseNumber:` + fmt.Sprintf("%v", this.NextPulseNumber) + `,`,
+		`PulseTimestamp:` + fmt.Sprintf("%v", this.PulseTimestamp) + `,`,
+		`EpochPulseNumber:` + fmt.Sprintf("%v", this.EpochPulseNumber) + `,`,
+		`OriginID:` + fmt.Sprintf("%v", this.OriginID) + `,`,
+		`Entropy:` + fmt.Sprintf("%v", this.Entropy) + `,`,
+		`Signs:` + strings.Replace(fmt.Sprintf("%v", this.Signs), "PayloadPulseSign", "PayloadPulseSign", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PayloadRequestPulse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PayloadRequestPulse{`,
+		`Pulse:` + strings.Replace(fmt.Sprintf("%v", this.Pulse), "PayloadPulse", "PayloadPulse", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PayloadResponsePulse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PayloadResponsePulse{`,
+		`Success:` + fmt.Sprintf("%v", this.Success) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PayloadRequestRPC) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PayloadRequestRPC{`,
+		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PayloadResponseRPC) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PayloadResponseRPC{`,
+		`Success:` + fmt.Sprintf("%v", this.Success) + `,`,
+		`Result:` + fmt.Sprintf("%v", this.Result) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PayloadRequestCascade) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PayloadRequestCascade{`,
+		`TraceID:` + fmt.Sprintf("%v", this.TraceID) + `,`,
+		`RPC:` + strings.Replace(fmt.Sprintf("%v", this.RPC), "PayloadRequestRPC", "PayloadRequestRPC", 1) + `,`,
+		`NodeIds:` + fmt.Sprintf("%v", this.NodeIds) + `,`,
+		`Entropy:` + fmt.Sprintf("%v", this.Entropy) + `,`,
+		`ReplicationFactor:` + fmt.Sprintf("%v", this.ReplicationFactor) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PayloadResponseCascade) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PayloadResponseCascade{`,
+		`Success:` + fmt.Sprintf("%v", this.Success) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PayloadChallengeRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PayloadChallengeRequest{`,
+		`SessionID:` + fmt.Sprintf("%v", this.SessionID) + `,`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PayloadSignedChallengeResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PayloadSignedChallengeResponse{`,
+		`Success:` + fmt.Sprintf("%v", this.Success) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`HasPayload:` + fmt.Sprintf("%v", this.HasPayload) + `,`,
+		`SignedNonce:` + fmt.Sprintf("%v", this.SignedNonce) + `,`,
+		`XorDiscoveryNonce:` + fmt.Sprintf("%v", this.XorDiscoveryNonce) + `,`,
+		`DiscoveryNonce:` + fmt.Sprintf("%v", this.DiscoveryNonce) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PayloadSignedChallengeRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PayloadSignedChallengeRequest{`,
+		`SessionID:` + fmt.Sprintf("%v", this.SessionID) + `,`,
+		`SignedDiscoveryNonce:` + fmt.Sprintf("%v", this.SignedDiscoveryNonce) + `,`,
+		`XorNonce:` + fmt.Sprintf("%v", this.XorNonce) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PayloadChallengeResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PayloadChallengeResponse{`,
+		`Success:` + fmt.Sprintf("%v", this.Success) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`HasPayload:` + fmt.Sprintf("%v", this.HasPayload) + `,`,
+		`AssignShortID:` + fmt.Sprintf("%v", this.AssignShortID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PayloadNodeBootstrapRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PayloadNodeBootstrapRequest{`,
+		`ProtocolVersion:` + fmt.Sprintf("%v", this.ProtocolVersion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PayloadNodeBootstrapResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PayloadNodeBootstrapResponse{`,
+		`Code:` + fmt.Sprintf("%v", this.Code) + `,`,
+		`RedirectHost:` + fmt.Sprintf("%v", this.RedirectHost) + `,`,
+		`RejectReason:` + fmt.Sprintf("%v", this.RejectReason) + `,`,
+		`NetworkSize:` + fmt.Sprintf("%v", this.NetworkSize) + `,`,
+		`ProtocolVersion:` + fmt.Sprintf("%v", this.ProtocolVersion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PayloadNode) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PayloadNode{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`SID:` + fmt.Sprintf("%v", this.SID) + `,`,
+		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
+		`PK:` + fmt.Sprintf("%v", this.PK) + `,`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PayloadGenesisRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PayloadGenesisRequest{`,
+		`LastPulse:` + fmt.Sprintf("%v", this.LastPulse) + `,`,
+		`Discovery:` + strings.Replace(fmt.Sprintf("%v", this.Discovery), "PayloadNode", "PayloadNode", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PayloadGenesisResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PayloadGenesisResponse{`,
+		`Response:` + strings.Replace(fmt.Sprintf("%v", this.Response), "PayloadGenesisRequest", "PayloadGenesisRequest", 1) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PayloadStartSessionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PayloadStartSessionRequest{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PayloadStartSessionResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PayloadStartSessionResponse{`,
+		`SessionID:` + fmt.Sprintf("%v", this.SessionID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PayloadAuthorizationRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PayloadAuthorizationRequest{`,
+		`Certificate:` + fmt.Sprintf("%v", this.Certificate) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PayloadAuthorizationResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PayloadAuthorizationResponse{`,
+		`Code:` + fmt.Sprintf("%v", this.Code) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`SessionID:` + fmt.Sprintf("%v", this.SessionID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PayloadRegistrationRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PayloadRegistrationRequest{`,
+		`SessionID:` + fmt.Sprintf("%v", this.SessionID) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`JoinClaim:` + fmt.Sprintf("%v", this.JoinClaim) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PayloadRegistrationResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PayloadRegistrationResponse{`,
+		`Code:` + fmt.Sprintf("%v", this.Code) + `,`,
+		`RetryIn:` + fmt.Sprintf("%v", this.RetryIn) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PayloadTest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PayloadTest{`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringPacket(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *EnvelopeHost) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnvelopeHost: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnvelopeHost: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeID = append(m.NodeID[:0], dAtA[iNdEx:postIndex]...)
+			if m.NodeID == nil {
+				m.NodeID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShortID", wireType)
+			}
+			m.ShortID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShortID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Envelope) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Envelope: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Envelope: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolVersion", wireType)
+			}
+			m.ProtocolVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProtocolVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Sender == nil {
+				m.Sender = &EnvelopeHost{}
+			}
+			if err := m.Sender.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Receiver == nil {
+				m.Receiver = &EnvelopeHost{}
+			}
+			if err := m.Receiver.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestID", wireType)
+			}
+			m.RequestID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequestID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraceID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsResponse", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsResponse = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataType", wireType)
+			}
+			m.DataType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DataType |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayloadPulseSign) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayloadPulseSign: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayloadPulseSign: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PulseNumber", wireType)
+			}
+			m.PulseNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PulseNumber |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChosenPublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChosenPublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entropy", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entropy = append(m.Entropy[:0], dAtA[iNdEx:postIndex]...)
+			if m.Entropy == nil {
+				m.Entropy = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayloadPulse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayloadPulse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayloadPulse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PulseNumber", wireType)
+			}
+			m.PulseNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PulseNumber |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevPulseNumber", wireType)
+			}
+			m.PrevPulseNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrevPulseNumber |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPulseNumber", wireType)
+			}
+			m.NextPulseNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextPulseNumber |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PulseTimestamp", wireType)
+			}
+			m.PulseTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PulseTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochPulseNumber", wireType)
+			}
+			m.EpochPulseNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochPulseNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginID = append(m.OriginID[:0], dAtA[iNdEx:postIndex]...)
+			if m.OriginID == nil {
+				m.OriginID = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entropy", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entropy = append(m.Entropy[:0], dAtA[iNdEx:postIndex]...)
+			if m.Entropy == nil {
+				m.Entropy = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signs = append(m.Signs, &PayloadPulseSign{})
+			if err := m.Signs[len(m.Signs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayloadRequestPulse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayloadRequestPulse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayloadRequestPulse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pulse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pulse == nil {
+				m.Pulse = &PayloadPulse{}
+			}
+			if err := m.Pulse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayloadResponsePulse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayloadResponsePulse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayloadResponsePulse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayloadRequestRPC) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayloadRequestRPC: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayloadRequestRPC: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data, make([]byte, postIndex-iNdEx))
+			copy(m.Data[len(m.Data)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayloadResponseRPC) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayloadResponseRPC: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayloadResponseRPC: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
+			if m.Result == nil {
+				m.Result = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayloadRequestCascade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayloadRequestCascade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayloadRequestCascade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraceID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RPC", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RPC == nil {
+				m.RPC = &PayloadRequestRPC{}
+			}
+			if err := m.RPC.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeIds", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeIds = append(m.NodeIds, make([]byte, postIndex-iNdEx))
+			copy(m.NodeIds[len(m.NodeIds)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entropy", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entropy = append(m.Entropy[:0], dAtA[iNdEx:postIndex]...)
+			if m.Entropy == nil {
+				m.Entropy = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationFactor", wireType)
+			}
+			m.ReplicationFactor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplicationFactor |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayloadResponseCascade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayloadResponseCascade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayloadResponseCascade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayloadChallengeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayloadChallengeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayloadChallengeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
+			}
+			m.SessionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nonce = append(m.Nonce[:0], dAtA[iNdEx:postIndex]...)
+			if m.Nonce == nil {
+				m.Nonce = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayloadSignedChallengeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayloadSignedChallengeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayloadSignedChallengeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasPayload", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasPayload = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedNonce", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignedNonce = append(m.SignedNonce[:0], dAtA[iNdEx:postIndex]...)
+			if m.SignedNonce == nil {
+				m.SignedNonce = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XorDiscoveryNonce", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XorDiscoveryNonce = append(m.XorDiscoveryNonce[:0], dAtA[iNdEx:postIndex]...)
+			if m.XorDiscoveryNonce == nil {
+				m.XorDiscoveryNonce = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscoveryNonce", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DiscoveryNonce = append(m.DiscoveryNonce[:0], dAtA[iNdEx:postIndex]...)
+			if m.DiscoveryNonce == nil {
+				m.DiscoveryNonce = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayloadSignedChallengeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayloadSignedChallengeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayloadSignedChallengeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
+			}
+			m.SessionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedDiscoveryNonce", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignedDiscoveryNonce = append(m.SignedDiscoveryNonce[:0], dAtA[iNdEx:postIndex]...)
+			if m.SignedDiscoveryNonce == nil {
+				m.SignedDiscoveryNonce = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field XorNonce", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XorNonce = append(m.XorNonce[:0], dAtA[iNdEx:postIndex]...)
+			if m.XorNonce == nil {
+				m.XorNonce = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayloadChallengeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayloadChallengeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayloadChallengeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasPayload", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasPayload = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssignShortID", wireType)
+			}
+			m.AssignShortID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssignShortID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayloadNodeBootstrapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayloadNodeBootstrapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayloadNodeBootstrapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolVersion", wireType)
+			}
+			m.ProtocolVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProtocolVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayloadNodeBootstrapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayloadNodeBootstrapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayloadNodeBootstrapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedirectHost", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedirectHost = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RejectReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkSize", wireType)
+			}
+			m.NetworkSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NetworkSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolVersion", wireType)
+			}
+			m.ProtocolVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProtocolVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayloadNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayloadNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayloadNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SID", wireType)
+			}
+			m.SID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PK", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PK = append(m.PK[:0], dAtA[iNdEx:postIndex]...)
+			if m.PK == nil {
+				m.PK = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayloadGenesisRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayloadGenesisRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayloadGenesisRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPulse", wireType)
+			}
+			m.LastPulse = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastPulse |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discovery", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Discovery == nil {
+				m.Discovery = &PayloadNode{}
+			}
+			if err := m.Discovery.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayloadGenesisResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayloadGenesisResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayloadGenesisResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Response == nil {
+				m.Response = &PayloadGenesisRequest{}
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayloadStartSessionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayloadStartSessionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayloadStartSessionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayloadStartSessionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayloadStartSessionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayloadStartSessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
+			}
+			m.SessionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayloadAuthorizationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayloadAuthorizationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayloadAuthorizationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Certificate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Certificate = append(m.Certificate[:0], dAtA[iNdEx:postIndex]...)
+			if m.Certificate == nil {
+				m.Certificate = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayloadAuthorizationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayloadAuthorizationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayloadAuthorizationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
+			}
+			m.SessionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayloadRegistrationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayloadRegistrationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayloadRegistrationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionID", wireType)
+			}
+			m.SessionID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SessionID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinClaim", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JoinClaim = append(m.JoinClaim[:0], dAtA[iNdEx:postIndex]...)
+			if m.JoinClaim == nil {
+				m.JoinClaim = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayloadRegistrationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayloadRegistrationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayloadRegistrationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryIn", wireType)
+			}
+			m.RetryIn = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryIn |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayloadTest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayloadTest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayloadTest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPacket(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPacket
+			}
+			iNdEx += length
+			if iNdEx < 0 {
+				return 0, ErrInvalidLengthPacket
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowPacket
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipPacket(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+				if iNdEx < 0 {
+					return 0, ErrInvalidLengthPacket
+				}
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthPacket = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPacket   = fmt.Errorf("proto: integer overflow")
+)
@@ -54,9 +54,14 @@ import (
 	"bytes"
 	"crypto/rand"
 	"encoding/gob"
+	"io/ioutil"
+	"path/filepath"
 	"testing"
 
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/network"
 	"github.com/insolar/insolar/network/hostnetwork/host"
+	"github.com/insolar/insolar/network/hostnetwork/packet/types"
 	"github.com/insolar/insolar/testutils"
 	"github.com/stretchr/testify/require"
 )
@@ -91,6 +96,7 @@ func TestDeserializePacket(t *testing.T) {
 	deserialized, err := DeserializePacket(&buffer)
 
 	require.NoError(t, err)
+	msg.ProtocolVersion = network.ProtocolCurrent
 	require.Equal(t, deserialized, msg)
 }
 
@@ -115,3 +121,94 @@ func TestDeserializeBigPacket(t *testing.T) {
 	deserializedData := deserializedMsg.Data.(*RequestTest).Data
 	require.Equal(t, data, deserializedData)
 }
+
+func TestSerializePacketVersion(t *testing.T) {
+	for _, version := range []network.ProtocolVersion{network.ProtocolGob, network.ProtocolProtobuf} {
+		for _, f := range fixturePackets() {
+			serialized, err := SerializePacketVersion(f.packet, version)
+			require.NoError(t, err)
+			require.Equal(t, byte(version), serialized[headerSize-1])
+
+			deserialized, err := DeserializePacket(bytes.NewReader(serialized))
+			require.NoError(t, err)
+			// packets of all versions advertise the latest version supported by sender
+			f.packet.ProtocolVersion = network.ProtocolCurrent
+			require.Equal(t, f.packet, deserialized, "packet %s, version %d", f.name, version)
+		}
+	}
+}
+
+func TestSerializePacketVersion_Unsupported(t *testing.T) {
+	_, err := SerializePacketVersion(fixturePackets()[0].packet, network.ProtocolCurrent+1)
+	require.Error(t, err)
+}
+
+func TestSerializePacket_UnregisteredPayload(t *testing.T) {
+	sender, _ := host.NewHostN("127.0.0.1:31337", testutils.RandomRef())
+	msg := NewBuilder(sender).Receiver(sender).Type(TestPacket).Response(&ResponseTest{42}).Build()
+
+	_, err := SerializePacketVersion(msg, network.ProtocolProtobuf)
+	require.Error(t, err)
+}
+
+func TestDeserializePacket_UnsupportedVersion(t *testing.T) {
+	serialized, err := SerializePacket(fixturePackets()[0].packet)
+	require.NoError(t, err)
+	serialized[headerSize-1] = byte(network.ProtocolCurrent + 1)
+
+	_, err = DeserializePacket(bytes.NewReader(serialized))
+	require.Error(t, err)
+}
+
+// TestDeserializePacket_Fixtures checks that packets serialized by previous protocol versions are still decoded.
+func TestDeserializePacket_Fixtures(t *testing.T) {
+	versions := map[string]network.ProtocolVersion{
+		"v0": network.ProtocolGob,
+		"v1": network.ProtocolProtobuf,
+	}
+	for suffix, version := range versions {
+		for _, f := range fixturePackets() {
+			data, err := ioutil.ReadFile(filepath.Join("testdata", f.name+"."+suffix))
+			require.NoError(t, err)
+			require.Equal(t, byte(version), data[headerSize-1])
+
+			deserialized, err := DeserializePacket(bytes.NewReader(data))
+			require.NoError(t, err)
+			// legacy nodes do not advertise protocol version
+			f.packet.ProtocolVersion = version
+			require.Equal(t, f.packet, deserialized, "fixture %s.%s", f.name, suffix)
+		}
+	}
+}
+
+type packetFixture struct {
+	name   string
+	packet *Packet
+}
+
+func fixturePackets() []packetFixture {
+	var senderID, receiverID insolar.Reference
+	for i := range senderID {
+		senderID[i] = byte(i)
+		receiverID[i] = byte(255 - i)
+	}
+	sender, _ := host.NewHostNS("127.0.0.1:31337", senderID, 42)
+	receiver, _ := host.NewHostNS("127.0.0.2:31338", receiverID, 24)
+
+	return []packetFixture{
+		{
+			name:   "ping",
+			packet: NewBuilder(sender).Receiver(receiver).Type(types.Ping).RequestID(7).TraceID("trace").Build(),
+		},
+		{
+			name: "test_request",
+			packet: NewBuilder(sender).Receiver(receiver).Type(TestPacket).RequestID(8).
+				Request(&RequestTest{Data: []byte{0, 1, 2, 3}}).Build(),
+		},
+		{
+			name: "pulse_response",
+			packet: NewBuilder(receiver).Receiver(sender).Type(types.Pulse).RequestID(9).
+				Response(&ResponsePulse{Success: true}).Build(),
+		},
+	}
+}
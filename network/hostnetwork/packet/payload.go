@@ -0,0 +1,261 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+//
+
+package packet
+
+import (
+	"reflect"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+)
+
+// Payload is packet data that has protobuf wire representation. It is used by protocol versions after ProtocolGob.
+type Payload interface {
+	Marshal() ([]byte, error)
+	Unmarshal(data []byte) error
+}
+
+// PayloadType identifies type of packet data on wire. Values should never be changed or reused.
+type PayloadType uint32
+
+const (
+	PayloadTypeRequestTest PayloadType = iota + 1
+	PayloadTypeRequestPulse
+	PayloadTypeResponsePulse
+	PayloadTypeRequestRPC
+	PayloadTypeResponseRPC
+	PayloadTypeRequestCascade
+	PayloadTypeResponseCascade
+	PayloadTypeChallengeRequest
+	PayloadTypeSignedChallengeResponse
+	PayloadTypeSignedChallengeRequest
+	PayloadTypeChallengeResponse
+	PayloadTypeNodeBootstrapRequest
+	PayloadTypeNodeBootstrapResponse
+	PayloadTypeGenesisRequest
+	PayloadTypeGenesisResponse
+	PayloadTypeStartSessionRequest
+	PayloadTypeStartSessionResponse
+	PayloadTypeAuthorizationRequest
+	PayloadTypeAuthorizationResponse
+	PayloadTypeRegistrationRequest
+	PayloadTypeRegistrationResponse
+)
+
+var payloads = struct {
+	sync.RWMutex
+	types     map[reflect.Type]PayloadType
+	factories map[PayloadType]func() Payload
+}{
+	types:     make(map[reflect.Type]PayloadType),
+	factories: make(map[PayloadType]func() Payload),
+}
+
+// RegisterPayload registers factory of packet data of the type. Packet data should be a pointer returned by factory.
+func RegisterPayload(t PayloadType, factory func() Payload) {
+	payloads.Lock()
+	defer payloads.Unlock()
+
+	if _, ok := payloads.factories[t]; ok {
+		panic(errors.Errorf("payload type %d is already registered", t))
+	}
+	payloads.types[reflect.TypeOf(factory())] = t
+	payloads.factories[t] = factory
+}
+
+func marshalPayload(data interface{}) (PayloadType, []byte, error) {
+	payloads.RLock()
+	t, ok := payloads.types[reflect.TypeOf(data)]
+	payloads.RUnlock()
+	if !ok {
+		return 0, nil, errors.Errorf("payload type %T is not registered", data)
+	}
+	raw, err := data.(Payload).Marshal()
+	if err != nil {
+		return 0, nil, errors.Wrapf(err, "failed to marshal %T", data)
+	}
+	return t, raw, nil
+}
+
+func unmarshalPayload(t PayloadType, raw []byte) (interface{}, error) {
+	payloads.RLock()
+	factory, ok := payloads.factories[t]
+	payloads.RUnlock()
+	if !ok {
+		return nil, errors.Errorf("unknown payload type %d", t)
+	}
+	data := factory()
+	if err := data.Unmarshal(raw); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal %T", data)
+	}
+	return data, nil
+}
+
+// MarshalPulse converts pulse to its wire representation.
+func MarshalPulse(pulse *insolar.Pulse) *PayloadPulse {
+	result := &PayloadPulse{
+		PulseNumber:      uint32(pulse.PulseNumber),
+		PrevPulseNumber:  uint32(pulse.PrevPulseNumber),
+		NextPulseNumber:  uint32(pulse.NextPulseNumber),
+		PulseTimestamp:   pulse.PulseTimestamp,
+		EpochPulseNumber: int64(pulse.EpochPulseNumber),
+		OriginID:         pulse.OriginID[:],
+		Entropy:          pulse.Entropy[:],
+	}
+	keys := make([]string, 0, len(pulse.Signs))
+	for key := range pulse.Signs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		sign := pulse.Signs[key]
+		result.Signs = append(result.Signs, &PayloadPulseSign{
+			Key:             key,
+			PulseNumber:     uint32(sign.PulseNumber),
+			ChosenPublicKey: sign.ChosenPublicKey,
+			Entropy:         sign.Entropy[:],
+			Signature:       sign.Signature,
+		})
+	}
+	return result
+}
+
+// UnmarshalPulse converts wire representation to pulse.
+func UnmarshalPulse(data *PayloadPulse) (insolar.Pulse, error) {
+	var pulse insolar.Pulse
+	if data == nil {
+		return pulse, nil
+	}
+	if len(data.OriginID) != len(pulse.OriginID) || len(data.Entropy) != len(pulse.Entropy) {
+		return pulse, errors.New("invalid pulse origin or entropy length")
+	}
+	pulse.PulseNumber = insolar.PulseNumber(data.PulseNumber)
+	pulse.PrevPulseNumber = insolar.PulseNumber(data.PrevPulseNumber)
+	pulse.NextPulseNumber = insolar.PulseNumber(data.NextPulseNumber)
+	pulse.PulseTimestamp = data.PulseTimestamp
+	pulse.EpochPulseNumber = int(data.EpochPulseNumber)
+	copy(pulse.OriginID[:], data.OriginID)
+	copy(pulse.Entropy[:], data.Entropy)
+	if len(data.Signs) != 0 {
+		pulse.Signs = make(map[string]insolar.PulseSenderConfirmation, len(data.Signs))
+	}
+	for _, sign := range data.Signs {
+		confirmation := insolar.PulseSenderConfirmation{
+			PulseNumber:     insolar.PulseNumber(sign.PulseNumber),
+			ChosenPublicKey: sign.ChosenPublicKey,
+			Signature:       sign.Signature,
+		}
+		if len(sign.Entropy) != len(confirmation.Entropy) {
+			return pulse, errors.New("invalid pulse sign entropy length")
+		}
+		copy(confirmation.Entropy[:], sign.Entropy)
+		pulse.Signs[sign.Key] = confirmation
+	}
+	return pulse, nil
+}
+
+// Marshal implements Payload.
+func (r *RequestPulse) Marshal() ([]byte, error) {
+	return (&PayloadRequestPulse{Pulse: MarshalPulse(&r.Pulse)}).Marshal()
+}
+
+// Unmarshal implements Payload.
+func (r *RequestPulse) Unmarshal(data []byte) error {
+	wire := PayloadRequestPulse{}
+	if err := wire.Unmarshal(data); err != nil {
+		return err
+	}
+	pulse, err := UnmarshalPulse(wire.Pulse)
+	if err != nil {
+		return err
+	}
+	r.Pulse = pulse
+	return nil
+}
+
+// Marshal implements Payload.
+func (r *ResponsePulse) Marshal() ([]byte, error) {
+	return (&PayloadResponsePulse{Success: r.Success, Error: r.Error}).Marshal()
+}
+
+// Unmarshal implements Payload.
+func (r *ResponsePulse) Unmarshal(data []byte) error {
+	wire := PayloadResponsePulse{}
+	if err := wire.Unmarshal(data); err != nil {
+		return err
+	}
+	r.Success, r.Error = wire.Success, wire.Error
+	return nil
+}
+
+// Marshal implements Payload.
+func (r *RequestTest) Marshal() ([]byte, error) {
+	return (&PayloadTest{Data: r.Data}).Marshal()
+}
+
+// Unmarshal implements Payload.
+func (r *RequestTest) Unmarshal(data []byte) error {
+	wire := PayloadTest{}
+	if err := wire.Unmarshal(data); err != nil {
+		return err
+	}
+	r.Data = wire.Data
+	return nil
+}
+
+func init() {
+	RegisterPayload(PayloadTypeRequestTest, func() Payload { return &RequestTest{} })
+	RegisterPayload(PayloadTypeRequestPulse, func() Payload { return &RequestPulse{} })
+	RegisterPayload(PayloadTypeResponsePulse, func() Payload { return &ResponsePulse{} })
+}
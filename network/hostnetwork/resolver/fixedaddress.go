@@ -51,9 +51,9 @@
 package resolver
 
 import (
-	"fmt"
 	"net"
 	"net/url"
+	"strings"
 
 	"github.com/pkg/errors"
 )
@@ -85,5 +85,5 @@ func (r *fixedAddressResolver) Resolve(address string) (string, error) {
 	if port == "" {
 		return "", errors.New("Failed to extract port from uri: " + address)
 	}
-	return fmt.Sprintf("%s:%s", r.publicAddress, port), nil
+	return net.JoinHostPort(strings.Trim(r.publicAddress, "[]"), port), nil
 }
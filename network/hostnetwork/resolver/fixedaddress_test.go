@@ -71,6 +71,18 @@ func (s *FixedAddressResolverSuite) TestSuccess() {
 	s.Equal("192.168.0.1:12345", realAddress)
 }
 
+func (s *FixedAddressResolverSuite) TestSuccess_IPv6() {
+	r := NewFixedAddressResolver("fd00::1")
+	realAddress, err := r.Resolve("[::1]:12345")
+	s.NoError(err)
+	s.Equal("[fd00::1]:12345", realAddress)
+
+	r = NewFixedAddressResolver("[fd00::1]")
+	realAddress, err = r.Resolve("[::1]:12345")
+	s.NoError(err)
+	s.Equal("[fd00::1]:12345", realAddress)
+}
+
 func (s *FixedAddressResolverSuite) TestFailure_EmptyPort() {
 	localAddress := "empty_port"
 	externalAddress := "192.168.0.1"
@@ -129,8 +129,8 @@ func (h *hostTransport) BuildResponse(ctx context.Context, request network.Reque
 	return p
 }
 
-func NewInternalTransport(conf configuration.Configuration, nodeRef string) (network.InternalTransport, error) {
-	tp, publicAddress, err := transport.NewTransport(conf.Host.Transport)
+func NewInternalTransport(conf configuration.Configuration, nodeRef string, credentials *transport.Credentials) (network.InternalTransport, error) {
+	tp, publicAddress, err := transport.NewTransport(conf.Host.Transport, credentials)
 	if err != nil {
 		return nil, errors.Wrap(err, "error creating transport")
 	}
@@ -139,7 +139,12 @@ func NewInternalTransport(conf configuration.Configuration, nodeRef string) (net
 		return nil, errors.Wrap(err, "invalid nodeRef")
 	}
 
-	origin, err := host.NewHostN(publicAddress, *id)
+	return NewInternalTransportWithTransport(tp, publicAddress, *id)
+}
+
+// NewInternalTransportWithTransport creates InternalTransport on top of already created transport.
+func NewInternalTransportWithTransport(tp transport.Transport, publicAddress string, nodeID insolar.Reference) (network.InternalTransport, error) {
+	origin, err := host.NewHostN(publicAddress, nodeID)
 	if err != nil {
 		return nil, errors.Wrap(err, "error getting origin")
 	}
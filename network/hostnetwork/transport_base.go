@@ -134,3 +134,13 @@ func (h *transportBase) GetNodeID() insolar.Reference {
 func (h *transportBase) NewRequestBuilder() network.RequestBuilder {
 	return &Builder{sender: h.origin, id: network.RequestID(h.sequenceGenerator.Generate())}
 }
+
+// SetProtocolVersion sets wire format negotiated with remote host.
+func (h *transportBase) SetProtocolVersion(address string, version network.ProtocolVersion) {
+	h.transport.SetProtocolVersion(address, version)
+}
+
+// RetainProtocolVersions forgets wire formats of hosts that are not listed in addresses.
+func (h *transportBase) RetainProtocolVersions(addresses []string) {
+	h.transport.RetainProtocolVersions(addresses)
+}
@@ -161,7 +161,7 @@ func NewConsensusNetwork(address, nodeID string, shortID insolar.ShortNodeID) (n
 	conf.Address = address
 	conf.Protocol = "PURE_UDP"
 
-	tp, publicAddress, err := transport.NewTransport(conf)
+	tp, publicAddress, err := transport.NewTransport(conf, nil)
 	if err != nil {
 		return nil, errors.Wrap(err, "error creating transport")
 	}
@@ -170,11 +170,22 @@ func NewConsensusNetwork(address, nodeID string, shortID insolar.ShortNodeID) (n
 		return nil, errors.Wrap(err, "invalid nodeID")
 	}
 
-	origin, err := host.NewHostNS(publicAddress, *id, shortID)
+	result, err := NewConsensusNetworkWithTransport(tp, publicAddress, *id, shortID)
 	if err != nil {
 		go tp.Stop()
 		<-tp.Stopped()
 		tp.Close()
+		return nil, err
+	}
+	return result, nil
+}
+
+// NewConsensusNetworkWithTransport creates ConsensusNetwork on top of already created transport.
+func NewConsensusNetworkWithTransport(tp transport.Transport, publicAddress string, nodeID insolar.Reference,
+	shortID insolar.ShortNodeID) (network.ConsensusNetwork, error) {
+
+	origin, err := host.NewHostNS(publicAddress, nodeID, shortID)
+	if err != nil {
 		return nil, errors.Wrap(err, "error getting origin")
 	}
 	result := &transportConsensus{handlers: make(map[packets.PacketType]network.ConsensusPacketHandler)}
@@ -73,14 +73,18 @@ type consensusTransportSuite struct {
 }
 
 func createTwoConsensusNetworks(id1, id2 insolar.ShortNodeID) (t1, t2 network.ConsensusNetwork, err error) {
+	return createTwoConsensusNetworksOnAddress(id1, id2, "127.0.0.1:0")
+}
+
+func createTwoConsensusNetworksOnAddress(id1, id2 insolar.ShortNodeID, address string) (t1, t2 network.ConsensusNetwork, err error) {
 	m := newMockResolver()
 
-	cn1, err := NewConsensusNetwork("127.0.0.1:0", ID1+DOMAIN, id1)
+	cn1, err := NewConsensusNetwork(address, ID1+DOMAIN, id1)
 	cn1.(*transportConsensus).Resolver = m
 	if err != nil {
 		return nil, nil, err
 	}
-	cn2, err := NewConsensusNetwork("127.0.0.1:0", ID2+DOMAIN, id2)
+	cn2, err := NewConsensusNetwork(address, ID2+DOMAIN, id2)
 	cn2.(*transportConsensus).Resolver = m
 	if err != nil {
 		return nil, nil, err
@@ -178,7 +182,11 @@ func (t *consensusTransportSuite) TestSendPacketPhase3() {
 }
 
 func (t *consensusTransportSuite) sendPacketAndVerify(packet consensus.ConsensusPacket) {
-	cn1, cn2, err := createTwoConsensusNetworks(0, 1)
+	t.sendPacketAndVerifyOnAddress(packet, "127.0.0.1:0")
+}
+
+func (t *consensusTransportSuite) sendPacketAndVerifyOnAddress(packet consensus.ConsensusPacket, address string) {
+	cn1, cn2, err := createTwoConsensusNetworksOnAddress(0, 1, address)
 	t.Require().NoError(err)
 	ctx := context.Background()
 	ctx2 := context.Background()
@@ -217,6 +225,11 @@ func (t *consensusTransportSuite) sendPacketAndVerify(packet consensus.Consensus
 	t.True(<-result)
 }
 
+func (t *consensusTransportSuite) TestSendPacketPhase1_IPv6() {
+	packet := newPhase1Packet()
+	t.sendPacketAndVerifyOnAddress(packet, "[::1]:0")
+}
+
 func (t *consensusTransportSuite) TestStartStop() {
 	cn, err := NewConsensusNetwork("127.0.0.1:0", ID1+DOMAIN, 0)
 	t.Require().NoError(err)
@@ -53,6 +53,7 @@ package hostnetwork
 import (
 	"context"
 	"encoding/gob"
+	"strconv"
 	"sync"
 	"testing"
 	"time"
@@ -66,6 +67,7 @@ import (
 	"github.com/insolar/insolar/log"
 	"github.com/insolar/insolar/network"
 	"github.com/insolar/insolar/network/hostnetwork/host"
+	"github.com/insolar/insolar/network/hostnetwork/packet"
 	"github.com/insolar/insolar/network/hostnetwork/packet/types"
 	"github.com/insolar/insolar/network/utils"
 )
@@ -142,10 +144,10 @@ func mockConfiguration(address string) configuration.Configuration {
 func TestNewInternalTransport(t *testing.T) {
 	// broken address
 	ctx := context.Background()
-	_, err := NewInternalTransport(mockConfiguration("abirvalg"), ID1+DOMAIN)
+	_, err := NewInternalTransport(mockConfiguration("abirvalg"), ID1+DOMAIN, nil)
 	require.Error(t, err)
 	address := "127.0.0.1:0"
-	tp, err := NewInternalTransport(mockConfiguration(address), ID1+DOMAIN)
+	tp, err := NewInternalTransport(mockConfiguration(address), ID1+DOMAIN, nil)
 	require.NoError(t, err)
 	defer tp.Stop(ctx)
 	// require that new address with correct port has been assigned
@@ -157,7 +159,7 @@ func TestNewInternalTransport(t *testing.T) {
 
 func TestNewInternalTransport2(t *testing.T) {
 	ctx := context.Background()
-	tp, err := NewInternalTransport(mockConfiguration("127.0.0.1:0"), ID1+DOMAIN)
+	tp, err := NewInternalTransport(mockConfiguration("127.0.0.1:0"), ID1+DOMAIN, nil)
 	require.NoError(t, err)
 	go tp.Start(ctx)
 	time.Sleep(time.Millisecond)
@@ -169,14 +171,18 @@ func TestNewInternalTransport2(t *testing.T) {
 }
 
 func createTwoHostNetworks(id1, id2 string) (t1, t2 *TransportResolvable, err error) {
+	return createTwoHostNetworksOnAddress(id1, id2, "127.0.0.1:0")
+}
+
+func createTwoHostNetworksOnAddress(id1, id2, address string) (t1, t2 *TransportResolvable, err error) {
 	m := newMockResolver()
 
-	i1, err := NewInternalTransport(mockConfiguration("127.0.0.1:0"), ID1+DOMAIN)
+	i1, err := NewInternalTransport(mockConfiguration(address), ID1+DOMAIN, nil)
 	if err != nil {
 		return nil, nil, err
 	}
 	tr1 := &TransportResolvable{Transport: i1, Resolver: m}
-	i2, err := NewInternalTransport(mockConfiguration("127.0.0.1:0"), ID2+DOMAIN)
+	i2, err := NewInternalTransport(mockConfiguration(address), ID2+DOMAIN, nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -195,7 +201,7 @@ func createTwoHostNetworks(id1, id2 string) (t1, t2 *TransportResolvable, err er
 }
 
 func TestNewInternalTransport3(t *testing.T) {
-	_, err := NewInternalTransport(mockConfiguration("127.0.0.1:0"), "")
+	_, err := NewInternalTransport(mockConfiguration("127.0.0.1:0"), "", nil)
 	require.Error(t, err)
 }
 
@@ -242,7 +248,7 @@ func TestHostTransport_SendRequestPacket(t *testing.T) {
 	m := newMockResolver()
 	ctx := context.Background()
 
-	i1, err := NewInternalTransport(mockConfiguration("127.0.0.1:0"), ID1+DOMAIN)
+	i1, err := NewInternalTransport(mockConfiguration("127.0.0.1:0"), ID1+DOMAIN, nil)
 	require.NoError(t, err)
 	t1 := &TransportResolvable{Transport: i1, Resolver: m}
 	t1.Transport.Start(ctx)
@@ -309,16 +315,65 @@ func TestHostTransport_SendRequestPacket2(t *testing.T) {
 	wg.Wait()
 }
 
-func TestHostTransport_SendRequestPacket3(t *testing.T) {
-	t1, t2, err := createTwoHostNetworks(ID1+DOMAIN, ID2+DOMAIN)
+func TestHostTransport_SendRequestPacket_IPv6(t *testing.T) {
+	t1, t2, err := createTwoHostNetworksOnAddress(ID1+DOMAIN, ID2+DOMAIN, "[::1]:0")
 	require.NoError(t, err)
 	ctx := context.Background()
-	ctx2 := context.Background()
 
-	type Data struct {
-		Number int
+	address, err := host.NewAddress(t1.PublicAddress())
+	require.NoError(t, err)
+	require.Equal(t, host.AddressIPv6, address.Type())
+
+	handler := func(ctx context.Context, r network.Request) (network.Response, error) {
+		require.Equal(t, t1.PublicAddress(), r.GetSenderHost().Address.String())
+		return t2.BuildResponse(ctx, r, nil), nil
+	}
+	t2.RegisterRequestHandler(types.Ping, handler)
+
+	err = t2.Transport.Start(ctx)
+	require.NoError(t, err)
+	defer t2.Transport.Stop(ctx)
+	err = t1.Transport.Start(ctx)
+	require.NoError(t, err)
+	defer t1.Transport.Stop(ctx)
+
+	request := t1.NewRequestBuilder().Type(types.Ping).Data(nil).Build()
+	ref, err := insolar.NewReferenceFromBase58(ID2 + DOMAIN)
+	require.NoError(t, err)
+	f, err := t1.SendRequest(ctx, request, *ref)
+	require.NoError(t, err)
+	_, err = f.GetResponse(time.Second)
+	require.NoError(t, err)
+}
+
+type Data struct {
+	Number int
+}
+
+func (d *Data) Marshal() ([]byte, error) {
+	return (&packet.PayloadTest{Data: []byte(strconv.Itoa(d.Number))}).Marshal()
+}
+
+func (d *Data) Unmarshal(data []byte) error {
+	wire := packet.PayloadTest{}
+	err := wire.Unmarshal(data)
+	if err != nil {
+		return err
 	}
+	d.Number, err = strconv.Atoi(string(wire.Data))
+	return err
+}
+
+func init() {
 	gob.Register(&Data{})
+	packet.RegisterPayload(packet.PayloadType(1000), func() packet.Payload { return &Data{} })
+}
+
+func TestHostTransport_SendRequestPacket3(t *testing.T) {
+	t1, t2, err := createTwoHostNetworks(ID1+DOMAIN, ID2+DOMAIN)
+	require.NoError(t, err)
+	ctx := context.Background()
+	ctx2 := context.Background()
 
 	handler := func(ctx context.Context, r network.Request) (network.Response, error) {
 		log.Info("handler triggered")
@@ -428,7 +483,7 @@ func TestHostTransport_WrongHandler(t *testing.T) {
 
 func TestDoubleStart(t *testing.T) {
 	ctx := context.Background()
-	tp, err := NewInternalTransport(mockConfiguration("127.0.0.1:0"), ID1+DOMAIN)
+	tp, err := NewInternalTransport(mockConfiguration("127.0.0.1:0"), ID1+DOMAIN, nil)
 	require.NoError(t, err)
 
 	err = tp.Start(ctx)
@@ -441,7 +496,7 @@ func TestDoubleStart(t *testing.T) {
 
 func TestStartStop(t *testing.T) {
 	ctx := context.Background()
-	tp, err := NewInternalTransport(mockConfiguration("127.0.0.1:0"), ID1+DOMAIN)
+	tp, err := NewInternalTransport(mockConfiguration("127.0.0.1:0"), ID1+DOMAIN, nil)
 	require.NoError(t, err)
 
 	err = tp.Start(ctx)
@@ -52,6 +52,7 @@ package network
 
 import (
 	"context"
+	"crypto"
 	"time"
 
 	"github.com/insolar/insolar/component"
@@ -127,6 +128,19 @@ type ConsensusNetwork interface {
 // RequestID is 64 bit unsigned int request id.
 type RequestID uint64
 
+// ProtocolVersion is a version of hostnetwork packets wire format.
+type ProtocolVersion uint8
+
+const (
+	// ProtocolGob is a legacy wire format with gob encoded packets.
+	ProtocolGob = ProtocolVersion(iota)
+	// ProtocolProtobuf is a wire format with protobuf packet envelope.
+	ProtocolProtobuf
+
+	// ProtocolCurrent is the latest wire format supported by node.
+	ProtocolCurrent = ProtocolProtobuf
+)
+
 // Packet is a packet that is transported via network by HostNetwork.
 type Packet interface {
 	GetSender() insolar.Reference
@@ -187,6 +201,8 @@ type NodeKeeper interface {
 	GetClaimQueue() ClaimQueue
 	// GetSnapshotCopy get copy of the current nodekeeper snapshot
 	GetSnapshotCopy() *node.Snapshot
+	// GetNodeKeys get public keys of nodes from active and sync lists including joiners approved by consensus
+	GetNodeKeys() []crypto.PublicKey
 	// Sync move unsync -> sync
 	Sync(context.Context, []insolar.NetworkNode, []consensus.ReferendumClaim) error
 	// MoveSyncToActive merge sync list with active nodes
@@ -272,6 +288,10 @@ type InternalTransport interface {
 	NewRequestBuilder() RequestBuilder
 	// BuildResponse create response to an incoming request with Data set to responseData.
 	BuildResponse(ctx context.Context, request Request, responseData interface{}) Response
+	// SetProtocolVersion sets wire format negotiated with remote host.
+	SetProtocolVersion(address string, version ProtocolVersion)
+	// RetainProtocolVersions forgets wire formats of hosts that are not listed in addresses.
+	RetainProtocolVersions(addresses []string)
 }
 
 // ClaimQueue is the queue that contains consensus claims.
@@ -287,6 +307,23 @@ type ClaimQueue interface {
 	Push(claim consensus.ReferendumClaim)
 }
 
+// FeatureActivator coordinates activation of features advertised by nodes with capability claims.
+//go:generate minimock -i github.com/insolar/insolar/network.FeatureActivator -o ../testutils/network -s _mock.go
+type FeatureActivator interface {
+	// SupportedFeatures returns keys of features supported by the origin node.
+	SupportedFeatures() []string
+	// ActivatedFeatures returns activation pulses of features activated by the network.
+	ActivatedFeatures() map[string]insolar.PulseNumber
+	// ProcessCapabilities takes into account features advertised by active nodes and activation pulses reported by them.
+	// Returns activation pulses of features that became activated for the origin node at the pulse.
+	ProcessCapabilities(
+		pulse insolar.PulseNumber,
+		active []insolar.Reference,
+		advertised map[string][]insolar.Reference,
+		reported map[string]map[insolar.Reference]insolar.PulseNumber,
+	) map[string]insolar.PulseNumber
+}
+
 // Accessor is interface that provides read access to nodekeeper internal snapshot
 type Accessor interface {
 	// GetWorkingNode get working node by its reference. Returns nil if node is not found or is not working.
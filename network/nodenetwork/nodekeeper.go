@@ -52,6 +52,7 @@ package nodenetwork
 
 import (
 	"context"
+	"crypto"
 	"net"
 	"sync"
 
@@ -67,6 +68,7 @@ import (
 	"github.com/insolar/insolar/network"
 	"github.com/insolar/insolar/network/transport"
 	"github.com/insolar/insolar/network/utils"
+	"github.com/insolar/insolar/platformpolicy"
 	"github.com/insolar/insolar/version"
 	"github.com/pkg/errors"
 	"go.opencensus.io/stats"
@@ -150,8 +152,9 @@ type nodekeeper struct {
 	isBootstrap     bool
 	isBootstrapLock sync.RWMutex
 
-	Cryptography       insolar.CryptographyService `inject:""`
-	TerminationHandler insolar.TerminationHandler  `inject:""`
+	Cryptography               insolar.CryptographyService        `inject:""`
+	PlatformCryptographyScheme insolar.PlatformCryptographyScheme `inject:""`
+	TerminationHandler         insolar.TerminationHandler         `inject:""`
 }
 
 func (nk *nodekeeper) GetSnapshotCopy() *node.Snapshot {
@@ -161,6 +164,33 @@ func (nk *nodekeeper) GetSnapshotCopy() *node.Snapshot {
 	return nk.snapshot.Copy()
 }
 
+func (nk *nodekeeper) GetNodeKeys() []crypto.PublicKey {
+	var keys []crypto.PublicKey
+	for _, n := range nk.GetAccessor().GetActiveNodes() {
+		keys = append(keys, n.PublicKey())
+	}
+
+	nk.syncLock.Lock()
+	defer nk.syncLock.Unlock()
+
+	for _, n := range nk.syncNodes {
+		keys = append(keys, n.PublicKey())
+	}
+	for _, claim := range nk.syncClaims {
+		join, ok := claim.(*consensus.NodeJoinClaim)
+		if !ok {
+			continue
+		}
+		key, err := platformpolicy.NewKeyProcessor().ImportPublicKeyBinary(join.NodePK[:])
+		if err != nil {
+			log.Warnf("Failed to import public key of joiner %s: %s", join.NodeRef, err.Error())
+			continue
+		}
+		keys = append(keys, key)
+	}
+	return keys
+}
+
 func (nk *nodekeeper) SetInitialSnapshot(nodes []insolar.NetworkNode) {
 	nk.activeLock.Lock()
 	defer nk.activeLock.Unlock()
@@ -330,12 +360,15 @@ func (nk *nodekeeper) MoveSyncToActive(ctx context.Context) error {
 		nk.activeLock.Unlock()
 	}()
 
-	mergeResult, err := GetMergedCopy(nk.syncNodes, nk.syncClaims)
+	mergeResult, err := GetMergedCopy(nk.syncNodes, nk.syncClaims, nk.PlatformCryptographyScheme, nk.Cryptography)
 	if err != nil {
 		return errors.Wrap(err, "[ MoveSyncToActive ] Failed to calculate new active list")
 	}
 	inslogger.FromContext(ctx).Infof("[ MoveSyncToActive ] New active list confirmed. Active list size: %d -> %d",
 		len(nk.accessor.GetActiveNodes()), len(mergeResult.ActiveList))
+	for _, ref := range mergeResult.Excluded {
+		inslogger.FromContext(ctx).Warnf("[ MoveSyncToActive ] Node %s is excluded from active list: violation is proved", ref)
+	}
 
 	nk.snapshot = node.NewSnapshot(insolar.PulseNumber(0), mergeResult.ActiveList)
 	nk.accessor = node.NewAccessor(nk.snapshot)
@@ -51,6 +51,7 @@
 package nodenetwork
 
 import (
+	insconsensus "github.com/insolar/insolar/consensus"
 	consensus "github.com/insolar/insolar/consensus/packets"
 	"github.com/insolar/insolar/insolar"
 	"github.com/insolar/insolar/network/node"
@@ -60,6 +61,9 @@ import (
 type MergedListCopy struct {
 	ActiveList                 map[insolar.Reference]insolar.NetworkNode
 	NodesJoinedDuringPrevPulse bool
+	// Excluded contains nodes removed from active list because majority of active nodes blamed them with evidence
+	// signed by the blamed node
+	Excluded []insolar.Reference
 }
 
 func copyActiveNodes(nodes []insolar.NetworkNode) map[insolar.Reference]insolar.NetworkNode {
@@ -71,7 +75,14 @@ func copyActiveNodes(nodes []insolar.NetworkNode) map[insolar.Reference]insolar.
 	return result
 }
 
-func GetMergedCopy(nodes []insolar.NetworkNode, claims []consensus.ReferendumClaim) (*MergedListCopy, error) {
+// GetMergedCopy applies claims to the copy of active nodes. Nodes blamed by majority of active nodes are excluded,
+// blames are verified with provided scheme and cryptography service.
+func GetMergedCopy(
+	nodes []insolar.NetworkNode,
+	claims []consensus.ReferendumClaim,
+	scheme insolar.PlatformCryptographyScheme,
+	cryptography insolar.CryptographyService,
+) (*MergedListCopy, error) {
 	nodesMap := copyActiveNodes(nodes)
 
 	var nodesJoinedDuringPrevPulse bool
@@ -84,12 +95,67 @@ func GetMergedCopy(nodes []insolar.NetworkNode, claims []consensus.ReferendumCla
 		nodesJoinedDuringPrevPulse = nodesJoinedDuringPrevPulse || isJoin
 	}
 
+	excluded := provenViolators(nodes, claims, scheme, cryptography)
+	for _, ref := range excluded {
+		delete(nodesMap, ref)
+	}
+
 	return &MergedListCopy{
 		ActiveList:                 nodesMap,
 		NodesJoinedDuringPrevPulse: nodesJoinedDuringPrevPulse,
+		Excluded:                   excluded,
 	}, nil
 }
 
+// provenViolators returns active nodes that are blamed for violations with valid evidence by more than a half of
+// active nodes. Each blamer is counted once per blamed node and only blames from active nodes are counted.
+func provenViolators(
+	nodes []insolar.NetworkNode,
+	claims []consensus.ReferendumClaim,
+	scheme insolar.PlatformCryptographyScheme,
+	cryptography insolar.CryptographyService,
+) []insolar.Reference {
+	active := make(map[insolar.Reference]insolar.NetworkNode, len(nodes))
+	shortIDs := make(map[insolar.ShortNodeID]insolar.NetworkNode, len(nodes))
+	for _, n := range nodes {
+		active[n.ID()] = n
+		shortIDs[n.ShortID()] = n
+	}
+
+	blamers := make(map[insolar.Reference]map[insolar.Reference]struct{})
+	for _, claim := range claims {
+		blame, ok := claim.(*consensus.NodeViolationBlame)
+		if !ok {
+			continue
+		}
+		if _, ok := active[blame.BlamerID]; !ok {
+			continue
+		}
+		blamed, ok := shortIDs[insolar.ShortNodeID(blame.BlameNodeID)]
+		if !ok || blamed.ID().Equal(blame.BlamerID) {
+			continue
+		}
+		if _, ok := blamers[blamed.ID()][blame.BlamerID]; ok {
+			continue
+		}
+		if blame.Verify(scheme, cryptography, blamed.PublicKey()) != nil {
+			continue
+		}
+		if blamers[blamed.ID()] == nil {
+			blamers[blamed.ID()] = make(map[insolar.Reference]struct{})
+		}
+		blamers[blamed.ID()][blame.BlamerID] = struct{}{}
+	}
+
+	result := make([]insolar.Reference, 0)
+	for _, n := range nodes {
+		if insconsensus.ReachedMajority(len(blamers[n.ID()]), len(nodes)) {
+			result = append(result, n.ID())
+		}
+	}
+	return result
+}
+
 func mergeClaim(nodes map[insolar.Reference]insolar.NetworkNode, claim consensus.ReferendumClaim) (bool, error) {
 	isJoinClaim := false
 	switch t := claim.(type) {
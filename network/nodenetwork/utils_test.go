@@ -0,0 +1,130 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+
+package nodenetwork
+
+import (
+	"testing"
+
+	"github.com/insolar/insolar/consensus/packets"
+	"github.com/insolar/insolar/cryptography"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/network/node"
+	"github.com/insolar/insolar/platformpolicy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func signPulseProof(t *testing.T, cs insolar.CryptographyService, pulseHash []byte, stateHash byte) packets.NodePulseProof {
+	proof := packets.NodePulseProof{NodeStateHash: [packets.HashLength]byte{stateHash}}
+	hasher := platformpolicy.NewPlatformCryptographyScheme().IntegrityHasher()
+	_, _ = hasher.Write(pulseHash)
+	_, _ = hasher.Write(proof.StateHash())
+	signature, err := cs.Sign(hasher.Sum(nil))
+	require.NoError(t, err)
+	copy(proof.NodeSignature[:], signature.Bytes())
+	return proof
+}
+
+func newBlame(
+	t *testing.T, cs insolar.CryptographyService, blamer, blamed insolar.NetworkNode, stateHash byte,
+) *packets.NodeViolationBlame {
+	blame := &packets.NodeViolationBlame{
+		BlameNodeID:   uint32(blamed.ShortID()),
+		TypeViolation: packets.ViolationConflictingProofs,
+		PulseHash:     [packets.HashLength]byte{42},
+	}
+	blame.Evidence[0] = signPulseProof(t, cs, blame.PulseHash[:], 1)
+	blame.Evidence[1] = signPulseProof(t, cs, blame.PulseHash[:], stateHash)
+	blame.AddSupplementaryInfo(blamer.ID())
+	return blame
+}
+
+func TestGetMergedCopy_ExcludesProvenViolators(t *testing.T) {
+	kp := platformpolicy.NewKeyProcessor()
+	nodes := make([]insolar.NetworkNode, 0, 5)
+	services := make([]insolar.CryptographyService, 0, 5)
+	for i := 1; i <= 5; i++ {
+		privateKey, err := kp.GeneratePrivateKey()
+		require.NoError(t, err)
+		services = append(services, cryptography.NewKeyBoundCryptographyService(privateKey))
+		n := node.NewNode(insolar.Reference{byte(i)}, insolar.StaticRoleVirtual, kp.ExtractPublicKey(privateKey), "127.0.0.1:0", "")
+		n.(node.MutableNode).SetShortID(insolar.ShortNodeID(i))
+		nodes = append(nodes, n)
+	}
+	violator, minority, forged := nodes[0], nodes[1], nodes[2]
+	unknown := node.NewNode(insolar.Reference{42}, insolar.StaticRoleVirtual, violator.PublicKey(), "127.0.0.1:0", "")
+
+	claims := []packets.ReferendumClaim{
+		// majority of active nodes blame the violator with proofs signed by it
+		newBlame(t, services[0], nodes[2], violator, 2),
+		newBlame(t, services[0], nodes[3], violator, 2),
+		newBlame(t, services[0], nodes[4], violator, 2),
+		// repeated blames and blames from the node itself or unknown nodes are not counted
+		newBlame(t, services[1], nodes[3], minority, 2),
+		newBlame(t, services[1], nodes[3], minority, 3),
+		newBlame(t, services[1], minority, minority, 2),
+		newBlame(t, services[1], unknown, minority, 2),
+		newBlame(t, services[1], nodes[4], minority, 2),
+		// proofs signed by other node don't prove violation
+		newBlame(t, services[4], nodes[0], forged, 2),
+		newBlame(t, services[4], nodes[3], forged, 2),
+		newBlame(t, services[4], nodes[4], forged, 2),
+	}
+
+	scheme := platformpolicy.NewPlatformCryptographyScheme()
+	result, err := GetMergedCopy(nodes, claims, scheme, services[3])
+	require.NoError(t, err)
+
+	assert.Equal(t, []insolar.Reference{violator.ID()}, result.Excluded)
+	assert.Len(t, result.ActiveList, 4)
+	assert.NotContains(t, result.ActiveList, violator.ID())
+	assert.Contains(t, result.ActiveList, minority.ID())
+	assert.Contains(t, result.ActiveList, forged.ID())
+}
@@ -81,11 +81,12 @@ type CommunicatorMock struct {
 
 func (cm *CommunicatorMock) ExchangePhase1(
 	ctx context.Context,
+	state *phases.ConsensusState,
 	originClaim *packets.NodeAnnounceClaim,
 	participants []insolar.NetworkNode,
 	packet *packets.Phase1Packet,
 ) (map[insolar.Reference]*packets.Phase1Packet, error) {
-	pckts, err := cm.communicator.ExchangePhase1(ctx, originClaim, participants, packet)
+	pckts, err := cm.communicator.ExchangePhase1(ctx, state, originClaim, participants, packet)
 	if err != nil {
 		return nil, err
 	}
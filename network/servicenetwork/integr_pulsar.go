@@ -80,7 +80,7 @@ func NewTestPulsar(pulseTimeMs, requestsTimeoutMs, pulseDelta int32) (TestPulsar
 		Protocol: "TCP",
 		Address:  "127.0.0.1:0",
 	}
-	tp, publicAddress, err := transport.NewTransport(transportCfg)
+	tp, publicAddress, err := transport.NewTransport(transportCfg, nil)
 	if err != nil {
 		return nil, errors.Wrap(err, "Failed to create distributor transport")
 	}
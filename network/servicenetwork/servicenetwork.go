@@ -74,6 +74,7 @@ import (
 	"github.com/insolar/insolar/network/hostnetwork"
 	"github.com/insolar/insolar/network/merkle"
 	"github.com/insolar/insolar/network/routing"
+	"github.com/insolar/insolar/network/transport"
 	"github.com/insolar/insolar/network/utils"
 )
 
@@ -87,14 +88,16 @@ type ServiceNetwork struct {
 	PulseManager        insolar.PulseManager        `inject:""`
 	PulseAccessor       pulse.Accessor              `inject:""`
 	CryptographyService insolar.CryptographyService `inject:""`
+	KeyStore            insolar.KeyStore            `inject:""`
 	NetworkCoordinator  insolar.NetworkCoordinator  `inject:""`
 	NodeKeeper          network.NodeKeeper          `inject:""`
 	NetworkSwitcher     insolar.NetworkSwitcher     `inject:""`
 	TerminationHandler  insolar.TerminationHandler  `inject:""`
 
 	// subcomponents
-	PhaseManager phases.PhaseManager `inject:"subcomponent"`
-	Controller   network.Controller  `inject:"subcomponent"`
+	PhaseManager phases.PhaseManager       `inject:"subcomponent"`
+	Controller   network.Controller        `inject:"subcomponent"`
+	Transport    network.InternalTransport `inject:"subcomponent"`
 
 	isGenesis   bool
 	isDiscovery bool
@@ -126,7 +129,17 @@ func (n *ServiceNetwork) RemoteProcedureRegister(name string, method insolar.Rem
 
 // Start implements component.Initer
 func (n *ServiceNetwork) Init(ctx context.Context) error {
-	internalTransport, err := hostnetwork.NewInternalTransport(n.cfg, n.CertificateManager.GetCertificate().GetNodeRef().String())
+	privateKey, err := n.KeyStore.GetPrivateKey("")
+	if err != nil {
+		return errors.Wrap(err, "Failed to get node private key")
+	}
+	credentials := &transport.Credentials{
+		PrivateKey:  privateKey,
+		Certificate: n.CertificateManager.GetCertificate(),
+		Peers:       n.NodeKeeper,
+	}
+
+	internalTransport, err := hostnetwork.NewInternalTransport(n.cfg, n.CertificateManager.GetCertificate().GetNodeRef().String(), credentials)
 	if err != nil {
 		return errors.Wrap(err, "Failed to create internal transport")
 	}
@@ -297,7 +310,19 @@ func (n *ServiceNetwork) phaseManagerOnPulse(ctx context.Context, newPulse insol
 	if err := n.PhaseManager.OnPulse(ctx, &newPulse, pulseStartTime); err != nil {
 		logger.Error("Failed to pass consensus: " + err.Error())
 		n.TerminationHandler.Abort()
+		return
+	}
+	n.forgetLeftHosts()
+}
+
+// forgetLeftHosts drops wire formats negotiated with hosts that are not active anymore.
+func (n *ServiceNetwork) forgetLeftHosts() {
+	nodes := n.NodeKeeper.GetAccessor().GetActiveNodes()
+	addresses := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		addresses = append(addresses, node.Address())
 	}
+	n.Transport.RetainProtocolVersions(addresses)
 }
 
 func (n *ServiceNetwork) connectToNewNetwork(result network.BootstrapResult) {
@@ -72,11 +72,14 @@ import (
 	"github.com/insolar/insolar/consensus/packets"
 	"github.com/insolar/insolar/cryptography"
 	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/keystore"
 	"github.com/insolar/insolar/log"
 	"github.com/insolar/insolar/network"
 	"github.com/insolar/insolar/network/nodenetwork"
+	"github.com/insolar/insolar/network/telemetry"
 	"github.com/insolar/insolar/platformpolicy"
 	"github.com/insolar/insolar/testutils"
+	"github.com/insolar/insolar/version/manager"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -415,6 +418,7 @@ func (s *testSuite) preInitNode(node *networkNode) {
 	cfg := configuration.NewConfiguration()
 	cfg.Pulsar.PulseTime = pulseTimeMs // pulse 5 sec for faster tests
 	cfg.Host.Transport.Address = node.host
+	cfg.Service.ConsensusDumpDirectory = "" // do not leave dumps of failed rounds in the source tree
 	cfg.Service.Skip = 5
 
 	node.componentManager = &component.Manager{}
@@ -446,10 +450,15 @@ func (s *testSuite) preInitNode(node *networkNode) {
 	terminationHandler.OnLeaveApprovedFunc = func(p context.Context) {}
 	terminationHandler.AbortFunc = func() {}
 
+	versionManager, err := manager.NewVersionManager(cfg.VersionManager)
+	s.Require().NoError(err)
+	consensusTelemetry, err := telemetry.NewRecorder(cfg.Service)
+	s.Require().NoError(err)
+
 	keyProc := platformpolicy.NewKeyProcessor()
-	node.componentManager.Register(terminationHandler, realKeeper, newPulseManagerMock(realKeeper.(network.NodeKeeper)))
+	node.componentManager.Register(terminationHandler, realKeeper, newPulseManagerMock(realKeeper.(network.NodeKeeper)), versionManager, consensusTelemetry)
 
-	node.componentManager.Register(netCoordinator, &amMock, certManager, cryptographyService)
+	node.componentManager.Register(netCoordinator, &amMock, certManager, cryptographyService, keystore.NewInplaceKeyStore(node.privateKey))
 	node.componentManager.Inject(serviceNetwork, NewTestNetworkSwitcher(), keyProc, terminationHandler)
 
 	node.serviceNetwork = serviceNetwork
@@ -54,6 +54,7 @@ package servicenetwork
 
 import (
 	"context"
+	"crypto"
 	"time"
 
 	consensus "github.com/insolar/insolar/consensus/packets"
@@ -76,6 +77,10 @@ func (n *nodeKeeperWrapper) GetSnapshotCopy() *node.Snapshot {
 	return n.original.GetSnapshotCopy()
 }
 
+func (n *nodeKeeperWrapper) GetNodeKeys() []crypto.PublicKey {
+	return n.original.GetNodeKeys()
+}
+
 func (n *nodeKeeperWrapper) GetAccessor() network.Accessor {
 	return n.original.GetAccessor()
 }
@@ -0,0 +1,212 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+
+package simulator
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/insolar/insolar/consensus/phases"
+)
+
+// clock is a consensus clock of one participant driven by virtual time of the network. Clock counts how many times
+// its participant was woken up by Cluster or by expired deadline and how many times the participant parked waiting
+// for a context or finished, so the participant is blocked when the counters are equal.
+// All fields are guarded by the lock of Network.
+type clock struct {
+	network *Network
+	// step is virtual time at which the participant was woken up last time.
+	step   time.Duration
+	wakes  int
+	parks  int
+	parked *timerContext
+}
+
+// timer expires context created by clock.
+type timer struct {
+	at  time.Duration
+	ctx *timerContext
+}
+
+// timerContext is a context with deadline measured by virtual clock.
+type timerContext struct {
+	context.Context
+	clock    *clock
+	cancel   context.CancelFunc
+	deadline time.Time
+	expired  int32
+}
+
+// Clock creates consensus clock driven by virtual time of the network. Every participant should have its own clock.
+func (n *Network) Clock() phases.Clock {
+	return &clock{network: n}
+}
+
+// Now returns current virtual time.
+func (c *clock) Now() time.Time {
+	return virtualTime(c.network.Now())
+}
+
+// WithTimeout returns a copy of ctx that is done when virtual clock reaches timeout.
+func (c *clock) WithTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	cancelCtx, cancel := context.WithCancel(ctx)
+
+	n := c.network
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	t := &timer{
+		at: n.now + timeout,
+		ctx: &timerContext{
+			Context:  cancelCtx,
+			clock:    c,
+			cancel:   cancel,
+			deadline: virtualTime(n.now + timeout),
+		},
+	}
+	if deadline, ok := ctx.Deadline(); ok && deadline.Before(t.ctx.deadline) {
+		t.ctx.deadline = deadline
+	}
+	if timeout <= 0 {
+		atomic.StoreInt32(&t.ctx.expired, 1)
+		cancel()
+		return t.ctx, cancel
+	}
+	n.timers = append(n.timers, t)
+
+	return t.ctx, func() {
+		n.removeTimer(t)
+		cancel()
+	}
+}
+
+// wake is called under the lock of Network when the participant is woken up.
+func (c *clock) wake() {
+	c.wakes++
+	c.step = c.network.now
+	c.parked = nil
+}
+
+// park is called under the lock of Network when the participant waits for ctx or finishes if ctx is nil.
+func (c *clock) park(ctx *timerContext) {
+	c.parks++
+	c.parked = ctx
+	c.network.changed.Broadcast()
+}
+
+// blocked is called under the lock of Network.
+func (c *clock) blocked() bool {
+	return c.wakes == c.parks
+}
+
+// Deadline returns virtual time when the context expires.
+func (c *timerContext) Deadline() (time.Time, bool) {
+	return c.deadline, true
+}
+
+// Done parks the participant if the context is not done yet, participants call it when they are going to wait
+// for the context.
+func (c *timerContext) Done() <-chan struct{} {
+	n := c.clock.network
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	if atomic.LoadInt32(&c.expired) == 0 && c.Context.Err() == nil {
+		c.clock.park(c)
+	}
+	return c.Context.Done()
+}
+
+// Err returns context.DeadlineExceeded if the context is expired by clock.
+func (c *timerContext) Err() error {
+	if atomic.LoadInt32(&c.expired) == 1 {
+		return context.DeadlineExceeded
+	}
+	return c.Context.Err()
+}
+
+// expire is called by Network under its lock.
+func (t *timer) expire() {
+	atomic.StoreInt32(&t.ctx.expired, 1)
+	if c := t.ctx.clock; c.parked == t.ctx && c.blocked() {
+		c.wake()
+	}
+	t.ctx.cancel()
+}
+
+// virtualTime converts virtual clock reading to time.
+func virtualTime(now time.Duration) time.Time {
+	return time.Unix(0, int64(now))
+}
+
+// nextTimer returns index and time of the earliest timer or -1 if there are no timers.
+func (n *Network) nextTimer() (int, time.Duration) {
+	index := -1
+	var at time.Duration
+	for i, t := range n.timers {
+		if index < 0 || t.at < at {
+			index, at = i, t.at
+		}
+	}
+	return index, at
+}
+
+func (n *Network) removeTimer(t *timer) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	for i, other := range n.timers {
+		if other == t {
+			n.timers = append(n.timers[:i], n.timers[i+1:]...)
+			return
+		}
+	}
+}
@@ -0,0 +1,412 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+
+package simulator
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/component"
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/consensus/packets"
+	"github.com/insolar/insolar/consensus/phases"
+	"github.com/insolar/insolar/cryptography"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/network"
+	"github.com/insolar/insolar/network/merkle"
+	"github.com/insolar/insolar/network/node"
+	"github.com/insolar/insolar/network/nodenetwork"
+	"github.com/insolar/insolar/network/routing"
+	"github.com/insolar/insolar/network/telemetry"
+	"github.com/insolar/insolar/platformpolicy"
+	"github.com/insolar/insolar/version/manager"
+)
+
+// basePort is a port of the first participant address, participants get sequential ports.
+const basePort = 20000
+
+// Participant is a consensus node running on top of simulated network.
+type Participant struct {
+	Ref            insolar.Reference
+	Address        string
+	NodeKeeper     network.NodeKeeper
+	PhaseManager   phases.PhaseManager
+	VersionManager *manager.VersionManager
+	Telemetry      telemetry.Recorder
+
+	pulseManager *pulseManager
+	clock        *clock
+	network      *consensusNetwork
+	cm           *component.Manager
+}
+
+// Cluster runs full consensus participants in one process over simulated Network.
+type Cluster struct {
+	Network      *Network
+	Participants []*Participant
+
+	rand  *rand.Rand
+	pulse insolar.Pulse
+}
+
+// NewCluster creates size participants that know each other as active nodes. References, keys and pulse
+// entropy are generated from seed.
+func NewCluster(net *Network, size int, seed int64) (*Cluster, error) {
+	c := &Cluster{
+		Network: net,
+		rand:    rand.New(rand.NewSource(seed)), // nolint: gosec
+		pulse:   insolar.Pulse{PulseNumber: insolar.FirstPulseNumber},
+	}
+
+	keyProcessor := platformpolicy.NewKeyProcessor()
+	type nodeInfo struct {
+		ref     insolar.Reference
+		key     insolar.CryptographyService
+		pubKey  crypto.PublicKey
+		address string
+	}
+	infos := make([]nodeInfo, 0, size)
+	for i := 0; i < size; i++ {
+		privateKey, err := keyProcessor.GeneratePrivateKey()
+		if err != nil {
+			return nil, errors.Wrap(err, "[ NewCluster ] failed to generate private key")
+		}
+		var ref insolar.Reference
+		_, _ = c.rand.Read(ref[:])
+		infos = append(infos, nodeInfo{
+			ref:     ref,
+			key:     cryptography.NewKeyBoundCryptographyService(privateKey),
+			pubKey:  keyProcessor.ExtractPublicKey(privateKey),
+			address: fmt.Sprintf("127.0.0.1:%d", basePort+i),
+		})
+	}
+
+	for _, info := range infos {
+		// every participant has its own copies of nodes, as they would be received from network
+		nodes := make([]insolar.NetworkNode, 0, size)
+		var origin insolar.NetworkNode
+		for _, other := range infos {
+			n := node.NewNode(other.ref, insolar.StaticRoleVirtual, other.pubKey, other.address, "")
+			if other.ref == info.ref {
+				origin = n
+			}
+			nodes = append(nodes, n)
+		}
+
+		nodeKeeper := nodenetwork.NewNodeKeeper(origin)
+		nodeKeeper.SetInitialSnapshot(nodes)
+
+		clock := &clock{network: net}
+		consensusNetwork, err := newConsensusNetwork(net, clock, info.address, info.ref, origin.ShortID())
+		if err != nil {
+			return nil, errors.Wrap(err, "[ NewCluster ] failed to create consensus network")
+		}
+
+		versionManager, err := manager.NewVersionManager(configuration.NewVersionManager())
+		if err != nil {
+			return nil, errors.Wrap(err, "[ NewCluster ] failed to create version manager")
+		}
+
+		// simulated rounds are inspected via Telemetry, so failed rounds are not dumped
+		telemetryCfg := configuration.NewServiceNetwork()
+		telemetryCfg.ConsensusDumpDirectory = ""
+		recorder, err := telemetry.NewRecorder(telemetryCfg)
+		if err != nil {
+			return nil, errors.Wrap(err, "[ NewCluster ] failed to create telemetry recorder")
+		}
+
+		consensusCommunicator := phases.NewCommunicator()
+		p := &Participant{
+			Ref:            info.ref,
+			Address:        info.address,
+			NodeKeeper:     nodeKeeper,
+			PhaseManager:   phases.NewPhaseManagerWithClock(clock),
+			VersionManager: versionManager,
+			Telemetry:      recorder,
+			pulseManager:   &pulseManager{keeper: nodeKeeper},
+			clock:          clock,
+			network:        consensusNetwork,
+			cm:             &component.Manager{},
+		}
+		p.cm.Inject(
+			platformpolicy.NewPlatformCryptographyScheme(),
+			info.key,
+			nodeKeeper,
+			p.pulseManager,
+			&terminationHandler{},
+			&pulseHandler{},
+			&stater{},
+			&routing.Table{},
+			merkle.NewCalculator(),
+			consensusNetwork,
+			// the first component implementing phases.Communicator is injected to phases
+			&communicator{communicator: consensusCommunicator, network: consensusNetwork},
+			consensusCommunicator,
+			phases.NewFirstPhase(),
+			phases.NewSecondPhase(),
+			phases.NewThirdPhase(),
+			p.PhaseManager,
+			p.VersionManager,
+			p.Telemetry,
+		)
+		c.Participants = append(c.Participants, p)
+	}
+	return c, nil
+}
+
+// Start starts participants.
+func (c *Cluster) Start(ctx context.Context) error {
+	for _, p := range c.Participants {
+		if err := p.cm.Init(ctx); err != nil {
+			return errors.Wrapf(err, "[ Cluster.Start ] failed to init participant %s", p.Ref)
+		}
+		if err := p.cm.Start(ctx); err != nil {
+			return errors.Wrapf(err, "[ Cluster.Start ] failed to start participant %s", p.Ref)
+		}
+	}
+	return nil
+}
+
+// Stop stops participants.
+func (c *Cluster) Stop(ctx context.Context) error {
+	for _, p := range c.Participants {
+		if err := p.cm.Stop(ctx); err != nil {
+			return errors.Wrapf(err, "[ Cluster.Stop ] failed to stop participant %s", p.Ref)
+		}
+	}
+	return nil
+}
+
+// Pulse generates the next pulse and runs consensus on all participants. Virtual clock is moved only when every
+// participant is blocked waiting for a phase deadline or for packets, and then only to the next packet delivery or
+// deadline. Participants are woken up one at a time, so phase deadlines, packet deliveries and the order in which
+// participants handle them depend only on the seed. Returns errors of participants that failed to pass consensus.
+func (c *Cluster) Pulse(ctx context.Context) map[insolar.Reference]error {
+	var entropy insolar.Entropy
+	_, _ = c.rand.Read(entropy[:])
+	pulse := insolar.Pulse{
+		PrevPulseNumber: c.pulse.PulseNumber,
+		PulseNumber:     c.pulse.PulseNumber + 1,
+		NextPulseNumber: c.pulse.PulseNumber + 2,
+		Entropy:         entropy,
+		PulseTimestamp:  c.pulse.PulseTimestamp + int64(time.Second),
+	}
+	c.pulse = pulse
+	pulseStartTime := virtualTime(c.Network.Now())
+
+	var lock sync.Mutex
+	result := make(map[insolar.Reference]error)
+	finished := 0
+	var wg sync.WaitGroup
+	wg.Add(len(c.Participants))
+	for _, p := range c.Participants {
+		c.wake(p)
+		go func(p *Participant) {
+			defer wg.Done()
+			err := p.pulseManager.Set(ctx, pulse, false)
+			if err == nil {
+				err = p.PhaseManager.OnPulse(ctx, &pulse, pulseStartTime)
+			}
+			lock.Lock()
+			if err != nil {
+				result[p.Ref] = err
+			}
+			finished++
+			lock.Unlock()
+			// participant is counted as finished before it parks, so the clock is not moved after the last
+			// participant finishes
+			c.finish(p)
+		}(p)
+		c.await()
+	}
+
+	for {
+		lock.Lock()
+		allFinished := finished == len(c.Participants)
+		lock.Unlock()
+		if allFinished {
+			wg.Wait()
+			return result
+		}
+		if !c.handoff() && !c.Network.next() {
+			// every participant waits for a deadline, so this never happens unless participants are broken
+			panic("[ Cluster.Pulse ] participants are blocked without deadlines")
+		}
+		c.await()
+	}
+}
+
+func (c *Cluster) wake(p *Participant) {
+	c.Network.lock.Lock()
+	defer c.Network.lock.Unlock()
+
+	p.clock.wake()
+}
+
+func (c *Cluster) finish(p *Participant) {
+	c.Network.lock.Lock()
+	defer c.Network.lock.Unlock()
+
+	p.clock.park(nil)
+}
+
+// await blocks until every participant is blocked: it waits for a phase deadline or has finished the pulse, all
+// requests it sends from its goroutines are passed to Network and all packets handed to it are received.
+func (c *Cluster) await() {
+	c.Network.lock.Lock()
+	defer c.Network.lock.Unlock()
+
+	for !c.blocked() {
+		c.Network.changed.Wait()
+	}
+}
+
+func (c *Cluster) blocked() bool {
+	for _, p := range c.Participants {
+		if !p.clock.blocked() || !p.network.blocked() {
+			return false
+		}
+	}
+	return true
+}
+
+// handoff passes one delivered packet to the first participant that waits for it. Returns false if no participant
+// waits for delivered packets.
+func (c *Cluster) handoff() bool {
+	c.Network.lock.Lock()
+	defer c.Network.lock.Unlock()
+
+	for _, p := range c.Participants {
+		if p.network.handoff() {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckAgreement checks that all participants have the same active list and cloud hash.
+func (c *Cluster) CheckAgreement() error {
+	if len(c.Participants) == 0 {
+		return nil
+	}
+	first := c.Participants[0]
+	expectedList := activeList(first.NodeKeeper)
+	expectedHash := first.NodeKeeper.GetCloudHash()
+	for _, p := range c.Participants[1:] {
+		list := activeList(p.NodeKeeper)
+		if !equalLists(expectedList, list) {
+			return errors.Errorf("active list of %s differs from %s: %v != %v", p.Ref, first.Ref, list, expectedList)
+		}
+		hash := p.NodeKeeper.GetCloudHash()
+		if !bytes.Equal(expectedHash, hash) {
+			return errors.Errorf("cloud hash of %s differs from %s", p.Ref, first.Ref)
+		}
+	}
+	return nil
+}
+
+func activeList(keeper network.NodeKeeper) []insolar.Reference {
+	nodes := keeper.GetAccessor().GetActiveNodes()
+	result := make([]insolar.Reference, 0, len(nodes))
+	for _, n := range nodes {
+		result = append(result, n.ID())
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Compare(result[j]) < 0
+	})
+	return result
+}
+
+func equalLists(a, b []insolar.Reference) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// pulseManager moves result of the previous consensus to active list, like ServiceNetwork does on new pulse.
+type pulseManager struct {
+	keeper network.NodeKeeper
+}
+
+func (pm *pulseManager) Set(ctx context.Context, pulse insolar.Pulse, persist bool) error {
+	return pm.keeper.MoveSyncToActive(ctx)
+}
+
+// pulseHandler ignores pulses received from phase1 packets, Cluster distributes pulses itself.
+type pulseHandler struct{}
+
+func (*pulseHandler) HandlePulse(context.Context, insolar.Pulse) {}
+
+type terminationHandler struct{}
+
+func (*terminationHandler) Leave(context.Context, insolar.PulseNumber) {}
+func (*terminationHandler) OnLeaveApproved(context.Context)            {}
+func (*terminationHandler) Abort()                                     {}
+
+// stater provides the same ledger state hash for all participants.
+type stater struct{}
+
+func (*stater) State() ([]byte, error) {
+	return make([]byte, packets.HashLength), nil
+}
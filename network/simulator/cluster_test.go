@@ -0,0 +1,218 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+
+package simulator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/network/telemetry"
+	"github.com/insolar/insolar/version/manager"
+)
+
+func runCluster(t *testing.T, net *Network, size, pulses int) *Cluster {
+	ctx := inslogger.TestContext(t)
+	cluster, err := NewCluster(net, size, 42)
+	require.NoError(t, err)
+	require.NoError(t, cluster.Start(ctx))
+	defer func() {
+		require.NoError(t, cluster.Stop(context.Background()))
+	}()
+
+	for i := 0; i < pulses; i++ {
+		require.Empty(t, cluster.Pulse(ctx), "pulse %d", i)
+		require.NoError(t, cluster.CheckAgreement(), "pulse %d", i)
+		require.Len(t, activeList(cluster.Participants[0].NodeKeeper), size, "pulse %d", i)
+		require.NotEmpty(t, cluster.Participants[0].NodeKeeper.GetCloudHash(), "pulse %d", i)
+	}
+	return cluster
+}
+
+func TestCluster_Agreement(t *testing.T) {
+	net := NewNetwork(1, Link{Latency: 5 * time.Millisecond, Jitter: 5 * time.Millisecond})
+	cluster := runCluster(t, net, 5, 10)
+
+	for _, p := range cluster.Participants {
+		reports := p.Telemetry.Reports(0)
+		require.Len(t, reports, 10)
+		for _, report := range reports {
+			require.True(t, report.Decision.Success, "pulse %d", report.Pulse)
+			require.Len(t, report.Phases, 4, "pulse %d", report.Pulse)
+			require.Len(t, report.Participants, 5, "pulse %d", report.Pulse)
+			for _, participant := range report.Participants {
+				require.Empty(t, participant.Missing, "pulse %d", report.Pulse)
+			}
+		}
+	}
+}
+
+func TestCluster_AgreementOnFaultyLinks(t *testing.T) {
+	net := NewNetwork(2, Link{
+		Latency:       5 * time.Millisecond,
+		Jitter:        10 * time.Millisecond,
+		DuplicateRate: 0.2,
+		ReorderRate:   0.2,
+		ReorderDelay:  15 * time.Millisecond,
+	})
+	runCluster(t, net, 5, 10)
+
+	stats := net.Stats()
+	require.NotZero(t, stats.Duplicated)
+}
+
+func TestCluster_Reproducible(t *testing.T) {
+	link := Link{
+		Latency:       5 * time.Millisecond,
+		Jitter:        10 * time.Millisecond,
+		DuplicateRate: 0.2,
+		ReorderRate:   0.2,
+		ReorderDelay:  15 * time.Millisecond,
+	}
+	type outcome struct {
+		Now       time.Duration
+		Stats     Stats
+		Decisions []telemetry.Decision
+		BitSets   []map[string]map[string]string
+	}
+	run := func() outcome {
+		net := NewNetwork(4, link)
+		cluster := runCluster(t, net, 5, 10)
+		result := outcome{Now: net.Now(), Stats: net.Stats()}
+		for _, report := range cluster.Participants[0].Telemetry.Reports(0) {
+			result.Decisions = append(result.Decisions, report.Decision)
+			result.BitSets = append(result.BitSets, report.BitSets)
+		}
+		return result
+	}
+
+	require.Equal(t, run(), run())
+}
+
+func TestCluster_FeatureActivation(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	net := NewNetwork(3, Link{Latency: 5 * time.Millisecond, Jitter: 5 * time.Millisecond})
+	cluster, err := NewCluster(net, 5, 42)
+	require.NoError(t, err)
+
+	upgrade := func(p *Participant) {
+		nodeVersion, err := manager.ParseVersion("v1.0.0")
+		require.NoError(t, err)
+		p.VersionManager.SetNodeVersion(nodeVersion)
+	}
+	for _, p := range cluster.Participants {
+		_, err := p.VersionManager.Add("new_feature", "v1.0.0", "feature for rolling upgrade test")
+		require.NoError(t, err)
+	}
+	upgrade(cluster.Participants[0])
+	upgrade(cluster.Participants[1])
+
+	require.NoError(t, cluster.Start(ctx))
+	defer func() {
+		require.NoError(t, cluster.Stop(context.Background()))
+	}()
+
+	for i := 0; i < 3; i++ {
+		require.Empty(t, cluster.Pulse(ctx), "pulse %d", i)
+	}
+	for _, p := range cluster.Participants {
+		require.False(t, p.VersionManager.IsAvailable("new_feature"), "feature is supported by minority")
+	}
+
+	upgrade(cluster.Participants[2])
+	require.Empty(t, cluster.Pulse(ctx))
+
+	var activationPulse insolar.PulseNumber
+	for i, p := range cluster.Participants {
+		require.True(t, p.VersionManager.IsAvailable("new_feature"))
+		pulse, ok := p.VersionManager.ActivationPulse("new_feature")
+		require.True(t, ok)
+		if i == 0 {
+			activationPulse = pulse
+		}
+		require.Equal(t, activationPulse, pulse, "feature is activated at different pulses")
+	}
+}
+
+func TestCluster_FeatureActivationHandoff(t *testing.T) {
+	ctx := inslogger.TestContext(t)
+	net := NewNetwork(5, Link{Latency: 5 * time.Millisecond, Jitter: 5 * time.Millisecond})
+	cluster, err := NewCluster(net, 5, 42)
+	require.NoError(t, err)
+
+	active := make([]insolar.Reference, 0, len(cluster.Participants))
+	for _, p := range cluster.Participants {
+		active = append(active, p.Ref)
+		_, err := p.VersionManager.Add("new_feature", "v1.0.0", "feature for activation handoff test")
+		require.NoError(t, err)
+	}
+	// all participants but the last one have activated the feature before, the last one is restarted
+	activationPulse := insolar.PulseNumber(insolar.FirstPulseNumber)
+	for _, p := range cluster.Participants[:4] {
+		p.VersionManager.ProcessCapabilities(activationPulse, active, map[string][]insolar.Reference{"new_feature": active}, nil)
+	}
+	restarted := cluster.Participants[4].VersionManager
+	require.False(t, restarted.IsAvailable("new_feature"))
+
+	require.NoError(t, cluster.Start(ctx))
+	defer func() {
+		require.NoError(t, cluster.Stop(context.Background()))
+	}()
+
+	require.Empty(t, cluster.Pulse(ctx))
+	require.True(t, restarted.IsAvailable("new_feature"))
+	pulse, ok := restarted.ActivationPulse("new_feature")
+	require.True(t, ok)
+	require.Equal(t, activationPulse, pulse)
+}
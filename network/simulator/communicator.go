@@ -0,0 +1,134 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+
+package simulator
+
+import (
+	"context"
+
+	"github.com/insolar/insolar/consensus/packets"
+	"github.com/insolar/insolar/consensus/phases"
+	"github.com/insolar/insolar/insolar"
+)
+
+// communicator wraps phases.Communicator of a participant and tells its consensus network which packets
+// the participant waits for and how many requests the wrapped communicator sends from its own goroutines,
+// so Cluster can tell when the participant is blocked.
+type communicator struct {
+	communicator phases.Communicator
+	network      *consensusNetwork
+}
+
+// Init does nothing, the wrapped communicator is initialized by component manager itself.
+func (c *communicator) Init(ctx context.Context) error {
+	return nil
+}
+
+// ExchangePhase1 exchanges phase1 packets with wrapped communicator.
+func (c *communicator) ExchangePhase1(
+	ctx context.Context,
+	state *phases.ConsensusState,
+	originClaim *packets.NodeAnnounceClaim,
+	participants []insolar.NetworkNode,
+	packet *packets.Phase1Packet,
+) (map[insolar.Reference]*packets.Phase1Packet, error) {
+	// requests with origin claim are sent once to every participant
+	c.network.exchange(packets.Phase1, packet.GetPulseNumber(), c.requests(participants, originClaim != nil))
+	defer c.network.exchanged()
+
+	return c.communicator.ExchangePhase1(ctx, state, originClaim, participants, packet)
+}
+
+// ExchangePhase2 exchanges phase2 packets with wrapped communicator.
+func (c *communicator) ExchangePhase2(ctx context.Context, state *phases.ConsensusState,
+	participants []insolar.NetworkNode, packet *packets.Phase2Packet) (map[insolar.Reference]*packets.Phase2Packet, error) {
+
+	c.network.exchange(packets.Phase2, packet.GetPulseNumber(), c.requests(participants, false))
+	defer c.network.exchanged()
+
+	return c.communicator.ExchangePhase2(ctx, state, participants, packet)
+}
+
+// ExchangePhase21 exchanges phase2 packets with wrapped communicator, additional requests are sent synchronously.
+func (c *communicator) ExchangePhase21(ctx context.Context, state *phases.ConsensusState,
+	packet *packets.Phase2Packet, additionalRequests []*phases.AdditionalRequest) ([]packets.ReferendumVote, error) {
+
+	c.network.exchange(packets.Phase2, packet.GetPulseNumber(), 0)
+	defer c.network.exchanged()
+
+	return c.communicator.ExchangePhase21(ctx, state, packet, additionalRequests)
+}
+
+// ExchangePhase3 exchanges phase3 packets with wrapped communicator.
+func (c *communicator) ExchangePhase3(ctx context.Context,
+	participants []insolar.NetworkNode, packet *packets.Phase3Packet) (map[insolar.Reference]*packets.Phase3Packet, error) {
+
+	c.network.exchange(packets.Phase3, packet.GetPulseNumber(), c.requests(participants, false))
+	defer c.network.exchanged()
+
+	return c.communicator.ExchangePhase3(ctx, participants, packet)
+}
+
+// requests returns a number of requests the wrapped communicator sends to participants from its goroutines.
+func (c *communicator) requests(participants []insolar.NetworkNode, distinct bool) int {
+	origin := c.network.GetNodeID()
+	seen := make(map[insolar.Reference]struct{}, len(participants))
+	count := 0
+	for _, p := range participants {
+		if p.ID().Equal(origin) {
+			continue
+		}
+		if _, ok := seen[p.ID()]; ok && distinct {
+			continue
+		}
+		seen[p.ID()] = struct{}{}
+		count++
+	}
+	return count
+}
@@ -0,0 +1,292 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+
+package simulator
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/consensus/packets"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/log"
+	"github.com/insolar/insolar/network"
+	"github.com/insolar/insolar/network/hostnetwork/host"
+)
+
+// incoming is a consensus packet delivered to participant and checked the same way hostnetwork checks it.
+type incoming struct {
+	packet packets.ConsensusPacket
+	pulse  insolar.PulseNumber
+	sender insolar.Reference
+}
+
+// consensusNetwork is network.ConsensusNetwork of one participant on top of simulated network. Unlike hostnetwork
+// it does not start a goroutine for every delivered packet: packets are kept in inbox until Cluster hands them
+// to the participant, one at a time and only when the participant waits for packets of that type and pulse.
+// All fields except handlers are guarded by the lock of Network.
+type consensusNetwork struct {
+	Resolver network.RoutingTable `inject:""`
+
+	network  *Network
+	clock    *clock
+	origin   *host.Host
+	handlers map[packets.PacketType]network.ConsensusPacketHandler
+
+	inbox []incoming
+	// waiting is a type of packets the participant is exchanging, zero if the participant does not exchange
+	// packets, pulse is a pulse of the exchange.
+	waiting packets.PacketType
+	pulse   insolar.PulseNumber
+	// requests is a number of requests the participant sends from its own goroutines that are not passed to
+	// Network yet, handoffs is a number of packet handlers that have not returned yet.
+	requests int
+	handoffs int
+}
+
+func newConsensusNetwork(net *Network, clock *clock, address string, nodeID insolar.Reference,
+	shortID insolar.ShortNodeID) (*consensusNetwork, error) {
+
+	origin, err := host.NewHostNS(address, nodeID, shortID)
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting origin")
+	}
+	return &consensusNetwork{
+		network:  net,
+		clock:    clock,
+		origin:   origin,
+		handlers: make(map[packets.PacketType]network.ConsensusPacketHandler),
+	}, nil
+}
+
+// Start registers consensus network in simulated network.
+func (cn *consensusNetwork) Start(ctx context.Context) error {
+	cn.network.register(cn.origin.Address.String(), cn)
+	return nil
+}
+
+// Stop removes consensus network from simulated network.
+func (cn *consensusNetwork) Stop(ctx context.Context) error {
+	cn.network.unregister(cn.origin.Address.String(), cn)
+	return nil
+}
+
+// PublicAddress returns address of the participant in simulated network.
+func (cn *consensusNetwork) PublicAddress() string {
+	return cn.origin.Address.String()
+}
+
+// GetNodeID returns reference of the participant.
+func (cn *consensusNetwork) GetNodeID() insolar.Reference {
+	return cn.origin.NodeID
+}
+
+// SignAndSendPacket signs packet and passes it to simulated network. Packet is sent at virtual time of the current
+// step of the participant, so it does not matter when the sending goroutine actually runs.
+func (cn *consensusNetwork) SignAndSendPacket(packet packets.ConsensusPacket,
+	receiver insolar.Reference, service insolar.CryptographyService) error {
+
+	defer cn.sent()
+
+	receiverHost, err := cn.Resolver.ResolveConsensusRef(receiver)
+	if err != nil {
+		return errors.Wrapf(err, "Failed to resolve %s request to node %s", packet.GetType(), receiver.String())
+	}
+	packet.SetRouting(cn.origin.ShortID, receiverHost.ShortID)
+	err = packet.Sign(service)
+	if err != nil {
+		return errors.Wrapf(err, "Failed to sign %s request to node %s", packet.GetType(), receiver.String())
+	}
+	data, err := packet.Serialize()
+	if err != nil {
+		return errors.Wrapf(err, "Failed to serialize %s request to node %s", packet.GetType(), receiver.String())
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte(frameConsensusPacket)
+	buf.Write(data)
+
+	cn.network.lock.Lock()
+	defer cn.network.lock.Unlock()
+
+	cn.network.transmit(cn.clock.step, cn.origin.Address.String(), receiverHost.Address.String(), buf.Bytes())
+	return nil
+}
+
+// RegisterPacketHandler registers handler of packets of type t.
+func (cn *consensusNetwork) RegisterPacketHandler(t packets.PacketType, handler network.ConsensusPacketHandler) {
+	_, exists := cn.handlers[t]
+	if exists {
+		log.Warnf("Multiple handlers for packet type %s are not supported! New handler will replace the old one!", t)
+	}
+	cn.handlers[t] = handler
+}
+
+// sent counts request sent from a goroutine of the participant.
+func (cn *consensusNetwork) sent() {
+	cn.network.lock.Lock()
+	defer cn.network.lock.Unlock()
+
+	if cn.requests > 0 {
+		cn.requests--
+		cn.network.changed.Broadcast()
+	}
+}
+
+// exchange is called by the participant before it sends requests of type t from requests goroutines and starts
+// to wait for packets of the type.
+func (cn *consensusNetwork) exchange(t packets.PacketType, pulse insolar.PulseNumber, requests int) {
+	cn.network.lock.Lock()
+	defer cn.network.lock.Unlock()
+
+	cn.waiting = t
+	cn.pulse = pulse
+	cn.requests = requests
+}
+
+// exchanged is called by the participant when it stops to wait for packets.
+func (cn *consensusNetwork) exchanged() {
+	cn.network.lock.Lock()
+	defer cn.network.lock.Unlock()
+
+	cn.waiting = 0
+	cn.requests = 0
+}
+
+// blocked returns true if all requests are passed to Network and all handlers have returned.
+// It is called under the lock of Network.
+func (cn *consensusNetwork) blocked() bool {
+	return cn.requests == 0 && cn.handoffs == 0
+}
+
+// enqueue is called by Network under its lock. It drops packets that hostnetwork would not pass to handlers.
+func (cn *consensusNetwork) enqueue(data []byte) bool {
+	msg, err := deserialize(data)
+	if err != nil {
+		log.Error("[ consensusNetwork ] Failed to deserialize packet: ", err.Error())
+		return false
+	}
+	p, ok := msg.Data.(packets.ConsensusPacket)
+	if !ok {
+		log.Error("[ consensusNetwork ] Failed to convert datagram to ConsensusPacket")
+		return false
+	}
+	if p.GetTarget() != cn.origin.ShortID || p.GetOrigin() == cn.origin.ShortID {
+		log.Errorf("[ consensusNetwork ] Wrong routing of %s packet: %d -> %d", p.GetType(), p.GetOrigin(), p.GetTarget())
+		return false
+	}
+	if _, ok := cn.handlers[p.GetType()]; !ok {
+		log.Errorf("[ consensusNetwork ] No handler set for packet type %s", p.GetType())
+		return false
+	}
+	pulse, ok := pulseOf(p)
+	if !ok {
+		log.Errorf("[ consensusNetwork ] Packet of type %s has no pulse", p.GetType())
+		return false
+	}
+	sender, err := cn.Resolver.ResolveConsensus(p.GetOrigin())
+	// Phase1 packet can come from a node that is not known yet, as in hostnetwork
+	if err != nil && p.GetType() != packets.Phase1 {
+		log.Errorf("[ consensusNetwork ] Failed to resolve ShortID (%d) -> NodeID", p.GetOrigin())
+		return false
+	}
+	if sender == nil {
+		sender = &host.Host{}
+	}
+	cn.inbox = append(cn.inbox, incoming{packet: p, pulse: pulse, sender: sender.NodeID})
+	return true
+}
+
+// handoff passes the first packet the blocked participant waits for to its handler and wakes the participant up,
+// packets of past pulses are dropped. Returns false if there is no such packet.
+// It is called under the lock of Network.
+func (cn *consensusNetwork) handoff() bool {
+	if cn.waiting == 0 {
+		return false
+	}
+	for i := 0; i < len(cn.inbox); i++ {
+		in := cn.inbox[i]
+		switch {
+		case in.pulse < cn.pulse:
+			cn.inbox = append(cn.inbox[:i], cn.inbox[i+1:]...)
+			i--
+		case in.pulse == cn.pulse && in.packet.GetType() == cn.waiting:
+			cn.inbox = append(cn.inbox[:i], cn.inbox[i+1:]...)
+			cn.handoffs++
+			cn.clock.wake()
+			go cn.handle(in)
+			return true
+		}
+	}
+	return false
+}
+
+// handle passes packet to its handler. Participant is waiting for the packet, so handler returns as soon as
+// the participant receives it.
+func (cn *consensusNetwork) handle(in incoming) {
+	cn.handlers[in.packet.GetType()](in.packet, in.sender)
+
+	cn.network.lock.Lock()
+	defer cn.network.lock.Unlock()
+
+	cn.handoffs--
+	cn.network.changed.Broadcast()
+}
+
+func pulseOf(p packets.ConsensusPacket) (insolar.PulseNumber, bool) {
+	pulsed, ok := p.(interface {
+		GetPulseNumber() insolar.PulseNumber
+	})
+	if !ok {
+		return 0, false
+	}
+	return pulsed.GetPulseNumber(), true
+}
@@ -0,0 +1,73 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+
+/*
+Package simulator provides deterministic in-process network for consensus tests.
+
+Network is a virtual packet courier with its own clock. Every packet gets delivery time computed from
+per-link settings (latency, jitter, loss, duplication, reordering) using seeded randomness, so the same seed
+and the same sequence of sent packets always produce the same deliveries. Packets are delivered only when the
+virtual clock is advanced with Advance. Clock exposes the same virtual time to consensus phases, so phase
+deadlines expire only when the clock is advanced too.
+
+Transports created by Network implement transport.Transport and can be used by hostnetwork instead of real ones:
+
+	net := simulator.NewNetwork(42, simulator.Link{Latency: 5 * time.Millisecond})
+	tp, address, _ := net.NewTransport("127.0.0.1:10000")
+	consensusNetwork, _ := hostnetwork.NewConsensusNetworkWithTransport(tp, address, nodeID, shortID)
+
+Cluster runs several full consensus participants on top of Network and checks that they agree on the
+active list and cloud hash after each pulse. Participants use their own consensus network instead of hostnetwork,
+it keeps delivered packets until Cluster hands them over. Cluster counts how many times every participant was
+woken up and how many times it parked waiting for a phase deadline, as well as requests and packet handlers of
+the participant that are still running. The clock is moved to the next packet delivery or deadline only when all
+participants are blocked, and packets are sent at the virtual time the sender was woken up at, so the run does
+not depend on the speed of the machine or on goroutine scheduling.
+*/
+package simulator
@@ -0,0 +1,342 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+
+package simulator
+
+import (
+	"container/heap"
+	"hash/fnv"
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// inboxSize is a number of delivered packets that transport can hold before it starts to lose them.
+const inboxSize = 1024
+
+// Link describes behaviour of a directed link between two addresses.
+type Link struct {
+	// Latency is a base delivery delay of a packet.
+	Latency time.Duration
+	// Jitter is a maximum random delay added to Latency.
+	Jitter time.Duration
+	// DropRate is a probability of packet loss.
+	DropRate float64
+	// DuplicateRate is a probability of packet being delivered twice.
+	DuplicateRate float64
+	// ReorderRate is a probability of packet being additionally delayed by ReorderDelay,
+	// so packets sent after it are delivered first.
+	ReorderRate  float64
+	ReorderDelay time.Duration
+}
+
+// Stats contains counters of packets passed through Network.
+type Stats struct {
+	Sent       int
+	Delivered  int
+	Dropped    int
+	Duplicated int
+}
+
+type linkKey struct {
+	from, to string
+}
+
+// delivery is ordered by time and then by link and sequence number of the packet on the link, so the order
+// does not depend on the order in which goroutines of different participants send packets.
+type delivery struct {
+	at   time.Duration
+	link linkKey
+	seq  uint64
+	data []byte
+}
+
+type deliveryQueue []*delivery
+
+func (q deliveryQueue) Len() int { return len(q) }
+
+func (q deliveryQueue) Less(i, j int) bool {
+	a, b := q[i], q[j]
+	switch {
+	case a.at != b.at:
+		return a.at < b.at
+	case a.link.from != b.link.from:
+		return a.link.from < b.link.from
+	case a.link.to != b.link.to:
+		return a.link.to < b.link.to
+	default:
+		return a.seq < b.seq
+	}
+}
+
+func (q deliveryQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *deliveryQueue) Push(x interface{}) { *q = append(*q, x.(*delivery)) }
+
+func (q *deliveryQueue) Pop() interface{} {
+	old := *q
+	n := len(old)
+	item := old[n-1]
+	*q = old[:n-1]
+	return item
+}
+
+// endpoint receives packets delivered by Network.
+type endpoint interface {
+	// enqueue is called by Network under its lock, so it must not block. Returns false if packet is lost.
+	enqueue(data []byte) bool
+}
+
+// Network is a simulated packet network with virtual clock.
+type Network struct {
+	lock sync.Mutex
+	// changed is broadcast when a participant becomes blocked, see Cluster.
+	changed *sync.Cond
+
+	seed        int64
+	now         time.Duration
+	defaultLink Link
+	links       map[linkKey]Link
+	rands       map[linkKey]*rand.Rand
+	seqs        map[linkKey]uint64
+	groups      map[string]int
+	endpoints   map[string]endpoint
+	queue       deliveryQueue
+	timers      []*timer
+	stats       Stats
+}
+
+// NewNetwork creates Network. All random decisions are derived from seed, defaultLink is used for
+// every link that was not configured with SetLink.
+func NewNetwork(seed int64, defaultLink Link) *Network {
+	n := &Network{
+		seed:        seed,
+		defaultLink: defaultLink,
+		links:       make(map[linkKey]Link),
+		rands:       make(map[linkKey]*rand.Rand),
+		seqs:        make(map[linkKey]uint64),
+		groups:      make(map[string]int),
+		endpoints:   make(map[string]endpoint),
+	}
+	n.changed = sync.NewCond(&n.lock)
+	return n
+}
+
+// SetLink overrides settings of a directed link.
+func (n *Network) SetLink(from, to string, link Link) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	n.links[linkKey{from: from, to: to}] = link
+}
+
+// Partition splits network into isolated groups of addresses. Addresses that are not listed form one more group.
+// Packets sent between different groups are dropped.
+func (n *Network) Partition(groups ...[]string) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	n.groups = make(map[string]int)
+	for i, group := range groups {
+		for _, address := range group {
+			n.groups[address] = i + 1
+		}
+	}
+}
+
+// Heal removes all partitions.
+func (n *Network) Heal() {
+	n.Partition()
+}
+
+// Now returns current virtual time.
+func (n *Network) Now() time.Duration {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	return n.now
+}
+
+// Stats returns packet counters.
+func (n *Network) Stats() Stats {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	return n.stats
+}
+
+// Advance moves virtual clock forward, delivers all packets that are due at the new time and expires contexts
+// created by Clock whose deadlines have passed. Packets and deadlines are processed in order of their time.
+func (n *Network) Advance(d time.Duration) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	target := n.now + d
+	for n.processNext(target) {
+	}
+	n.now = target
+}
+
+// next moves virtual clock to the earliest packet delivery or deadline and processes it. Returns false if there
+// are neither packets in flight nor deadlines.
+func (n *Network) next() bool {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	return n.processNext(math.MaxInt64)
+}
+
+// processNext processes the earliest packet delivery or deadline that is due not later than limit, packets
+// go first if they are due at the same time as a deadline. Returns false if there is nothing to process.
+func (n *Network) processNext(limit time.Duration) bool {
+	timerIndex, timerAt := n.nextTimer()
+	deliveryDue := n.queue.Len() > 0 && n.queue[0].at <= limit
+	timerDue := timerIndex >= 0 && timerAt <= limit
+
+	switch {
+	case deliveryDue && (!timerDue || n.queue[0].at <= timerAt):
+		item := heap.Pop(&n.queue).(*delivery)
+		n.now = item.at
+		n.deliver(item)
+	case timerDue:
+		t := n.timers[timerIndex]
+		n.timers = append(n.timers[:timerIndex], n.timers[timerIndex+1:]...)
+		n.now = timerAt
+		t.expire()
+	default:
+		return false
+	}
+	return true
+}
+
+func (n *Network) register(address string, e endpoint) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	n.endpoints[address] = e
+}
+
+func (n *Network) unregister(address string, e endpoint) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	if n.endpoints[address] == e {
+		delete(n.endpoints, address)
+	}
+}
+
+func (n *Network) send(from, to string, data []byte) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	n.transmit(n.now, from, to, data)
+}
+
+// transmit sends packet at virtual time at, it is called under the lock of Network.
+func (n *Network) transmit(at time.Duration, from, to string, data []byte) {
+	n.stats.Sent++
+	if n.groups[from] != n.groups[to] {
+		n.stats.Dropped++
+		return
+	}
+
+	key := linkKey{from: from, to: to}
+	link, ok := n.links[key]
+	if !ok {
+		link = n.defaultLink
+	}
+	rnd := n.linkRand(key)
+
+	if rnd.Float64() < link.DropRate {
+		n.stats.Dropped++
+		return
+	}
+	n.schedule(at, key, data, link, rnd)
+	if rnd.Float64() < link.DuplicateRate {
+		n.stats.Duplicated++
+		n.schedule(at, key, data, link, rnd)
+	}
+}
+
+func (n *Network) schedule(at time.Duration, key linkKey, data []byte, link Link, rnd *rand.Rand) {
+	delay := link.Latency
+	if link.Jitter > 0 {
+		delay += time.Duration(rnd.Int63n(int64(link.Jitter)))
+	}
+	if rnd.Float64() < link.ReorderRate {
+		delay += link.ReorderDelay
+	}
+	n.seqs[key]++
+	heap.Push(&n.queue, &delivery{at: at + delay, link: key, seq: n.seqs[key], data: data})
+}
+
+func (n *Network) deliver(item *delivery) {
+	e, ok := n.endpoints[item.link.to]
+	if !ok || !e.enqueue(item.data) {
+		n.stats.Dropped++
+		return
+	}
+	n.stats.Delivered++
+}
+
+// linkRand returns random generator of the link. Every link has its own generator, so decisions made for
+// the link do not depend on the order in which packets are sent over other links.
+func (n *Network) linkRand(key linkKey) *rand.Rand {
+	rnd, ok := n.rands[key]
+	if !ok {
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(key.from))
+		_, _ = h.Write([]byte{0})
+		_, _ = h.Write([]byte(key.to))
+		rnd = rand.New(rand.NewSource(n.seed ^ int64(h.Sum64()))) // nolint: gosec
+		n.rands[key] = rnd
+	}
+	return rnd
+}
@@ -0,0 +1,235 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+
+package simulator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/network/hostnetwork/host"
+	"github.com/insolar/insolar/network/hostnetwork/packet"
+	"github.com/insolar/insolar/network/hostnetwork/packet/types"
+	"github.com/insolar/insolar/testutils"
+)
+
+const (
+	addressA = "127.0.0.1:1"
+	addressB = "127.0.0.1:2"
+	addressC = "127.0.0.1:3"
+)
+
+// recorder registers fake transports in network and records delivered datagrams.
+func recorder(t *testing.T, net *Network, addresses ...string) map[string]*simTransport {
+	result := make(map[string]*simTransport)
+	for _, address := range addresses {
+		tp, _, err := net.NewTransport(address)
+		require.NoError(t, err)
+		st := tp.(*simTransport)
+		net.register(address, st)
+		result[address] = st
+	}
+	return result
+}
+
+func received(tp *simTransport) []byte {
+	result := make([]byte, 0)
+	for {
+		select {
+		case data := <-tp.inbox:
+			result = append(result, data...)
+		default:
+			return result
+		}
+	}
+}
+
+func sendSequence(net *Network, from, to string, count int) {
+	for i := 0; i < count; i++ {
+		net.send(from, to, []byte{byte(i)})
+	}
+}
+
+func TestNetwork_Latency(t *testing.T) {
+	net := NewNetwork(1, Link{Latency: 10 * time.Millisecond})
+	tps := recorder(t, net, addressA, addressB)
+
+	sendSequence(net, addressA, addressB, 3)
+	net.Advance(9 * time.Millisecond)
+	assert.Empty(t, received(tps[addressB]))
+	net.Advance(time.Millisecond)
+	assert.Equal(t, []byte{0, 1, 2}, received(tps[addressB]))
+	assert.Equal(t, 10*time.Millisecond, net.Now())
+	assert.Equal(t, Stats{Sent: 3, Delivered: 3}, net.Stats())
+}
+
+func TestNetwork_Deterministic(t *testing.T) {
+	link := Link{
+		Latency:       time.Millisecond,
+		Jitter:        5 * time.Millisecond,
+		DropRate:      0.2,
+		DuplicateRate: 0.2,
+		ReorderRate:   0.2,
+		ReorderDelay:  10 * time.Millisecond,
+	}
+	run := func(seed int64, noise int) ([]byte, Stats) {
+		net := NewNetwork(seed, link)
+		tps := recorder(t, net, addressA, addressB, addressC)
+		sendSequence(net, addressC, addressA, noise)
+		sendSequence(net, addressA, addressB, 100)
+		net.Advance(time.Second)
+		return received(tps[addressB]), net.Stats()
+	}
+
+	first, stats := run(1, 0)
+	assert.NotZero(t, stats.Dropped)
+	assert.NotZero(t, stats.Duplicated)
+
+	// traffic on other links does not affect decisions made for A -> B
+	second, _ := run(1, 50)
+	assert.Equal(t, first, second)
+
+	third, _ := run(2, 0)
+	assert.NotEqual(t, first, third)
+}
+
+func TestNetwork_Reorder(t *testing.T) {
+	net := NewNetwork(1, Link{Latency: time.Millisecond})
+	net.SetLink(addressA, addressB, Link{Latency: time.Millisecond, ReorderRate: 1, ReorderDelay: time.Millisecond})
+	tps := recorder(t, net, addressA, addressB, addressC)
+
+	net.send(addressA, addressB, []byte{1})
+	net.send(addressC, addressB, []byte{2})
+	net.Advance(time.Second)
+	assert.Equal(t, []byte{2, 1}, received(tps[addressB]))
+}
+
+func TestNetwork_Partition(t *testing.T) {
+	net := NewNetwork(1, Link{})
+	tps := recorder(t, net, addressA, addressB, addressC)
+
+	net.Partition([]string{addressA})
+	net.send(addressA, addressB, []byte{1})
+	net.send(addressC, addressB, []byte{2})
+	net.Advance(0)
+	assert.Equal(t, []byte{2}, received(tps[addressB]))
+
+	net.Heal()
+	net.send(addressA, addressB, []byte{3})
+	net.Advance(0)
+	assert.Equal(t, []byte{3}, received(tps[addressB]))
+	assert.Equal(t, 1, net.Stats().Dropped)
+}
+
+func TestNetwork_Clock(t *testing.T) {
+	net := NewNetwork(1, Link{})
+	clock := net.Clock()
+	start := clock.Now()
+
+	ctx, cancel := clock.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	deadline, ok := ctx.Deadline()
+	require.True(t, ok)
+	assert.Equal(t, 10*time.Millisecond, deadline.Sub(start))
+
+	net.Advance(9 * time.Millisecond)
+	assert.NoError(t, ctx.Err())
+	net.Advance(time.Millisecond)
+	<-ctx.Done()
+	assert.Equal(t, context.DeadlineExceeded, ctx.Err())
+	assert.Equal(t, 10*time.Millisecond, clock.Now().Sub(start))
+
+	canceled, cancel := clock.WithTimeout(context.Background(), time.Millisecond)
+	cancel()
+	net.Advance(time.Millisecond)
+	assert.Equal(t, context.Canceled, canceled.Err())
+}
+
+func TestTransport_RequestResponse(t *testing.T) {
+	ctx := context.Background()
+	net := NewNetwork(1, Link{Latency: time.Millisecond})
+
+	tpA, _, err := net.NewTransport(addressA)
+	require.NoError(t, err)
+	tpB, _, err := net.NewTransport(addressB)
+	require.NoError(t, err)
+	require.NoError(t, tpA.Start(ctx))
+	require.NoError(t, tpB.Start(ctx))
+	defer tpA.Stop()
+	defer tpB.Stop()
+
+	hostA, err := host.NewHostN(addressA, testutils.RandomRef())
+	require.NoError(t, err)
+	hostB, err := host.NewHostN(addressB, testutils.RandomRef())
+	require.NoError(t, err)
+
+	responded := make(chan struct{})
+	go func() {
+		request := <-tpB.Packets()
+		response := packet.NewBuilder(hostB).Receiver(request.Sender).Type(types.Ping).Response(nil).Build()
+		assert.NoError(t, tpB.SendResponse(ctx, request.RequestID, response))
+		close(responded)
+	}()
+
+	request := packet.NewBuilder(hostA).Receiver(hostB).Type(types.Ping).RequestID(1).Request(nil).Build()
+	f, err := tpA.SendRequest(ctx, request)
+	require.NoError(t, err)
+	// request and response are delivered in two steps of the clock
+	net.Advance(time.Millisecond)
+	<-responded
+	net.Advance(time.Millisecond)
+	response, err := f.GetResult(time.Second)
+	require.NoError(t, err)
+	assert.True(t, response.IsResponse)
+	assert.Equal(t, hostB.NodeID, response.Sender.NodeID)
+}
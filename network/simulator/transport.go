@@ -0,0 +1,263 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+
+package simulator
+
+import (
+	"bytes"
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/consensus/packets"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/network"
+	"github.com/insolar/insolar/network/hostnetwork/future"
+	"github.com/insolar/insolar/network/hostnetwork/host"
+	"github.com/insolar/insolar/network/hostnetwork/packet"
+	"github.com/insolar/insolar/network/transport"
+)
+
+// frame kinds, the first byte of every datagram sent over simulated network
+const (
+	frameHostPacket = byte(iota)
+	frameConsensusPacket
+)
+
+type simTransport struct {
+	network       *Network
+	address       string
+	futureManager future.Manager
+	packetHandler future.PacketHandler
+
+	inbox   chan []byte
+	stopped chan bool
+
+	versions     map[string]network.ProtocolVersion
+	versionsLock sync.RWMutex
+}
+
+// NewTransport creates transport bound to address in simulated network. Address should be a valid "host:port" string
+// because hostnetwork resolves it, but no real sockets are opened.
+func (n *Network) NewTransport(address string) (transport.Transport, string, error) {
+	if _, err := host.NewAddress(address); err != nil {
+		return nil, "", errors.Wrap(err, "[ NewTransport ] invalid address")
+	}
+	futureManager := future.NewManager()
+	return &simTransport{
+		network:       n,
+		address:       address,
+		futureManager: futureManager,
+		packetHandler: future.NewPacketHandler(futureManager),
+		inbox:         make(chan []byte, inboxSize),
+		stopped:       make(chan bool, 1),
+		versions:      make(map[string]network.ProtocolVersion),
+	}, address, nil
+}
+
+// SendRequest sends request packet and returns future.
+func (t *simTransport) SendRequest(ctx context.Context, msg *packet.Packet) (future.Future, error) {
+	f := t.futureManager.Create(msg)
+	err := t.SendPacket(ctx, msg)
+	if err != nil {
+		f.Cancel()
+		return nil, errors.Wrap(err, "Failed to send transport packet")
+	}
+	return f, nil
+}
+
+// SendResponse sends response packet.
+func (t *simTransport) SendResponse(ctx context.Context, requestID network.RequestID, msg *packet.Packet) error {
+	msg.RequestID = requestID
+	return t.SendPacket(ctx, msg)
+}
+
+// SendPacket serializes packet and passes it to simulated network.
+func (t *simTransport) SendPacket(ctx context.Context, p *packet.Packet) error {
+	recvAddress := p.Receiver.Address.String()
+	data, err := t.serialize(p, recvAddress)
+	if err != nil {
+		return errors.Wrap(err, "Failed to serialize packet")
+	}
+	t.network.send(t.address, recvAddress, data)
+	return nil
+}
+
+// Start registers transport in simulated network and starts processing of delivered packets.
+func (t *simTransport) Start(ctx context.Context) error {
+	t.network.register(t.address, t)
+	go t.listen(ctx)
+	return nil
+}
+
+// Stop removes transport from simulated network.
+func (t *simTransport) Stop() {
+	t.network.unregister(t.address, t)
+	t.stopped <- true
+	close(t.stopped)
+}
+
+// Close does nothing, simulated transport has no underlying resources.
+func (t *simTransport) Close() {}
+
+// Packets returns incoming packets channel.
+func (t *simTransport) Packets() <-chan *packet.Packet {
+	return t.packetHandler.Received()
+}
+
+// Stopped returns signal channel to support graceful shutdown.
+func (t *simTransport) Stopped() <-chan bool {
+	return t.stopped
+}
+
+// SetProtocolVersion sets wire format for packets sent to address.
+func (t *simTransport) SetProtocolVersion(address string, version network.ProtocolVersion) {
+	t.versionsLock.Lock()
+	defer t.versionsLock.Unlock()
+
+	t.versions[address] = version
+}
+
+// RetainProtocolVersions forgets wire formats of hosts that are not listed in addresses.
+func (t *simTransport) RetainProtocolVersions(addresses []string) {
+	keep := make(map[string]struct{}, len(addresses))
+	for _, address := range addresses {
+		keep[address] = struct{}{}
+	}
+
+	t.versionsLock.Lock()
+	defer t.versionsLock.Unlock()
+
+	for address := range t.versions {
+		if _, ok := keep[address]; !ok {
+			delete(t.versions, address)
+		}
+	}
+}
+
+func (t *simTransport) getProtocolVersion(address string) network.ProtocolVersion {
+	t.versionsLock.RLock()
+	defer t.versionsLock.RUnlock()
+
+	return t.versions[address]
+}
+
+// enqueue is called by Network under its lock, so it must not block.
+func (t *simTransport) enqueue(data []byte) bool {
+	select {
+	case t.inbox <- data:
+		return true
+	default:
+		return false
+	}
+}
+
+func (t *simTransport) listen(ctx context.Context) {
+	for {
+		select {
+		case data := <-t.inbox:
+			t.handle(ctx, data)
+		case <-t.stopped:
+			return
+		}
+	}
+}
+
+func (t *simTransport) handle(ctx context.Context, data []byte) {
+	p, err := deserialize(data)
+	if err != nil {
+		inslogger.FromContext(ctx).Error("[ simTransport ] Failed to deserialize packet: ", err.Error())
+		return
+	}
+	if p.Sender != nil && p.Sender.Address != nil {
+		t.SetProtocolVersion(p.Sender.Address.String(), p.ProtocolVersion)
+	}
+	t.packetHandler.Handle(ctx, p)
+}
+
+func (t *simTransport) serialize(p *packet.Packet, recvAddress string) ([]byte, error) {
+	var buf bytes.Buffer
+	if consensusPacket, ok := p.Data.(packets.ConsensusPacket); ok {
+		data, err := consensusPacket.Serialize()
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteByte(frameConsensusPacket)
+		buf.Write(data)
+		return buf.Bytes(), nil
+	}
+
+	data, err := packet.SerializePacketVersion(p, t.getProtocolVersion(recvAddress))
+	if err != nil {
+		return nil, err
+	}
+	buf.WriteByte(frameHostPacket)
+	buf.Write(data)
+	return buf.Bytes(), nil
+}
+
+func deserialize(data []byte) (*packet.Packet, error) {
+	if len(data) == 0 {
+		return nil, errors.New("empty datagram")
+	}
+	reader := bytes.NewReader(data[1:])
+	switch data[0] {
+	case frameConsensusPacket:
+		consensusPacket, err := packets.ExtractPacket(reader)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not convert datagram to ConsensusPacket")
+		}
+		return &packet.Packet{Data: consensusPacket}, nil
+	case frameHostPacket:
+		return packet.DeserializePacket(reader)
+	default:
+		return nil, errors.Errorf("unknown frame kind %d", data[0])
+	}
+}
@@ -0,0 +1,196 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+
+package telemetry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+)
+
+// Recorder keeps reports of recent consensus rounds.
+//go:generate minimock -i github.com/insolar/insolar/network/telemetry.Recorder -o ../../testutils/network -s _mock.go
+type Recorder interface {
+	// Record saves report of the finished round. Reports of failed rounds are also dumped to files.
+	Record(ctx context.Context, report *Report)
+	// Reports returns up to limit latest reports, from the oldest to the newest. Zero limit returns all kept reports.
+	Reports(limit int) []Report
+	// Report returns report of the round for the pulse.
+	Report(pulse insolar.PulseNumber) (*Report, bool)
+}
+
+type recorder struct {
+	lock    sync.RWMutex
+	reports []Report
+	next    int
+	full    bool
+	dumpDir string
+}
+
+// NewRecorder creates Recorder that keeps ConsensusReportsLimit latest reports and dumps reports of failed rounds
+// to ConsensusDumpDirectory. Dumps are disabled if directory is empty.
+func NewRecorder(cfg configuration.ServiceNetwork) (Recorder, error) {
+	if cfg.ConsensusReportsLimit <= 0 {
+		return nil, errors.New("[ NewRecorder ] ConsensusReportsLimit should be positive")
+	}
+	return &recorder{
+		reports: make([]Report, cfg.ConsensusReportsLimit),
+		dumpDir: cfg.ConsensusDumpDirectory,
+	}, nil
+}
+
+func (r *recorder) Record(ctx context.Context, report *Report) {
+	if report == nil {
+		return
+	}
+
+	r.lock.Lock()
+	r.reports[r.next] = *report
+	r.next = (r.next + 1) % len(r.reports)
+	r.full = r.full || r.next == 0
+	r.lock.Unlock()
+
+	if report.Decision.Success || r.dumpDir == "" {
+		return
+	}
+	path, err := Dump(r.dumpDir, report)
+	if err != nil {
+		inslogger.FromContext(ctx).Warn("[ Recorder.Record ] Failed to dump consensus report: ", err)
+		return
+	}
+	inslogger.FromContext(ctx).Warnf("[ Recorder.Record ] Consensus for pulse %d failed, report is dumped to %s", report.Pulse, path)
+}
+
+func (r *recorder) Reports(limit int) []Report {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	ordered := make([]Report, 0, len(r.reports))
+	if r.full {
+		ordered = append(ordered, r.reports[r.next:]...)
+	}
+	ordered = append(ordered, r.reports[:r.next]...)
+
+	if limit > 0 && limit < len(ordered) {
+		ordered = ordered[len(ordered)-limit:]
+	}
+	return ordered
+}
+
+func (r *recorder) Report(pulse insolar.PulseNumber) (*Report, bool) {
+	reports := r.Reports(0)
+	for i := len(reports) - 1; i >= 0; i-- {
+		if reports[i].Pulse == pulse {
+			return &reports[i], true
+		}
+	}
+	return nil, false
+}
+
+// Dump writes report to a file in the directory and returns path of the file.
+func Dump(dir string, report *Report) (string, error) {
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return "", errors.Wrap(err, "[ Dump ] Failed to create dump directory")
+	}
+	data, err := json.MarshalIndent(report, "", "    ")
+	if err != nil {
+		return "", errors.Wrap(err, "[ Dump ] Failed to marshal report")
+	}
+	path := filepath.Join(dir, fmt.Sprintf("consensus_%d_%s.json", report.Pulse, shortNodeName(report.Node)))
+	err = ioutil.WriteFile(path, data, 0644)
+	if err != nil {
+		return "", errors.Wrap(err, "[ Dump ] Failed to write report")
+	}
+	return path, nil
+}
+
+// Load reads reports from the file. File may contain a single report written by Dump,
+// an array of reports or the result of consensus.Reports API method.
+func Load(path string) ([]Report, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "[ Load ] Failed to read file")
+	}
+
+	var reports []Report
+	if err := json.Unmarshal(data, &reports); err == nil {
+		return reports, nil
+	}
+	var reply struct {
+		Reports []Report
+	}
+	if err := json.Unmarshal(data, &reply); err == nil && reply.Reports != nil {
+		return reply.Reports, nil
+	}
+	var report Report
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, errors.Wrapf(err, "[ Load ] Failed to parse reports from %s", path)
+	}
+	return []Report{report}, nil
+}
+
+func shortNodeName(node string) string {
+	const length = 8
+	if len(node) > length {
+		return node[:length]
+	}
+	return node
+}
@@ -0,0 +1,154 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+
+package telemetry
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+)
+
+func newTestRecorder(t *testing.T, limit int, dumpDir string) Recorder {
+	cfg := configuration.NewServiceNetwork()
+	cfg.ConsensusReportsLimit = limit
+	cfg.ConsensusDumpDirectory = dumpDir
+	r, err := NewRecorder(cfg)
+	require.NoError(t, err)
+	return r
+}
+
+func TestNewRecorder_BadLimit(t *testing.T) {
+	cfg := configuration.NewServiceNetwork()
+	cfg.ConsensusReportsLimit = 0
+	_, err := NewRecorder(cfg)
+	require.Error(t, err)
+}
+
+func TestRecorder_RingBuffer(t *testing.T) {
+	r := newTestRecorder(t, 3, "")
+	assert.Empty(t, r.Reports(0))
+
+	for pulse := insolar.PulseNumber(1); pulse <= 5; pulse++ {
+		r.Record(context.Background(), &Report{Pulse: pulse, Decision: Decision{Success: true}})
+	}
+
+	pulses := func(reports []Report) []insolar.PulseNumber {
+		res := make([]insolar.PulseNumber, 0, len(reports))
+		for _, report := range reports {
+			res = append(res, report.Pulse)
+		}
+		return res
+	}
+	assert.Equal(t, []insolar.PulseNumber{3, 4, 5}, pulses(r.Reports(0)))
+	assert.Equal(t, []insolar.PulseNumber{4, 5}, pulses(r.Reports(2)))
+	assert.Equal(t, []insolar.PulseNumber{3, 4, 5}, pulses(r.Reports(10)))
+
+	report, ok := r.Report(4)
+	require.True(t, ok)
+	assert.Equal(t, insolar.PulseNumber(4), report.Pulse)
+	_, ok = r.Report(2)
+	assert.False(t, ok)
+}
+
+func TestRecorder_DumpFailed(t *testing.T) {
+	dir, err := ioutil.TempDir("", "consensus-dumps-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	r := newTestRecorder(t, 10, dir)
+	r.Record(context.Background(), &Report{Pulse: 1, Node: "successfulnode", Decision: Decision{Success: true}})
+	r.Record(context.Background(), &Report{Pulse: 2, Node: "failednode", Decision: Decision{Error: "timeout"}})
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	assert.Equal(t, "consensus_2_failedno.json", filepath.Base(files[0]))
+
+	reports, err := Load(files[0])
+	require.NoError(t, err)
+	require.Len(t, reports, 1)
+	assert.Equal(t, insolar.PulseNumber(2), reports[0].Pulse)
+	assert.Equal(t, "timeout", reports[0].Decision.Error)
+}
+
+func TestLoad_APIReply(t *testing.T) {
+	dir, err := ioutil.TempDir("", "consensus-dumps-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	reports := []Report{{Pulse: 1}, {Pulse: 2}}
+	write := func(name string, v interface{}) string {
+		data, err := json.Marshal(v)
+		require.NoError(t, err)
+		path := filepath.Join(dir, name)
+		require.NoError(t, ioutil.WriteFile(path, data, 0644))
+		return path
+	}
+
+	loaded, err := Load(write("array.json", reports))
+	require.NoError(t, err)
+	assert.Equal(t, reports, loaded)
+
+	loaded, err = Load(write("reply.json", map[string]interface{}{"Reports": reports}))
+	require.NoError(t, err)
+	assert.Equal(t, reports, loaded)
+
+	_, err = Load(write("bad.json", "not a report"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,262 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+
+package telemetry
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/insolar/insolar/insolar"
+)
+
+// Names of consensus phases used in reports.
+const (
+	Phase1  = "1"
+	Phase2  = "2"
+	Phase21 = "2.1"
+	Phase3  = "3"
+)
+
+// LegitState is a name of bitset state of node that behaves correctly.
+const LegitState = "Legit"
+
+// expectedPhases are phases in which every participant should send a packet to the origin.
+var expectedPhases = []string{Phase1, Phase2, Phase3}
+
+// PhaseTiming describes execution of one consensus phase.
+type PhaseTiming struct {
+	Phase    string
+	Start    time.Time
+	Duration time.Duration
+	Timeout  time.Duration
+	Error    string `json:",omitempty"`
+}
+
+// Participant describes packets received from a participant of consensus.
+type Participant struct {
+	Node     string
+	Received []string
+	Missing  []string
+}
+
+// Decision is a result of consensus round.
+type Decision struct {
+	Success        bool
+	Error          string `json:",omitempty"`
+	ActiveNodes    int
+	ApprovedClaims int
+}
+
+// Report describes one consensus round on a node.
+type Report struct {
+	Pulse        insolar.PulseNumber
+	Node         string
+	Start        time.Time
+	Finish       time.Time
+	Phases       []PhaseTiming
+	Participants []Participant
+	// BitSets contains bitset state of each node by phase after which bitset was taken
+	BitSets  map[string]map[string]string
+	Decision Decision
+}
+
+// Round collects report of the current consensus round. All methods are safe for concurrent use
+// and can be called on nil Round.
+type Round struct {
+	lock         sync.Mutex
+	report       Report
+	participants []insolar.Reference
+	received     map[insolar.Reference]map[string]struct{}
+}
+
+// NewRound creates Round for the pulse on the origin node with the list of expected participants.
+func NewRound(pulse insolar.PulseNumber, origin insolar.Reference, participants []insolar.Reference) *Round {
+	return &Round{
+		report: Report{
+			Pulse:   pulse,
+			Node:    origin.String(),
+			Start:   time.Now(),
+			BitSets: make(map[string]map[string]string),
+		},
+		participants: participants,
+		received:     make(map[insolar.Reference]map[string]struct{}),
+	}
+}
+
+// StartPhase marks the start of the phase.
+func (r *Round) StartPhase(phase string, timeout time.Duration) {
+	if r == nil {
+		return
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.report.Phases = append(r.report.Phases, PhaseTiming{Phase: phase, Start: time.Now(), Timeout: timeout})
+}
+
+// EndPhase marks the end of the phase started last with the StartPhase.
+func (r *Round) EndPhase(phase string, err error) {
+	if r == nil {
+		return
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for i := len(r.report.Phases) - 1; i >= 0; i-- {
+		timing := &r.report.Phases[i]
+		if timing.Phase != phase {
+			continue
+		}
+		timing.Duration = time.Since(timing.Start)
+		if err != nil {
+			timing.Error = err.Error()
+		}
+		return
+	}
+}
+
+// PacketReceived marks packets of the phase received from nodes.
+func (r *Round) PacketReceived(phase string, nodes ...insolar.Reference) {
+	if r == nil {
+		return
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for _, node := range nodes {
+		if r.received[node] == nil {
+			r.received[node] = make(map[string]struct{})
+		}
+		r.received[node][phase] = struct{}{}
+	}
+}
+
+// SetBitSet saves bitset states of nodes after the phase.
+func (r *Round) SetBitSet(phase string, states map[insolar.Reference]string) {
+	if r == nil {
+		return
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	bitset := make(map[string]string, len(states))
+	for node, state := range states {
+		bitset[node.String()] = state
+	}
+	r.report.BitSets[phase] = bitset
+}
+
+// SetResult saves the number of nodes and claims in consensus result.
+func (r *Round) SetResult(activeNodes, approvedClaims int) {
+	if r == nil {
+		return
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.report.Decision.ActiveNodes = activeNodes
+	r.report.Decision.ApprovedClaims = approvedClaims
+}
+
+// Finish completes the round with the decision and returns its report.
+func (r *Round) Finish(err error) *Report {
+	if r == nil {
+		return nil
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.report.Finish = time.Now()
+	r.report.Decision.Success = err == nil
+	if err != nil {
+		r.report.Decision.Error = err.Error()
+	}
+
+	started := make(map[string]struct{}, len(r.report.Phases))
+	for _, timing := range r.report.Phases {
+		started[timing.Phase] = struct{}{}
+	}
+
+	r.report.Participants = make([]Participant, 0, len(r.participants))
+	for _, node := range r.participants {
+		participant := Participant{Node: node.String(), Received: []string{}, Missing: []string{}}
+		for phase := range r.received[node] {
+			participant.Received = append(participant.Received, phase)
+		}
+		sort.Strings(participant.Received)
+		for _, phase := range expectedPhases {
+			if _, ok := started[phase]; !ok {
+				continue
+			}
+			if _, ok := r.received[node][phase]; !ok {
+				participant.Missing = append(participant.Missing, phase)
+			}
+		}
+		r.report.Participants = append(r.report.Participants, participant)
+	}
+
+	report := r.report
+	return &report
+}
+
+type roundKey struct{}
+
+// WithRound returns context with the Round.
+func WithRound(ctx context.Context, round *Round) context.Context {
+	return context.WithValue(ctx, roundKey{}, round)
+}
+
+// FromContext returns the Round from context or nil if there is no Round in context.
+func FromContext(ctx context.Context) *Round {
+	round, _ := ctx.Value(roundKey{}).(*Round)
+	return round
+}
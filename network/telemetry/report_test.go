@@ -0,0 +1,130 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+
+package telemetry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/testutils"
+)
+
+func TestRound_Finish(t *testing.T) {
+	origin := testutils.RandomRef()
+	other := testutils.RandomRef()
+	round := NewRound(insolar.FirstPulseNumber, origin, []insolar.Reference{origin, other})
+
+	round.StartPhase(Phase1, time.Second)
+	round.PacketReceived(Phase1, origin, other)
+	round.EndPhase(Phase1, nil)
+	round.StartPhase(Phase2, time.Second)
+	round.PacketReceived(Phase2, origin)
+	round.SetBitSet(Phase2, map[insolar.Reference]string{origin: LegitState, other: "TimedOut"})
+	round.EndPhase(Phase2, errors.New("timeout"))
+
+	report := round.Finish(errors.New("phase 2 failed"))
+	require.NotNil(t, report)
+
+	assert.Equal(t, insolar.FirstPulseNumber, int(report.Pulse))
+	assert.Equal(t, origin.String(), report.Node)
+	assert.False(t, report.Decision.Success)
+	assert.Equal(t, "phase 2 failed", report.Decision.Error)
+
+	require.Len(t, report.Phases, 2)
+	assert.Equal(t, Phase1, report.Phases[0].Phase)
+	assert.Empty(t, report.Phases[0].Error)
+	assert.Equal(t, time.Second, report.Phases[0].Timeout)
+	assert.Equal(t, "timeout", report.Phases[1].Error)
+
+	require.Len(t, report.Participants, 2)
+	assert.Equal(t, []string{Phase1, Phase2}, report.Participants[0].Received)
+	assert.Empty(t, report.Participants[0].Missing)
+	assert.Equal(t, []string{Phase1}, report.Participants[1].Received)
+	// phase 3 was not started, so its packets are not missing
+	assert.Equal(t, []string{Phase2}, report.Participants[1].Missing)
+
+	assert.Equal(t, "TimedOut", report.BitSets[Phase2][other.String()])
+}
+
+func TestRound_Success(t *testing.T) {
+	origin := testutils.RandomRef()
+	round := NewRound(insolar.FirstPulseNumber, origin, []insolar.Reference{origin})
+	round.SetResult(1, 2)
+
+	report := round.Finish(nil)
+	assert.True(t, report.Decision.Success)
+	assert.Empty(t, report.Decision.Error)
+	assert.Equal(t, 1, report.Decision.ActiveNodes)
+	assert.Equal(t, 2, report.Decision.ApprovedClaims)
+}
+
+func TestRound_Nil(t *testing.T) {
+	round := FromContext(context.Background())
+	require.Nil(t, round)
+
+	round.StartPhase(Phase1, time.Second)
+	round.PacketReceived(Phase1, testutils.RandomRef())
+	round.EndPhase(Phase1, nil)
+	round.SetBitSet(Phase1, nil)
+	round.SetResult(1, 0)
+	assert.Nil(t, round.Finish(nil))
+}
+
+func TestWithRound(t *testing.T) {
+	round := NewRound(insolar.FirstPulseNumber, testutils.RandomRef(), nil)
+	ctx := WithRound(context.Background(), round)
+	assert.Equal(t, round, FromContext(ctx))
+}
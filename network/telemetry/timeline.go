@@ -0,0 +1,144 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+
+package telemetry
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/insolar/insolar/insolar"
+)
+
+// Event is an entry of merged consensus timeline.
+type Event struct {
+	Time    time.Time
+	Pulse   insolar.PulseNumber
+	Node    string
+	Message string
+}
+
+// MergeTimeline merges reports from several nodes into one timeline sorted by pulse and time.
+// Node clocks are not synchronized, so order of events from different nodes is approximate.
+func MergeTimeline(reports []Report) []Event {
+	events := make([]Event, 0)
+	for _, report := range reports {
+		add := func(t time.Time, format string, args ...interface{}) {
+			events = append(events, Event{
+				Time:    t,
+				Pulse:   report.Pulse,
+				Node:    report.Node,
+				Message: fmt.Sprintf(format, args...),
+			})
+		}
+
+		add(report.Start, "consensus started, participants: %d", len(report.Participants))
+		for _, timing := range report.Phases {
+			add(timing.Start, "phase %s started, timeout: %s", timing.Phase, timing.Timeout)
+			end := timing.Start.Add(timing.Duration)
+			if timing.Error != "" {
+				add(end, "phase %s failed after %s: %s", timing.Phase, timing.Duration, timing.Error)
+				continue
+			}
+			add(end, "phase %s finished in %s", timing.Phase, timing.Duration)
+		}
+		for _, participant := range report.Participants {
+			if len(participant.Missing) != 0 {
+				add(report.Finish, "no packets of phases %s from %s", strings.Join(participant.Missing, ", "), participant.Node)
+			}
+		}
+		phases := make([]string, 0, len(report.BitSets))
+		for phase := range report.BitSets {
+			phases = append(phases, phase)
+		}
+		sort.Strings(phases)
+		for _, phase := range phases {
+			bitset := report.BitSets[phase]
+			nodes := make([]string, 0, len(bitset))
+			for node, state := range bitset {
+				if state != LegitState {
+					nodes = append(nodes, node)
+				}
+			}
+			sort.Strings(nodes)
+			for _, node := range nodes {
+				add(report.Finish, "bitset after phase %s: %s is %s", phase, node, bitset[node])
+			}
+		}
+		if report.Decision.Success {
+			add(report.Finish, "consensus succeeded, active nodes: %d, approved claims: %d",
+				report.Decision.ActiveNodes, report.Decision.ApprovedClaims)
+		} else {
+			add(report.Finish, "consensus failed: %s", report.Decision.Error)
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].Pulse != events[j].Pulse {
+			return events[i].Pulse < events[j].Pulse
+		}
+		return events[i].Time.Before(events[j].Time)
+	})
+	return events
+}
+
+// WriteTimeline writes timeline in human readable form.
+func WriteTimeline(w io.Writer, events []Event) error {
+	for _, event := range events {
+		_, err := fmt.Fprintf(w, "%s pulse %d node %s: %s\n",
+			event.Time.Format("15:04:05.000000"), event.Pulse, shortNodeName(event.Node), event.Message)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+
+package telemetry
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeTimeline(t *testing.T) {
+	start := time.Now()
+	reports := []Report{
+		{
+			Pulse:    2,
+			Node:     "node1",
+			Start:    start.Add(time.Second),
+			Finish:   start.Add(2 * time.Second),
+			Decision: Decision{Success: true, ActiveNodes: 2},
+		},
+		{
+			Pulse:  1,
+			Node:   "node2",
+			Start:  start,
+			Finish: start.Add(time.Second),
+			Phases: []PhaseTiming{
+				{Phase: Phase1, Start: start, Duration: time.Millisecond, Timeout: time.Second, Error: "timeout"},
+			},
+			Participants: []Participant{{Node: "node1", Missing: []string{Phase1}}},
+			BitSets:      map[string]map[string]string{Phase2: {"node1": "TimedOut", "node2": LegitState}},
+			Decision:     Decision{Error: "phase 1 failed"},
+		},
+		{
+			Pulse:    1,
+			Node:     "node1",
+			Start:    start.Add(time.Millisecond / 2),
+			Finish:   start.Add(time.Second / 2),
+			Decision: Decision{Success: true},
+		},
+	}
+
+	events := MergeTimeline(reports)
+	require.Len(t, events, 10)
+
+	for i := 1; i < len(events); i++ {
+		prev, cur := events[i-1], events[i]
+		assert.True(t, prev.Pulse < cur.Pulse || prev.Pulse == cur.Pulse && !cur.Time.Before(prev.Time),
+			"events are not sorted: %v, %v", prev, cur)
+	}
+	assert.Equal(t, "node2", events[0].Node)
+	assert.Equal(t, "node1", events[2].Node)
+	assert.Contains(t, events[len(events)-1].Message, "consensus succeeded")
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteTimeline(&buf, events))
+	out := buf.String()
+	assert.Contains(t, out, "phase 1 failed after 1ms: timeout")
+	assert.Contains(t, out, "no packets of phases 1 from node1")
+	assert.Contains(t, out, "bitset after phase 2: node1 is TimedOut")
+	assert.Contains(t, out, "consensus failed: phase 1 failed")
+}
@@ -65,14 +65,14 @@ import (
 )
 
 type transportSerializer interface {
-	SerializePacket(q *packet.Packet) ([]byte, error)
+	SerializePacket(q *packet.Packet, version network.ProtocolVersion) ([]byte, error)
 	DeserializePacket(conn io.Reader) (*packet.Packet, error)
 }
 
 type baseSerializer struct{}
 
-func (b *baseSerializer) SerializePacket(q *packet.Packet) ([]byte, error) {
-	return packet.SerializePacket(q)
+func (b *baseSerializer) SerializePacket(q *packet.Packet, version network.ProtocolVersion) ([]byte, error) {
+	return packet.SerializePacketVersion(q, version)
 }
 
 func (b *baseSerializer) DeserializePacket(conn io.Reader) (*packet.Packet, error) {
@@ -89,6 +89,9 @@ type baseTransport struct {
 
 	mutex *sync.RWMutex
 
+	versions     map[string]network.ProtocolVersion
+	versionsLock sync.RWMutex
+
 	publicAddress string
 	sendFunc      func(recvAddress string, data []byte) error
 }
@@ -102,6 +105,8 @@ func newBaseTransport(publicAddress string) baseTransport {
 
 		mutex: &sync.RWMutex{},
 
+		versions: make(map[string]network.ProtocolVersion),
+
 		disconnectStarted:  make(chan bool, 1),
 		disconnectFinished: make(chan bool, 1),
 
@@ -154,9 +159,56 @@ func (t *baseTransport) prepareDisconnect() {
 	close(t.disconnectStarted)
 }
 
+// SetProtocolVersion sets wire format for packets sent to address.
+func (t *baseTransport) SetProtocolVersion(address string, version network.ProtocolVersion) {
+	t.versionsLock.Lock()
+	defer t.versionsLock.Unlock()
+
+	t.versions[address] = version
+}
+
+// RetainProtocolVersions forgets wire formats of hosts that are not listed in addresses.
+func (t *baseTransport) RetainProtocolVersions(addresses []string) {
+	keep := make(map[string]struct{}, len(addresses))
+	for _, address := range addresses {
+		keep[address] = struct{}{}
+	}
+
+	t.versionsLock.Lock()
+	defer t.versionsLock.Unlock()
+
+	for address := range t.versions {
+		if _, ok := keep[address]; !ok {
+			delete(t.versions, address)
+		}
+	}
+}
+
+func (t *baseTransport) getProtocolVersion(address string) network.ProtocolVersion {
+	t.versionsLock.RLock()
+	defer t.versionsLock.RUnlock()
+
+	return t.versions[address]
+}
+
+// handlePacket learns wire format advertised by sender and passes packet to packet handler.
+func (t *baseTransport) handlePacket(ctx context.Context, msg *packet.Packet) {
+	if msg.Sender != nil && msg.Sender.Address != nil {
+		version := msg.ProtocolVersion
+		if version > network.ProtocolCurrent {
+			version = network.ProtocolCurrent
+		}
+		address := msg.Sender.Address.String()
+		if t.getProtocolVersion(address) != version {
+			t.SetProtocolVersion(address, version)
+		}
+	}
+	t.packetHandler.Handle(ctx, msg)
+}
+
 func (t *baseTransport) SendPacket(ctx context.Context, p *packet.Packet) error {
 	recvAddress := p.Receiver.Address.String()
-	data, err := t.serializer.SerializePacket(p)
+	data, err := t.serializer.SerializePacket(p, t.getProtocolVersion(recvAddress))
 	if err != nil {
 		return errors.Wrap(err, "Failed to serialize packet")
 	}
@@ -46,98 +46,156 @@
 //    including, without limitation, any software-as-a-service, platform-as-a-service,
 //    infrastructure-as-a-service or other similar online service, irrespective of
 //    whether it competes with the products or services of Insolar Technologies GmbH.
-//
 
 package transport
 
 import (
 	"context"
-	"crypto/rand"
-	"crypto/rsa"
 	"crypto/tls"
-	"crypto/x509"
-	"encoding/pem"
-	"math/big"
+	"io"
 	"net"
+	"strings"
+	"time"
 
 	"github.com/lucas-clemente/quic-go"
 	"github.com/pkg/errors"
 
+	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/log"
+	"github.com/insolar/insolar/metrics"
+	"github.com/insolar/insolar/network/transport/pool"
 	"github.com/insolar/insolar/network/utils"
 )
 
-type quicConnection struct {
-	session quic.Session
-	stream  quic.Stream
+var quicConfig = &quic.Config{
+	KeepAlive: true,
 }
 
 type quicTransport struct {
 	baseTransport
-	l           quic.Listener
-	conn        net.PacketConn
-	connections map[string]quicConnection
+
+	pool     pool.ConnectionPool
+	listener quic.Listener
+	conn     net.PacketConn
+	config   *tls.Config
+	address  string
 }
 
-func NewQuicTransport(conn net.PacketConn, publicAddress string) (Transport, error) {
-	listener, err := quic.Listen(conn, generateTLSConfig(), nil)
+func newQuicTransport(listenAddress, fixedPublicAddress string, credentials *Credentials) (*quicTransport, string, error) {
+	config, err := newTLSConfig(credentials)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to create TLS config")
+	}
+
+	conn, err := net.ListenPacket("udp", listenAddress)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to listen UDP")
+	}
+	publicAddress, err := Resolve(fixedPublicAddress, conn.LocalAddr().String())
 	if err != nil {
-		return nil, err
+		utils.CloseVerbose(conn)
+		return nil, "", errors.Wrap(err, "failed to resolve public address")
 	}
 
 	transport := &quicTransport{
 		baseTransport: newBaseTransport(publicAddress),
-		l:             listener,
 		conn:          conn,
-		connections:   make(map[string]quicConnection),
+		config:        config,
+		pool:          pool.NewConnectionPool(&quicConnectionFactory{config: config}),
 	}
 
 	transport.sendFunc = transport.send
-	return transport, nil
+
+	return transport, publicAddress, nil
 }
 
-func (t *quicTransport) send(recvAddress string, data []byte) error {
-	conn, ok := t.connections[recvAddress]
-	var stream quic.Stream
-	var err error
-	if !ok {
-		var session quic.Session
-		session, stream, err = createConnection(recvAddress)
+func (t *quicTransport) send(address string, data []byte) error {
+	ctx := context.Background()
+	logger := inslogger.FromContext(ctx)
+
+	addr, err := net.ResolveUDPAddr("udp", address)
+	if err != nil {
+		return errors.Wrap(err, "[ send ] Failed to resolve net address")
+	}
+
+	conn, err := t.pool.GetConnection(ctx, addr)
+	if err != nil {
+		return errors.Wrap(err, "[ send ] Failed to get connection")
+	}
+
+	logger.Debug("[ send ] len = ", len(data))
+
+	n, err := conn.Write(data)
+
+	if err != nil {
+		t.pool.CloseConnection(ctx, addr)
+		conn, err = t.pool.GetConnection(ctx, addr)
 		if err != nil {
-			return errors.Wrap(err, "[ send ] failed to create a connection")
+			return errors.Wrap(err, "[ send ] Failed to get connection")
 		}
-		t.connections[recvAddress] = quicConnection{session, stream}
-	} else {
-		stream = conn.stream
+		n, err = conn.Write(data)
 	}
 
-	n, err := stream.Write(data)
-	if err != nil {
-		return errors.Wrap(err, "[ send ] failed to write to a stream")
+	if err == nil {
+		metrics.NetworkSentSize.Add(float64(n))
+		return nil
 	}
+	return errors.Wrap(err, "[ send ] Failed to write data")
+}
+
+func (t *quicTransport) prepareListen() (quic.Listener, error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.disconnectStarted = make(chan bool, 1)
+	t.disconnectFinished = make(chan bool, 1)
 
-	if n != len(data) {
-		return errors.New("[ send ] sent a part of data")
+	var err error
+	if t.conn != nil {
+		t.address = t.conn.LocalAddr().String()
+	} else {
+		t.conn, err = net.ListenPacket("udp", t.address)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to listen UDP")
+		}
 	}
 
-	return nil
+	t.listener, err = quic.Listen(t.conn, t.config, quicConfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to listen QUIC")
+	}
+
+	return t.listener, nil
 }
 
 // Start starts networking.
 func (t *quicTransport) Start(ctx context.Context) error {
-	log.Debug("Start QUIC transport")
+	logger := inslogger.FromContext(ctx)
+	logger.Info("[ Start ] Start QUIC transport")
+
+	listener, err := t.prepareListen()
+	if err != nil {
+		logger.Info("[ Start ] Failed to prepare QUIC transport: ", err.Error())
+		return err
+	}
 
 	go func() {
 		for {
-			session, err := t.l.Accept()
+			session, err := listener.Accept()
 			if err != nil {
 				<-t.disconnectFinished
-				log.Error("failed to accept connection: ", err.Error())
+				if strings.Contains(strings.ToLower(err.Error()), "server closed") {
+					logger.Info("Listener closed, quiting accept loop")
+					return
+				}
+
+				logger.Error("[ Start ] Failed to accept session: ", err.Error())
 				return
 			}
 
-			log.Debug("accepted connection from ", session.RemoteAddr().String())
-			go t.handleAcceptedConnection(session)
+			logger.Debugf("[ Start ] Accepted new session from %s", session.RemoteAddr())
+
+			go t.handleAcceptedSession(session)
 		}
 	}()
 
@@ -149,66 +207,142 @@ func (t *quicTransport) Stop() {
 	t.mutex.Lock()
 	defer t.mutex.Unlock()
 
-	log.Debug("[ Stop ] Stop QUIC transport")
+	log.Info("[ Stop ] Stop QUIC transport")
 	t.prepareDisconnect()
 
-	utils.CloseVerbose(t.l)
-
-	for _, conn := range t.connections {
-		utils.CloseVerbose(conn.stream)
-		utils.CloseVerbose(conn.session)
+	if t.listener != nil {
+		utils.CloseVerbose(t.listener)
+		t.listener = nil
 	}
-
-	utils.CloseVerbose(t.conn)
+	if t.conn != nil {
+		utils.CloseVerbose(t.conn)
+		t.conn = nil
+	}
+	t.pool.Reset()
 }
 
-func (t *quicTransport) handleAcceptedConnection(session quic.Session) {
-	stream, err := session.AcceptStream()
-	if err != nil {
-		log.Error(err, "[ handleAcceptedConnection ] failed to get a stream")
+// handleAcceptedSession authenticates peer and accepts streams from its session, every stream carries its own packets.
+func (t *quicTransport) handleAcceptedSession(session quic.Session) {
+	defer utils.CloseVerbose(session)
+
+	if err := quicServerHandshake(session, t.config); err != nil {
+		log.Warnf("[ handleAcceptedSession ] Rejected session from %s: %s", session.RemoteAddr(), err.Error())
+		return
 	}
 
-	msg, err := t.serializer.DeserializePacket(stream)
-	if err != nil {
-		log.Error(err, "[ handleAcceptedConnection ] failed to deserialize a packet")
+	for {
+		stream, err := session.AcceptStream()
+		if err != nil {
+			log.Debugf("[ handleAcceptedSession ] Session with %s closed: %s", session.RemoteAddr(), err.Error())
+			return
+		}
+
+		go t.handleAcceptedStream(stream)
 	}
+}
 
-	go t.packetHandler.Handle(context.TODO(), msg)
+func (t *quicTransport) handleAcceptedStream(stream quic.Stream) {
+	defer utils.CloseVerbose(stream)
+
+	for {
+		msg, err := t.serializer.DeserializePacket(stream)
+		if err != nil {
+			if err == io.EOF || err == io.ErrUnexpectedEOF {
+				return
+			}
+
+			log.Error("[ handleAcceptedStream ] Failed to deserialize packet: ", err.Error())
+			return
+		}
 
-	utils.CloseVerbose(stream)
+		ctx, logger := inslogger.WithTraceField(context.Background(), msg.TraceID)
+		logger.Debug("[ handleAcceptedStream ] Handling packet: ", msg.RequestID)
+
+		go t.handlePacket(ctx, msg)
+	}
+}
+
+type quicConnectionFactory struct {
+	config *tls.Config
 }
 
-func createConnection(addr string) (quic.Session, quic.Stream, error) {
-	// TODO: NETD18-78
-	session, err := quic.DialAddr(addr, &tls.Config{InsecureSkipVerify: true}, nil) //nolint: gosec
+// CreateConnection dials QUIC session and checks that server key is listed in node certificate.
+// gQUIC does not support client certificates, so client authenticates itself with in-band handshake.
+func (f *quicConnectionFactory) CreateConnection(ctx context.Context, address net.Addr) (net.Conn, error) {
+	logger := inslogger.FromContext(ctx)
+
+	session, err := quic.DialAddr(address.String(), f.config, quicConfig)
 	if err != nil {
-		return nil, nil, errors.Wrap(err, "[ createConnection ] failed to create a session")
+		logger.Errorf("[ createConnection ] Failed to open session to %s: %s", address, err.Error())
+		return nil, errors.Wrap(err, "[ createConnection ] Failed to open session")
 	}
-	stream, err := session.OpenStreamSync()
+
+	certificates := session.ConnectionState().PeerCertificates
+	rawCerts := make([][]byte, 0, len(certificates))
+	for _, certificate := range certificates {
+		rawCerts = append(rawCerts, certificate.Raw)
+	}
+	err = f.config.VerifyPeerCertificate(rawCerts, nil)
 	if err != nil {
-		return nil, nil, errors.Wrap(err, "[ createConnection ] failed to open a stream")
+		utils.CloseVerbose(session)
+		return nil, errors.Wrap(err, "[ createConnection ] Failed to verify peer")
 	}
-	log.Debug("connected to: %s", session.RemoteAddr().String())
-	return session, stream, nil
-}
 
-// Setup a bare-bones TLS config for the server
-func generateTLSConfig() *tls.Config {
-	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	err = quicClientHandshake(session, f.config)
 	if err != nil {
-		panic(err)
+		utils.CloseVerbose(session)
+		return nil, errors.Wrap(err, "[ createConnection ] Failed to authenticate")
 	}
-	template := x509.Certificate{SerialNumber: big.NewInt(1)}
-	certDER, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+
+	return &quicConnection{session: session}, nil
+}
+
+// quicConnection adapts QUIC session to net.Conn used by connection pool.
+// Each Write goes to a new stream, so packets to the same peer do not block each other.
+type quicConnection struct {
+	session quic.Session
+}
+
+func (c *quicConnection) Write(data []byte) (int, error) {
+	stream, err := c.session.OpenStreamSync()
 	if err != nil {
-		panic(err)
+		return 0, errors.Wrap(err, "failed to open stream")
 	}
-	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
-	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
+	defer utils.CloseVerbose(stream)
 
-	tlsCert, err := tls.X509KeyPair(certPEM, keyPEM)
+	n, err := stream.Write(data)
 	if err != nil {
-		panic(err)
+		return n, errors.Wrap(err, "failed to write to stream")
 	}
-	return &tls.Config{Certificates: []tls.Certificate{tlsCert}}
+	return n, nil
+}
+
+// Read blocks until session is closed, peer never writes to outgoing session.
+func (c *quicConnection) Read([]byte) (int, error) {
+	<-c.session.Context().Done()
+	return 0, io.EOF
+}
+
+func (c *quicConnection) Close() error {
+	return c.session.Close()
+}
+
+func (c *quicConnection) LocalAddr() net.Addr {
+	return c.session.LocalAddr()
+}
+
+func (c *quicConnection) RemoteAddr() net.Addr {
+	return c.session.RemoteAddr()
+}
+
+func (c *quicConnection) SetDeadline(time.Time) error {
+	return nil
+}
+
+func (c *quicConnection) SetReadDeadline(time.Time) error {
+	return nil
+}
+
+func (c *quicConnection) SetWriteDeadline(time.Time) error {
+	return nil
 }
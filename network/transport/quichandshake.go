@@ -0,0 +1,217 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+
+package transport
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/binary"
+	"io"
+	"time"
+
+	"github.com/lucas-clemente/quic-go"
+	"github.com/pkg/errors"
+)
+
+// gQUIC has no client certificates, so client proves its identity in-band: it opens the first stream of a session,
+// server replies with a random nonce and client sends its certificate and a signature of the nonce and the server
+// certificate. Server accepts other streams of the session only after successful handshake.
+const (
+	quicHandshakeNonceSize = 32
+	quicHandshakeTimeout   = 5 * time.Second
+	quicHandshakeHello     = byte(1)
+	quicHandshakeAccepted  = byte(1)
+)
+
+// quicClientHandshake authenticates client on a session dialed with config.
+func quicClientHandshake(session quic.Session, config *tls.Config) error {
+	stream, err := session.OpenStreamSync()
+	if err != nil {
+		return errors.Wrap(err, "failed to open handshake stream")
+	}
+	defer stream.Close() // nolint: errcheck
+	_ = stream.SetDeadline(time.Now().Add(quicHandshakeTimeout))
+
+	// peer sees stream only after data is written to it
+	if _, err := stream.Write([]byte{quicHandshakeHello}); err != nil {
+		return errors.Wrap(err, "failed to write hello")
+	}
+	nonce := make([]byte, quicHandshakeNonceSize)
+	if _, err := io.ReadFull(stream, nonce); err != nil {
+		return errors.Wrap(err, "failed to read nonce")
+	}
+
+	serverCerts := session.ConnectionState().PeerCertificates
+	if len(serverCerts) == 0 {
+		return errors.New("server certificate is not provided")
+	}
+	own := config.Certificates[0]
+	signer, ok := own.PrivateKey.(crypto.Signer)
+	if !ok {
+		return errors.New("private key does not implement crypto.Signer")
+	}
+	ownCert, err := x509.ParseCertificate(own.Certificate[0])
+	if err != nil {
+		return errors.Wrap(err, "failed to parse own certificate")
+	}
+	hash, err := signatureHash(ownCert.SignatureAlgorithm)
+	if err != nil {
+		return err
+	}
+	h := hash.New()
+	_, _ = h.Write(handshakeSignedData(nonce, serverCerts[0].Raw))
+	signature, err := signer.Sign(rand.Reader, h.Sum(nil), hash)
+	if err != nil {
+		return errors.Wrap(err, "failed to sign nonce")
+	}
+
+	if err := writeChunk(stream, ownCert.Raw); err != nil {
+		return errors.Wrap(err, "failed to write certificate")
+	}
+	if err := writeChunk(stream, signature); err != nil {
+		return errors.Wrap(err, "failed to write signature")
+	}
+
+	reply := make([]byte, 1)
+	if _, err := io.ReadFull(stream, reply); err != nil || reply[0] != quicHandshakeAccepted {
+		return errors.New("client is rejected by server")
+	}
+	return nil
+}
+
+// quicServerHandshake checks that client of accepted session owns a key listed in the node certificate.
+func quicServerHandshake(session quic.Session, config *tls.Config) error {
+	stream, err := session.AcceptStream()
+	if err != nil {
+		return errors.Wrap(err, "failed to accept handshake stream")
+	}
+	defer stream.Close() // nolint: errcheck
+	_ = stream.SetDeadline(time.Now().Add(quicHandshakeTimeout))
+
+	hello := make([]byte, 1)
+	if _, err := io.ReadFull(stream, hello); err != nil || hello[0] != quicHandshakeHello {
+		return errors.New("first stream is not a handshake")
+	}
+	nonce := make([]byte, quicHandshakeNonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return errors.Wrap(err, "failed to generate nonce")
+	}
+	if _, err := stream.Write(nonce); err != nil {
+		return errors.Wrap(err, "failed to write nonce")
+	}
+
+	rawCert, err := readChunk(stream)
+	if err != nil {
+		return errors.Wrap(err, "failed to read certificate")
+	}
+	signature, err := readChunk(stream)
+	if err != nil {
+		return errors.Wrap(err, "failed to read signature")
+	}
+
+	if err := config.VerifyPeerCertificate([][]byte{rawCert}, nil); err != nil {
+		return errors.Wrap(err, "failed to verify client")
+	}
+	clientCert, err := x509.ParseCertificate(rawCert)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse client certificate")
+	}
+	data := handshakeSignedData(nonce, config.Certificates[0].Certificate[0])
+	if err := clientCert.CheckSignature(clientCert.SignatureAlgorithm, data, signature); err != nil {
+		return errors.Wrap(err, "invalid handshake signature")
+	}
+
+	if _, err := stream.Write([]byte{quicHandshakeAccepted}); err != nil {
+		return errors.Wrap(err, "failed to write reply")
+	}
+	return nil
+}
+
+func handshakeSignedData(nonce, serverCert []byte) []byte {
+	data := make([]byte, 0, len(nonce)+len(serverCert))
+	data = append(data, nonce...)
+	return append(data, serverCert...)
+}
+
+func signatureHash(algorithm x509.SignatureAlgorithm) (crypto.Hash, error) {
+	switch algorithm {
+	case x509.ECDSAWithSHA256, x509.SHA256WithRSA:
+		return crypto.SHA256, nil
+	case x509.ECDSAWithSHA384, x509.SHA384WithRSA:
+		return crypto.SHA384, nil
+	case x509.ECDSAWithSHA512, x509.SHA512WithRSA:
+		return crypto.SHA512, nil
+	}
+	return 0, errors.Errorf("unsupported signature algorithm %s", algorithm)
+}
+
+func writeChunk(w io.Writer, data []byte) error {
+	header := make([]byte, 2)
+	binary.BigEndian.PutUint16(header, uint16(len(data)))
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+	_, err := w.Write(data)
+	return err
+}
+
+func readChunk(r io.Reader) ([]byte, error) {
+	header := make([]byte, 2)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, err
+	}
+	data := make([]byte, binary.BigEndian.Uint16(header))
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
@@ -70,14 +70,19 @@ type tcpTransport struct {
 
 	pool     pool.ConnectionPool
 	listener net.Listener
+	listen   func(address string) (net.Listener, error)
 	address  string
 }
 
+func listenTCP(address string) (net.Listener, error) {
+	return net.Listen("tcp", address)
+}
+
 func newTCPTransport(listenAddress, fixedPublicAddress string) (*tcpTransport, string, error) {
 
-	listener, err := net.Listen("tcp", listenAddress)
+	listener, err := listenTCP(listenAddress)
 	if err != nil {
-		return nil, "", errors.Wrap(err, "failed to listen UDP")
+		return nil, "", errors.Wrap(err, "failed to listen TCP")
 	}
 	publicAddress, err := Resolve(fixedPublicAddress, listener.Addr().String())
 	if err != nil {
@@ -87,6 +92,7 @@ func newTCPTransport(listenAddress, fixedPublicAddress string) (*tcpTransport, s
 	transport := &tcpTransport{
 		baseTransport: newBaseTransport(publicAddress),
 		listener:      listener,
+		listen:        listenTCP,
 		pool:          pool.NewConnectionPool(&tcpConnectionFactory{}),
 	}
 
@@ -140,7 +146,7 @@ func (t *tcpTransport) prepareListen() (net.Listener, error) {
 		t.address = t.listener.Addr().String()
 	} else {
 		var err error
-		t.listener, err = net.Listen("tcp", t.address)
+		t.listener, err = t.listen(t.address)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to listen TCP")
 		}
@@ -202,6 +208,11 @@ func (t *tcpTransport) Stop() {
 func (t *tcpTransport) handleAcceptedConnection(conn net.Conn) {
 	defer utils.CloseVerbose(conn)
 
+	if err := handshake(conn); err != nil {
+		log.Warnf("[ handleAcceptedConnection ] Handshake with %s failed: %s", conn.RemoteAddr(), err.Error())
+		return
+	}
+
 	for {
 		msg, err := t.serializer.DeserializePacket(conn)
 
@@ -216,7 +227,7 @@ func (t *tcpTransport) handleAcceptedConnection(conn net.Conn) {
 			ctx, logger := inslogger.WithTraceField(context.Background(), msg.TraceID)
 			logger.Debug("[ handleAcceptedConnection ] Handling packet: ", msg.RequestID)
 
-			go t.packetHandler.Handle(ctx, msg)
+			go t.handlePacket(ctx, msg)
 		}
 	}
 }
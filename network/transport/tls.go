@@ -0,0 +1,255 @@
+//
+// Modified BSD 3-Clause Clear License
+//
+// Copyright (c) 2019 Insolar Technologies GmbH
+//
+// All rights reserved.
+//
+// Redistribution and use in source and binary forms, with or without modification,
+// are permitted (subject to the limitations in the disclaimer below) provided that
+// the following conditions are met:
+//  * Redistributions of source code must retain the above copyright notice, this list
+//    of conditions and the following disclaimer.
+//  * Redistributions in binary form must reproduce the above copyright notice, this list
+//    of conditions and the following disclaimer in the documentation and/or other materials
+//    provided with the distribution.
+//  * Neither the name of Insolar Technologies GmbH nor the names of its contributors
+//    may be used to endorse or promote products derived from this software without
+//    specific prior written permission.
+//
+// NO EXPRESS OR IMPLIED LICENSES TO ANY PARTY'S PATENT RIGHTS ARE GRANTED
+// BY THIS LICENSE. THIS SOFTWARE IS PROVIDED BY THE COPYRIGHT HOLDERS
+// AND CONTRIBUTORS "AS IS" AND ANY EXPRESS OR IMPLIED WARRANTIES,
+// INCLUDING, BUT NOT LIMITED TO, THE IMPLIED WARRANTIES OF MERCHANTABILITY
+// AND FITNESS FOR A PARTICULAR PURPOSE ARE DISCLAIMED. IN NO EVENT SHALL
+// THE COPYRIGHT HOLDER OR CONTRIBUTORS BE LIABLE FOR ANY DIRECT, INDIRECT,
+// INCIDENTAL, SPECIAL, EXEMPLARY, OR CONSEQUENTIAL DAMAGES (INCLUDING,
+// BUT NOT LIMITED TO, PROCUREMENT OF SUBSTITUTE GOODS OR SERVICES; LOSS
+// OF USE, DATA, OR PROFITS; OR BUSINESS INTERRUPTION) HOWEVER CAUSED AND
+// ON ANY THEORY OF LIABILITY, WHETHER IN CONTRACT, STRICT LIABILITY, OR TORT
+// (INCLUDING NEGLIGENCE OR OTHERWISE) ARISING IN ANY WAY OUT OF THE USE
+// OF THIS SOFTWARE, EVEN IF ADVISED OF THE POSSIBILITY OF SUCH DAMAGE.
+//
+// Notwithstanding any other provisions of this license, it is prohibited to:
+//    (a) use this software,
+//
+//    (b) prepare modifications and derivative works of this software,
+//
+//    (c) distribute this software (including without limitation in source code, binary or
+//        object code form), and
+//
+//    (d) reproduce copies of this software
+//
+//    for any commercial purposes, and/or
+//
+//    for the purposes of making available this software to third parties as a service,
+//    including, without limitation, any software-as-a-service, platform-as-a-service,
+//    infrastructure-as-a-service or other similar online service, irrespective of
+//    whether it competes with the products or services of Insolar Technologies GmbH.
+
+package transport
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"math/big"
+	"net"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/instrumentation/inslogger"
+	"github.com/insolar/insolar/network/transport/pool"
+	"github.com/insolar/insolar/network/utils"
+)
+
+// Credentials holds node identity which secure transports use to authenticate itself and its peers.
+type Credentials struct {
+	// PrivateKey is the node private key from keystore
+	PrivateKey crypto.PrivateKey
+	// Certificate is the node certificate, its public key and discovery nodes keys are trusted
+	Certificate insolar.Certificate
+	// Peers provides keys of the nodes known to the network, they are trusted too. Only certificate keys are trusted
+	// if it's nil.
+	Peers PeerKeys
+}
+
+// PeerKeys provides public keys of the nodes from active and sync lists.
+type PeerKeys interface {
+	GetNodeKeys() []crypto.PublicKey
+}
+
+func newTLSTransport(listenAddress, fixedPublicAddress string, credentials *Credentials) (*tcpTransport, string, error) {
+	config, err := newTLSConfig(credentials)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to create TLS config")
+	}
+
+	listen := func(address string) (net.Listener, error) {
+		return tls.Listen("tcp", address, config)
+	}
+
+	listener, err := listen(listenAddress)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to listen TLS")
+	}
+	publicAddress, err := Resolve(fixedPublicAddress, listener.Addr().String())
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to resolve public address")
+	}
+
+	transport := &tcpTransport{
+		baseTransport: newBaseTransport(publicAddress),
+		listener:      listener,
+		listen:        listen,
+		pool:          pool.NewConnectionPool(&tlsConnectionFactory{config: config}),
+	}
+
+	transport.sendFunc = transport.send
+
+	return transport, publicAddress, nil
+}
+
+// newTLSConfig creates mutual TLS config with self-signed x509 certificate made from node private key.
+// Peer is accepted only if its public key is listed in the node certificate or is provided by credentials peers.
+func newTLSConfig(credentials *Credentials) (*tls.Config, error) {
+	if credentials == nil || credentials.PrivateKey == nil || credentials.Certificate == nil {
+		return nil, errors.New("node credentials are required")
+	}
+
+	signer, ok := credentials.PrivateKey.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("private key does not implement crypto.Signer")
+	}
+
+	certificate, err := createX509Certificate(signer)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create x509 certificate")
+	}
+
+	trusted, err := trustedKeys(credentials.Certificate)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get trusted keys")
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		ClientAuth:   tls.RequireAnyClientCert,
+		MinVersion:   tls.VersionTLS12,
+		// certificates are self-signed, peer is verified by its public key in VerifyPeerCertificate
+		InsecureSkipVerify:    true, //nolint: gosec
+		VerifyPeerCertificate: newPeerVerifier(trusted, credentials.Peers),
+	}, nil
+}
+
+func createX509Certificate(signer crypto.Signer) (tls.Certificate, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return tls.Certificate{}, errors.Wrap(err, "failed to generate serial number")
+	}
+
+	now := time.Now()
+	template := x509.Certificate{
+		SerialNumber: serial,
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(10 * 365 * 24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, signer.Public(), signer)
+	if err != nil {
+		return tls.Certificate{}, errors.Wrap(err, "failed to sign certificate")
+	}
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: signer}, nil
+}
+
+func trustedKeys(certificate insolar.Certificate) (map[string]struct{}, error) {
+	keys := []crypto.PublicKey{certificate.GetPublicKey()}
+	for _, node := range certificate.GetDiscoveryNodes() {
+		keys = append(keys, node.GetPublicKey())
+	}
+
+	result := make(map[string]struct{}, len(keys))
+	for _, key := range keys {
+		der, err := x509.MarshalPKIXPublicKey(key)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to marshal public key")
+		}
+		result[string(der)] = struct{}{}
+	}
+	return result, nil
+}
+
+// newPeerVerifier checks peer key against keys of the node certificate first. Peer keys are looked up on every
+// handshake, because active and sync lists change every pulse.
+func newPeerVerifier(trusted map[string]struct{}, peers PeerKeys) func([][]byte, [][]*x509.Certificate) error {
+	return func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		if len(rawCerts) == 0 {
+			return errors.New("peer certificate is not provided")
+		}
+
+		certificate, err := x509.ParseCertificate(rawCerts[0])
+		if err != nil {
+			return errors.Wrap(err, "failed to parse peer certificate")
+		}
+		der, err := x509.MarshalPKIXPublicKey(certificate.PublicKey)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal peer public key")
+		}
+		if _, ok := trusted[string(der)]; ok {
+			return nil
+		}
+		if peers != nil && isPeerKey(peers.GetNodeKeys(), der) {
+			return nil
+		}
+		return errors.New("peer public key is not found in node certificate and active nodes")
+	}
+}
+
+func isPeerKey(keys []crypto.PublicKey, der []byte) bool {
+	for _, key := range keys {
+		peerDER, err := x509.MarshalPKIXPublicKey(key)
+		if err == nil && bytes.Equal(peerDER, der) {
+			return true
+		}
+	}
+	return false
+}
+
+// handshake runs TLS handshake for accepted TLS connections, other connections are passed as is.
+func handshake(conn net.Conn) error {
+	tlsConn, ok := conn.(*tls.Conn)
+	if !ok {
+		return nil
+	}
+	return tlsConn.Handshake()
+}
+
+type tlsConnectionFactory struct {
+	tcpConnectionFactory
+	config *tls.Config
+}
+
+func (f *tlsConnectionFactory) CreateConnection(ctx context.Context, address net.Addr) (net.Conn, error) {
+	conn, err := f.tcpConnectionFactory.CreateConnection(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConn := tls.Client(conn, f.config)
+	err = tlsConn.Handshake()
+	if err != nil {
+		inslogger.FromContext(ctx).Errorf("[ createConnection ] TLS handshake with %s failed: %s", address, err.Error())
+		utils.CloseVerbose(conn)
+		return nil, errors.Wrap(err, "[ createConnection ] Failed to handshake")
+	}
+
+	return tlsConn, nil
+}
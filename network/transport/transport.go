@@ -87,15 +87,26 @@ type Transport interface {
 
 	// Stopped returns signal channel to support graceful shutdown.
 	Stopped() <-chan bool
+
+	// SetProtocolVersion sets wire format for packets sent to address. Legacy format is used by default.
+	SetProtocolVersion(address string, version network.ProtocolVersion)
+
+	// RetainProtocolVersions forgets wire formats of hosts that are not listed in addresses.
+	RetainProtocolVersions(addresses []string)
 }
 
-// NewTransport creates new Transport with particular configuration
-func NewTransport(cfg configuration.Transport) (Transport, string, error) {
+// NewTransport creates new Transport with particular configuration.
+// Credentials are required for secure protocols only and may be nil otherwise.
+func NewTransport(cfg configuration.Transport, credentials *Credentials) (Transport, string, error) {
 	switch cfg.Protocol {
 	case "TCP":
 		return newTCPTransport(cfg.Address, cfg.FixedPublicAddress)
 	case "PURE_UDP":
 		return newUDPTransport(cfg.Address, cfg.FixedPublicAddress)
+	case "TLS":
+		return newTLSTransport(cfg.Address, cfg.FixedPublicAddress, credentials)
+	case "QUIC":
+		return newQuicTransport(cfg.Address, cfg.FixedPublicAddress, credentials)
 	default:
 		return nil, "", errors.New("invalid transport configuration")
 	}
@@ -52,24 +52,34 @@ package transport
 
 import (
 	"context"
+	"crypto"
 	"crypto/rand"
 	"encoding/gob"
 	"testing"
+	"time"
 
 	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/network"
 	"github.com/insolar/insolar/network/hostnetwork/host"
 	"github.com/insolar/insolar/network/hostnetwork/packet"
 	"github.com/insolar/insolar/network/hostnetwork/packet/types"
 	"github.com/insolar/insolar/network/hostnetwork/resolver"
+	"github.com/insolar/insolar/platformpolicy"
+	"github.com/insolar/insolar/testutils"
+	testnetwork "github.com/insolar/insolar/testutils/network"
 
+	"github.com/gojuno/minimock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
 type node struct {
-	config    configuration.Transport
-	transport Transport
-	host      *host.Host
+	config      configuration.Transport
+	credentials *Credentials
+	transport   Transport
+	host        *host.Host
 }
 
 type transportSuite struct {
@@ -91,7 +101,7 @@ func setupNode(t *transportSuite, n *node) {
 	n.host, err = host.NewHost(n.config.Address)
 	t.Assert().NoError(err)
 
-	n.transport, _, err = NewTransport(n.config)
+	n.transport, _, err = NewTransport(n.config, n.credentials)
 	t.Require().NoError(err)
 	t.Require().NotNil(n.transport)
 	t.Require().Implements((*Transport)(nil), n.transport)
@@ -99,8 +109,18 @@ func setupNode(t *transportSuite, n *node) {
 
 func (t *transportSuite) SetupTest() {
 	gob.Register(&packet.RequestTest{})
+	keyProcessor := platformpolicy.NewKeyProcessor()
+	key1, err := keyProcessor.GeneratePrivateKey()
+	t.Require().NoError(err)
+	key2, err := keyProcessor.GeneratePrivateKey()
+	t.Require().NoError(err)
+	t.node1.credentials = newTestCredentials(t.T(), key1, key2)
+	t.node2.credentials = newTestCredentials(t.T(), key2, key1)
 	setupNode(t, &t.node1)
 	setupNode(t, &t.node2)
+
+	// node1 sends packets in current wire format, node2 keeps legacy one
+	t.node1.transport.SetProtocolVersion(t.node2.host.Address.String(), network.ProtocolCurrent)
 }
 
 func (t *transportSuite) BeforeTest(suiteName, testName string) {
@@ -151,6 +171,24 @@ func (t *transportSuite) TestPingPong() {
 	t.Assert().True(responseMsg.IsResponse)
 }
 
+func (t *transportSuite) TestAdvertisedProtocolVersion() {
+	ctx := context.Background()
+	versions := t.node2.transport.(interface {
+		getProtocolVersion(address string) network.ProtocolVersion
+	})
+	address := t.node1.host.Address.String()
+	t.Require().Equal(network.ProtocolGob, versions.getProtocolVersion(address))
+
+	p := packet.NewBuilder(t.node1.host).Type(types.Ping).Receiver(t.node2.host).Build()
+	_, err := t.node1.transport.SendRequest(ctx, p)
+	t.Require().NoError(err)
+	<-t.node2.transport.Packets()
+	t.Require().Equal(network.ProtocolCurrent, versions.getProtocolVersion(address))
+
+	t.node2.transport.RetainProtocolVersions([]string{t.node2.host.Address.String()})
+	t.Require().Equal(network.ProtocolGob, versions.getProtocolVersion(address))
+}
+
 func (t *transportSuite) TestSendBigPacket() {
 	if testing.Short() {
 		t.T().Skip("Skipping TestSendBigPacket in short mode")
@@ -198,14 +236,153 @@ func TestTCPTransport(t *testing.T) {
 }
 
 func TestQuicTransport(t *testing.T) {
-	t.Skip("QUIC internals racing atm. Skip until we want to use it in production")
-
 	cfg1 := configuration.Transport{Protocol: "QUIC", Address: "127.0.0.1:17018"}
 	cfg2 := configuration.Transport{Protocol: "QUIC", Address: "127.0.0.1:17019"}
 
 	suite.Run(t, NewSuite(cfg1, cfg2))
 }
 
+func TestTLSTransport(t *testing.T) {
+	cfg1 := configuration.Transport{Protocol: "TLS", Address: "127.0.0.1:17020"}
+	cfg2 := configuration.Transport{Protocol: "TLS", Address: "127.0.0.1:17021"}
+
+	suite.Run(t, NewSuite(cfg1, cfg2))
+}
+
+func TestTLSTransport_RejectsUnknownPeer(t *testing.T) {
+	testRejectsUnknownPeer(t, "TLS", "127.0.0.1:17022", "127.0.0.1:17023")
+}
+
+func TestQuicTransport_RejectsUnknownPeer(t *testing.T) {
+	testRejectsUnknownPeer(t, "QUIC", "127.0.0.1:17024", "127.0.0.1:17025")
+}
+
+func testRejectsUnknownPeer(t *testing.T, protocol, trustedAddress, unknownAddress string) {
+	ctx := context.Background()
+	keyProcessor := platformpolicy.NewKeyProcessor()
+	trustedKey, err := keyProcessor.GeneratePrivateKey()
+	require.NoError(t, err)
+	unknownKey, err := keyProcessor.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	trusted := node{
+		config:      configuration.Transport{Protocol: protocol, Address: trustedAddress},
+		credentials: newTestCredentials(t, trustedKey),
+	}
+	unknown := node{
+		config:      configuration.Transport{Protocol: protocol, Address: unknownAddress},
+		credentials: newTestCredentials(t, unknownKey, trustedKey),
+	}
+	for _, n := range []*node{&trusted, &unknown} {
+		n.host, err = host.NewHost(n.config.Address)
+		require.NoError(t, err)
+		n.transport, _, err = NewTransport(n.config, n.credentials)
+		require.NoError(t, err)
+		require.NoError(t, n.transport.Start(ctx))
+	}
+	defer func() {
+		for _, n := range []*node{&trusted, &unknown} {
+			go n.transport.Stop()
+			<-n.transport.Stopped()
+			n.transport.Close()
+		}
+	}()
+
+	// trusted node does not accept server certificate of unknown node
+	p := packet.NewBuilder(trusted.host).Type(types.Ping).Receiver(unknown.host).Build()
+	_, err = trusted.transport.SendRequest(ctx, p)
+	assert.Error(t, err)
+
+	// unknown node is able to send, but trusted node drops connection during handshake
+	p = packet.NewBuilder(unknown.host).Type(types.Ping).Receiver(trusted.host).Build()
+	_, _ = unknown.transport.SendRequest(ctx, p)
+	select {
+	case <-trusted.transport.Packets():
+		t.Fatal("packet from unknown peer must not be received")
+	case <-time.After(time.Second):
+	}
+}
+
+func TestTLSTransport_AcceptsActiveNodes(t *testing.T) {
+	testAcceptsActiveNodes(t, "TLS", "127.0.0.1:17026", "127.0.0.1:17027")
+}
+
+// testAcceptsActiveNodes connects two nodes which keys are not listed in certificates, they trust each other because
+// both nodes are in the active list.
+func testAcceptsActiveNodes(t *testing.T, protocol string, address1, address2 string) {
+	ctx := context.Background()
+	keyProcessor := platformpolicy.NewKeyProcessor()
+	discoveryKey, err := keyProcessor.GeneratePrivateKey()
+	require.NoError(t, err)
+	key1, err := keyProcessor.GeneratePrivateKey()
+	require.NoError(t, err)
+	key2, err := keyProcessor.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	keeper := testnetwork.NewNodeKeeperMock(t)
+	keeper.GetNodeKeysMock.Return([]crypto.PublicKey{
+		keyProcessor.ExtractPublicKey(discoveryKey),
+		keyProcessor.ExtractPublicKey(key1),
+		keyProcessor.ExtractPublicKey(key2),
+	})
+
+	node1 := node{
+		config:      configuration.Transport{Protocol: protocol, Address: address1},
+		credentials: newTestCredentials(t, key1, discoveryKey),
+	}
+	node2 := node{
+		config:      configuration.Transport{Protocol: protocol, Address: address2},
+		credentials: newTestCredentials(t, key2, discoveryKey),
+	}
+	for _, n := range []*node{&node1, &node2} {
+		n.credentials.Peers = keeper
+		n.host, err = host.NewHost(n.config.Address)
+		require.NoError(t, err)
+		n.transport, _, err = NewTransport(n.config, n.credentials)
+		require.NoError(t, err)
+		require.NoError(t, n.transport.Start(ctx))
+	}
+	defer func() {
+		for _, n := range []*node{&node1, &node2} {
+			go n.transport.Stop()
+			<-n.transport.Stopped()
+			n.transport.Close()
+		}
+	}()
+
+	p := packet.NewBuilder(node1.host).Type(types.Ping).Receiver(node2.host).Build()
+	_, err = node1.transport.SendRequest(ctx, p)
+	require.NoError(t, err)
+	select {
+	case received := <-node2.transport.Packets():
+		assert.Equal(t, node1.host.Address.String(), received.Sender.Address.String())
+	case <-time.After(time.Second):
+		t.Fatal("packet from active node is not received")
+	}
+}
+
+func TestNewTransport_TLSWithoutCredentials(t *testing.T) {
+	cfg := configuration.Transport{Protocol: "TLS", Address: "127.0.0.1:0"}
+	_, _, err := NewTransport(cfg, nil)
+	assert.Error(t, err)
+}
+
+func newTestCredentials(t minimock.Tester, key crypto.PrivateKey, trusted ...crypto.PrivateKey) *Credentials {
+	keyProcessor := platformpolicy.NewKeyProcessor()
+	nodes := make([]insolar.DiscoveryNode, 0, len(trusted))
+	for _, k := range trusted {
+		discoveryNode := testutils.NewDiscoveryNodeMock(t)
+		discoveryNode.GetPublicKeyMock.Return(keyProcessor.ExtractPublicKey(k))
+		nodes = append(nodes, discoveryNode)
+	}
+
+	cert := testutils.NewCertificateMock(t)
+	cert.GetPublicKeyMock.Return(keyProcessor.ExtractPublicKey(key))
+	cert.GetDiscoveryNodesMock.Return(nodes)
+
+	return &Credentials{PrivateKey: key, Certificate: cert}
+}
+
 func Test_createResolver(t *testing.T) {
 	a := assert.New(t)
 
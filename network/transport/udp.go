@@ -64,6 +64,7 @@ import (
 	"github.com/insolar/insolar/consensus/packets"
 	"github.com/insolar/insolar/instrumentation/inslogger"
 	"github.com/insolar/insolar/log"
+	"github.com/insolar/insolar/network"
 	"github.com/insolar/insolar/network/hostnetwork/packet"
 	"github.com/insolar/insolar/network/utils"
 )
@@ -78,7 +79,7 @@ type udpTransport struct {
 
 type udpSerializer struct{}
 
-func (b *udpSerializer) SerializePacket(q *packet.Packet) ([]byte, error) {
+func (b *udpSerializer) SerializePacket(q *packet.Packet, _ network.ProtocolVersion) ([]byte, error) {
 	data, ok := q.Data.(packets.ConsensusPacket)
 	if !ok {
 		return nil, errors.New("could not convert packet to ConsensusPacket type")
@@ -40,6 +40,7 @@ import (
 	"github.com/insolar/insolar/network/nodenetwork"
 	"github.com/insolar/insolar/network/servicenetwork"
 	"github.com/insolar/insolar/network/state"
+	"github.com/insolar/insolar/network/telemetry"
 	"github.com/insolar/insolar/networkcoordinator"
 	"github.com/insolar/insolar/platformpolicy"
 	"github.com/insolar/insolar/pulsar"
@@ -161,9 +162,12 @@ func initComponents(
 	networkCoordinator, err := networkcoordinator.New()
 	checkError(ctx, err, "failed to start NetworkCoordinator")
 
-	_, err = manager.NewVersionManager(cfg.VersionManager)
+	versionManager, err := manager.InitVersionManager(cfg.VersionManager)
 	checkError(ctx, err, "failed to load VersionManager: ")
 
+	consensusTelemetry, err := telemetry.NewRecorder(cfg.Service)
+	checkError(ctx, err, "failed to start consensus telemetry Recorder")
+
 	// move to logic runner ??
 	err = logicRunner.OnPulse(ctx, *pulsar.NewPulse(cfg.Pulsar.NumberDelta, 0, &entropygenerator.StandardEntropyGenerator{}))
 	checkError(ctx, err, "failed init pulse for LogicRunner")
@@ -177,6 +181,8 @@ func initComponents(
 		certManager,
 		nodeNetwork,
 		nw,
+		versionManager,
+		consensusTelemetry,
 	)
 
 	components := ledger.GetLedgerComponents(cfg.Ledger, certManager.GetCertificate())
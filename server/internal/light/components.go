@@ -38,6 +38,7 @@ import (
 	"github.com/insolar/insolar/network/nodenetwork"
 	"github.com/insolar/insolar/network/servicenetwork"
 	"github.com/insolar/insolar/network/state"
+	"github.com/insolar/insolar/network/telemetry"
 	"github.com/insolar/insolar/networkcoordinator"
 	"github.com/insolar/insolar/platformpolicy"
 	"github.com/insolar/insolar/version/manager"
@@ -147,9 +148,12 @@ func initComponents(
 	networkCoordinator, err := networkcoordinator.New()
 	checkError(ctx, err, "failed to start NetworkCoordinator")
 
-	_, err = manager.NewVersionManager(cfg.VersionManager)
+	versionManager, err := manager.InitVersionManager(cfg.VersionManager)
 	checkError(ctx, err, "failed to load VersionManager: ")
 
+	consensusTelemetry, err := telemetry.NewRecorder(cfg.Service)
+	checkError(ctx, err, "failed to start consensus telemetry Recorder")
+
 	cm.Register(
 		terminationHandler,
 		platformCryptographyScheme,
@@ -159,6 +163,8 @@ func initComponents(
 		certManager,
 		nodeNetwork,
 		nw,
+		versionManager,
+		consensusTelemetry,
 	)
 
 	components := ledger.GetLedgerComponents(cfg.Ledger, certManager.GetCertificate())
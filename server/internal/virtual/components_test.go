@@ -18,6 +18,9 @@ package virtual
 
 import (
 	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/insolar/insolar/configuration"
@@ -26,7 +29,13 @@ import (
 
 func TestInitComponents(t *testing.T) {
 	ctx := context.Background()
+	tmpdir, err := ioutil.TempDir("", "virtual-test-")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpdir)
+
 	cfg := configuration.NewConfiguration()
+	cfg.Ledger.Storage.DataDirectory = filepath.Join(tmpdir, "data")
+	cfg.Ledger.Storage.DataDirectoryNewDB = filepath.Join(tmpdir, "new-data")
 	cfg.KeysPath = "testdata/bootstrap_keys.json"
 	cfg.CertificatePath = "testdata/certificate.json"
 
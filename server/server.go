@@ -18,6 +18,7 @@ package server
 
 import (
 	"github.com/insolar/insolar/server/internal/genesis"
+	"github.com/insolar/insolar/server/internal/heavy"
 	"github.com/insolar/insolar/server/internal/light"
 	"github.com/insolar/insolar/server/internal/virtual"
 )
@@ -26,6 +27,10 @@ type Server interface {
 	Serve()
 }
 
+func NewHeavyServer(cfgPath string, trace bool) Server {
+	return heavy.New(cfgPath, trace)
+}
+
 func NewLightServer(cfgPath string, trace bool) Server {
 	return light.New(cfgPath, trace)
 }
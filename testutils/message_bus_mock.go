@@ -21,6 +21,16 @@ import (
 type MessageBusMock struct {
 	t minimock.Tester
 
+	AddInboundInterceptorFunc       func(p insolar.InboundInterceptor)
+	AddInboundInterceptorCounter    uint64
+	AddInboundInterceptorPreCounter uint64
+	AddInboundInterceptorMock       mMessageBusMockAddInboundInterceptor
+
+	AddOutboundInterceptorFunc       func(p insolar.OutboundInterceptor)
+	AddOutboundInterceptorCounter    uint64
+	AddOutboundInterceptorPreCounter uint64
+	AddOutboundInterceptorMock       mMessageBusMockAddOutboundInterceptor
+
 	MustRegisterFunc       func(p insolar.MessageType, p1 insolar.MessageHandler)
 	MustRegisterCounter    uint64
 	MustRegisterPreCounter uint64
@@ -60,6 +70,8 @@ func NewMessageBusMock(t minimock.Tester) *MessageBusMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.AddInboundInterceptorMock = mMessageBusMockAddInboundInterceptor{mock: m}
+	m.AddOutboundInterceptorMock = mMessageBusMockAddOutboundInterceptor{mock: m}
 	m.MustRegisterMock = mMessageBusMockMustRegister{mock: m}
 	m.NewPlayerMock = mMessageBusMockNewPlayer{mock: m}
 	m.NewRecorderMock = mMessageBusMockNewRecorder{mock: m}
@@ -70,6 +82,252 @@ func NewMessageBusMock(t minimock.Tester) *MessageBusMock {
 	return m
 }
 
+type mMessageBusMockAddInboundInterceptor struct {
+	mock              *MessageBusMock
+	mainExpectation   *MessageBusMockAddInboundInterceptorExpectation
+	expectationSeries []*MessageBusMockAddInboundInterceptorExpectation
+}
+
+type MessageBusMockAddInboundInterceptorExpectation struct {
+	input *MessageBusMockAddInboundInterceptorInput
+}
+
+type MessageBusMockAddInboundInterceptorInput struct {
+	p insolar.InboundInterceptor
+}
+
+//Expect specifies that invocation of MessageBus.AddInboundInterceptor is expected from 1 to Infinity times
+func (m *mMessageBusMockAddInboundInterceptor) Expect(p insolar.InboundInterceptor) *mMessageBusMockAddInboundInterceptor {
+	m.mock.AddInboundInterceptorFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &MessageBusMockAddInboundInterceptorExpectation{}
+	}
+	m.mainExpectation.input = &MessageBusMockAddInboundInterceptorInput{p}
+	return m
+}
+
+//Return specifies results of invocation of MessageBus.AddInboundInterceptor
+func (m *mMessageBusMockAddInboundInterceptor) Return() *MessageBusMock {
+	m.mock.AddInboundInterceptorFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &MessageBusMockAddInboundInterceptorExpectation{}
+	}
+
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of MessageBus.AddInboundInterceptor is expected once
+func (m *mMessageBusMockAddInboundInterceptor) ExpectOnce(p insolar.InboundInterceptor) *MessageBusMockAddInboundInterceptorExpectation {
+	m.mock.AddInboundInterceptorFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &MessageBusMockAddInboundInterceptorExpectation{}
+	expectation.input = &MessageBusMockAddInboundInterceptorInput{p}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+//Set uses given function f as a mock of MessageBus.AddInboundInterceptor method
+func (m *mMessageBusMockAddInboundInterceptor) Set(f func(p insolar.InboundInterceptor)) *MessageBusMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.AddInboundInterceptorFunc = f
+	return m.mock
+}
+
+//AddInboundInterceptor implements github.com/insolar/insolar/insolar.MessageBus interface
+func (m *MessageBusMock) AddInboundInterceptor(p insolar.InboundInterceptor) {
+	counter := atomic.AddUint64(&m.AddInboundInterceptorPreCounter, 1)
+	defer atomic.AddUint64(&m.AddInboundInterceptorCounter, 1)
+
+	if len(m.AddInboundInterceptorMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.AddInboundInterceptorMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to MessageBusMock.AddInboundInterceptor. %v", p)
+			return
+		}
+
+		input := m.AddInboundInterceptorMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, MessageBusMockAddInboundInterceptorInput{p}, "MessageBus.AddInboundInterceptor got unexpected parameters")
+
+		return
+	}
+
+	if m.AddInboundInterceptorMock.mainExpectation != nil {
+
+		input := m.AddInboundInterceptorMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, MessageBusMockAddInboundInterceptorInput{p}, "MessageBus.AddInboundInterceptor got unexpected parameters")
+		}
+
+		return
+	}
+
+	if m.AddInboundInterceptorFunc == nil {
+		m.t.Fatalf("Unexpected call to MessageBusMock.AddInboundInterceptor. %v", p)
+		return
+	}
+
+	m.AddInboundInterceptorFunc(p)
+}
+
+//AddInboundInterceptorMinimockCounter returns a count of MessageBusMock.AddInboundInterceptorFunc invocations
+func (m *MessageBusMock) AddInboundInterceptorMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.AddInboundInterceptorCounter)
+}
+
+//AddInboundInterceptorMinimockPreCounter returns the value of MessageBusMock.AddInboundInterceptor invocations
+func (m *MessageBusMock) AddInboundInterceptorMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.AddInboundInterceptorPreCounter)
+}
+
+//AddInboundInterceptorFinished returns true if mock invocations count is ok
+func (m *MessageBusMock) AddInboundInterceptorFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.AddInboundInterceptorMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.AddInboundInterceptorCounter) == uint64(len(m.AddInboundInterceptorMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.AddInboundInterceptorMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.AddInboundInterceptorCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.AddInboundInterceptorFunc != nil {
+		return atomic.LoadUint64(&m.AddInboundInterceptorCounter) > 0
+	}
+
+	return true
+}
+
+type mMessageBusMockAddOutboundInterceptor struct {
+	mock              *MessageBusMock
+	mainExpectation   *MessageBusMockAddOutboundInterceptorExpectation
+	expectationSeries []*MessageBusMockAddOutboundInterceptorExpectation
+}
+
+type MessageBusMockAddOutboundInterceptorExpectation struct {
+	input *MessageBusMockAddOutboundInterceptorInput
+}
+
+type MessageBusMockAddOutboundInterceptorInput struct {
+	p insolar.OutboundInterceptor
+}
+
+//Expect specifies that invocation of MessageBus.AddOutboundInterceptor is expected from 1 to Infinity times
+func (m *mMessageBusMockAddOutboundInterceptor) Expect(p insolar.OutboundInterceptor) *mMessageBusMockAddOutboundInterceptor {
+	m.mock.AddOutboundInterceptorFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &MessageBusMockAddOutboundInterceptorExpectation{}
+	}
+	m.mainExpectation.input = &MessageBusMockAddOutboundInterceptorInput{p}
+	return m
+}
+
+//Return specifies results of invocation of MessageBus.AddOutboundInterceptor
+func (m *mMessageBusMockAddOutboundInterceptor) Return() *MessageBusMock {
+	m.mock.AddOutboundInterceptorFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &MessageBusMockAddOutboundInterceptorExpectation{}
+	}
+
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of MessageBus.AddOutboundInterceptor is expected once
+func (m *mMessageBusMockAddOutboundInterceptor) ExpectOnce(p insolar.OutboundInterceptor) *MessageBusMockAddOutboundInterceptorExpectation {
+	m.mock.AddOutboundInterceptorFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &MessageBusMockAddOutboundInterceptorExpectation{}
+	expectation.input = &MessageBusMockAddOutboundInterceptorInput{p}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+//Set uses given function f as a mock of MessageBus.AddOutboundInterceptor method
+func (m *mMessageBusMockAddOutboundInterceptor) Set(f func(p insolar.OutboundInterceptor)) *MessageBusMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.AddOutboundInterceptorFunc = f
+	return m.mock
+}
+
+//AddOutboundInterceptor implements github.com/insolar/insolar/insolar.MessageBus interface
+func (m *MessageBusMock) AddOutboundInterceptor(p insolar.OutboundInterceptor) {
+	counter := atomic.AddUint64(&m.AddOutboundInterceptorPreCounter, 1)
+	defer atomic.AddUint64(&m.AddOutboundInterceptorCounter, 1)
+
+	if len(m.AddOutboundInterceptorMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.AddOutboundInterceptorMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to MessageBusMock.AddOutboundInterceptor. %v", p)
+			return
+		}
+
+		input := m.AddOutboundInterceptorMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, MessageBusMockAddOutboundInterceptorInput{p}, "MessageBus.AddOutboundInterceptor got unexpected parameters")
+
+		return
+	}
+
+	if m.AddOutboundInterceptorMock.mainExpectation != nil {
+
+		input := m.AddOutboundInterceptorMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, MessageBusMockAddOutboundInterceptorInput{p}, "MessageBus.AddOutboundInterceptor got unexpected parameters")
+		}
+
+		return
+	}
+
+	if m.AddOutboundInterceptorFunc == nil {
+		m.t.Fatalf("Unexpected call to MessageBusMock.AddOutboundInterceptor. %v", p)
+		return
+	}
+
+	m.AddOutboundInterceptorFunc(p)
+}
+
+//AddOutboundInterceptorMinimockCounter returns a count of MessageBusMock.AddOutboundInterceptorFunc invocations
+func (m *MessageBusMock) AddOutboundInterceptorMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.AddOutboundInterceptorCounter)
+}
+
+//AddOutboundInterceptorMinimockPreCounter returns the value of MessageBusMock.AddOutboundInterceptor invocations
+func (m *MessageBusMock) AddOutboundInterceptorMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.AddOutboundInterceptorPreCounter)
+}
+
+//AddOutboundInterceptorFinished returns true if mock invocations count is ok
+func (m *MessageBusMock) AddOutboundInterceptorFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.AddOutboundInterceptorMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.AddOutboundInterceptorCounter) == uint64(len(m.AddOutboundInterceptorMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.AddOutboundInterceptorMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.AddOutboundInterceptorCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.AddOutboundInterceptorFunc != nil {
+		return atomic.LoadUint64(&m.AddOutboundInterceptorCounter) > 0
+	}
+
+	return true
+}
+
 type mMessageBusMockMustRegister struct {
 	mock              *MessageBusMock
 	mainExpectation   *MessageBusMockMustRegisterExpectation
@@ -948,6 +1206,14 @@ func (m *MessageBusMock) SendFinished() bool {
 //Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
 func (m *MessageBusMock) ValidateCallCounters() {
 
+	if !m.AddInboundInterceptorFinished() {
+		m.t.Fatal("Expected call to MessageBusMock.AddInboundInterceptor")
+	}
+
+	if !m.AddOutboundInterceptorFinished() {
+		m.t.Fatal("Expected call to MessageBusMock.AddOutboundInterceptor")
+	}
+
 	if !m.MustRegisterFinished() {
 		m.t.Fatal("Expected call to MessageBusMock.MustRegister")
 	}
@@ -989,6 +1255,14 @@ func (m *MessageBusMock) Finish() {
 //MinimockFinish checks that all mocked methods of the interface have been called at least once
 func (m *MessageBusMock) MinimockFinish() {
 
+	if !m.AddInboundInterceptorFinished() {
+		m.t.Fatal("Expected call to MessageBusMock.AddInboundInterceptor")
+	}
+
+	if !m.AddOutboundInterceptorFinished() {
+		m.t.Fatal("Expected call to MessageBusMock.AddOutboundInterceptor")
+	}
+
 	if !m.MustRegisterFinished() {
 		m.t.Fatal("Expected call to MessageBusMock.MustRegister")
 	}
@@ -1027,6 +1301,8 @@ func (m *MessageBusMock) MinimockWait(timeout time.Duration) {
 	timeoutCh := time.After(timeout)
 	for {
 		ok := true
+		ok = ok && m.AddInboundInterceptorFinished()
+		ok = ok && m.AddOutboundInterceptorFinished()
 		ok = ok && m.MustRegisterFinished()
 		ok = ok && m.NewPlayerFinished()
 		ok = ok && m.NewRecorderFinished()
@@ -1041,6 +1317,14 @@ func (m *MessageBusMock) MinimockWait(timeout time.Duration) {
 		select {
 		case <-timeoutCh:
 
+			if !m.AddInboundInterceptorFinished() {
+				m.t.Error("Expected call to MessageBusMock.AddInboundInterceptor")
+			}
+
+			if !m.AddOutboundInterceptorFinished() {
+				m.t.Error("Expected call to MessageBusMock.AddOutboundInterceptor")
+			}
+
 			if !m.MustRegisterFinished() {
 				m.t.Error("Expected call to MessageBusMock.MustRegister")
 			}
@@ -1077,6 +1361,14 @@ func (m *MessageBusMock) MinimockWait(timeout time.Duration) {
 //it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
 func (m *MessageBusMock) AllMocksCalled() bool {
 
+	if !m.AddInboundInterceptorFinished() {
+		return false
+	}
+
+	if !m.AddOutboundInterceptorFinished() {
+		return false
+	}
+
 	if !m.MustRegisterFinished() {
 		return false
 	}
@@ -0,0 +1,578 @@
+package network
+
+/*
+DO NOT EDIT!
+This code was generated automatically using github.com/gojuno/minimock v1.9
+The original interface "FeatureActivator" can be found in github.com/insolar/insolar/network
+*/
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/gojuno/minimock"
+	insolar "github.com/insolar/insolar/insolar"
+
+	testify_assert "github.com/stretchr/testify/assert"
+)
+
+//FeatureActivatorMock implements github.com/insolar/insolar/network.FeatureActivator
+type FeatureActivatorMock struct {
+	t minimock.Tester
+
+	ActivatedFeaturesFunc       func() (r map[string]insolar.PulseNumber)
+	ActivatedFeaturesCounter    uint64
+	ActivatedFeaturesPreCounter uint64
+	ActivatedFeaturesMock       mFeatureActivatorMockActivatedFeatures
+
+	ProcessCapabilitiesFunc       func(p insolar.PulseNumber, p1 []insolar.Reference, p2 map[string][]insolar.Reference, p3 map[string]map[insolar.Reference]insolar.PulseNumber) (r map[string]insolar.PulseNumber)
+	ProcessCapabilitiesCounter    uint64
+	ProcessCapabilitiesPreCounter uint64
+	ProcessCapabilitiesMock       mFeatureActivatorMockProcessCapabilities
+
+	SupportedFeaturesFunc       func() (r []string)
+	SupportedFeaturesCounter    uint64
+	SupportedFeaturesPreCounter uint64
+	SupportedFeaturesMock       mFeatureActivatorMockSupportedFeatures
+}
+
+//NewFeatureActivatorMock returns a mock for github.com/insolar/insolar/network.FeatureActivator
+func NewFeatureActivatorMock(t minimock.Tester) *FeatureActivatorMock {
+	m := &FeatureActivatorMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ActivatedFeaturesMock = mFeatureActivatorMockActivatedFeatures{mock: m}
+	m.ProcessCapabilitiesMock = mFeatureActivatorMockProcessCapabilities{mock: m}
+	m.SupportedFeaturesMock = mFeatureActivatorMockSupportedFeatures{mock: m}
+
+	return m
+}
+
+type mFeatureActivatorMockActivatedFeatures struct {
+	mock              *FeatureActivatorMock
+	mainExpectation   *FeatureActivatorMockActivatedFeaturesExpectation
+	expectationSeries []*FeatureActivatorMockActivatedFeaturesExpectation
+}
+
+type FeatureActivatorMockActivatedFeaturesExpectation struct {
+	result *FeatureActivatorMockActivatedFeaturesResult
+}
+
+type FeatureActivatorMockActivatedFeaturesResult struct {
+	r map[string]insolar.PulseNumber
+}
+
+//Expect specifies that invocation of FeatureActivator.ActivatedFeatures is expected from 1 to Infinity times
+func (m *mFeatureActivatorMockActivatedFeatures) Expect() *mFeatureActivatorMockActivatedFeatures {
+	m.mock.ActivatedFeaturesFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &FeatureActivatorMockActivatedFeaturesExpectation{}
+	}
+
+	return m
+}
+
+//Return specifies results of invocation of FeatureActivator.ActivatedFeatures
+func (m *mFeatureActivatorMockActivatedFeatures) Return(r map[string]insolar.PulseNumber) *FeatureActivatorMock {
+	m.mock.ActivatedFeaturesFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &FeatureActivatorMockActivatedFeaturesExpectation{}
+	}
+	m.mainExpectation.result = &FeatureActivatorMockActivatedFeaturesResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of FeatureActivator.ActivatedFeatures is expected once
+func (m *mFeatureActivatorMockActivatedFeatures) ExpectOnce() *FeatureActivatorMockActivatedFeaturesExpectation {
+	m.mock.ActivatedFeaturesFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &FeatureActivatorMockActivatedFeaturesExpectation{}
+
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *FeatureActivatorMockActivatedFeaturesExpectation) Return(r map[string]insolar.PulseNumber) {
+	e.result = &FeatureActivatorMockActivatedFeaturesResult{r}
+}
+
+//Set uses given function f as a mock of FeatureActivator.ActivatedFeatures method
+func (m *mFeatureActivatorMockActivatedFeatures) Set(f func() (r map[string]insolar.PulseNumber)) *FeatureActivatorMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.ActivatedFeaturesFunc = f
+	return m.mock
+}
+
+//ActivatedFeatures implements github.com/insolar/insolar/network.FeatureActivator interface
+func (m *FeatureActivatorMock) ActivatedFeatures() (r map[string]insolar.PulseNumber) {
+	counter := atomic.AddUint64(&m.ActivatedFeaturesPreCounter, 1)
+	defer atomic.AddUint64(&m.ActivatedFeaturesCounter, 1)
+
+	if len(m.ActivatedFeaturesMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.ActivatedFeaturesMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to FeatureActivatorMock.ActivatedFeatures.")
+			return
+		}
+
+		result := m.ActivatedFeaturesMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the FeatureActivatorMock.ActivatedFeatures")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.ActivatedFeaturesMock.mainExpectation != nil {
+
+		result := m.ActivatedFeaturesMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the FeatureActivatorMock.ActivatedFeatures")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.ActivatedFeaturesFunc == nil {
+		m.t.Fatalf("Unexpected call to FeatureActivatorMock.ActivatedFeatures.")
+		return
+	}
+
+	return m.ActivatedFeaturesFunc()
+}
+
+//ActivatedFeaturesMinimockCounter returns a count of FeatureActivatorMock.ActivatedFeaturesFunc invocations
+func (m *FeatureActivatorMock) ActivatedFeaturesMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.ActivatedFeaturesCounter)
+}
+
+//ActivatedFeaturesMinimockPreCounter returns the value of FeatureActivatorMock.ActivatedFeatures invocations
+func (m *FeatureActivatorMock) ActivatedFeaturesMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.ActivatedFeaturesPreCounter)
+}
+
+//ActivatedFeaturesFinished returns true if mock invocations count is ok
+func (m *FeatureActivatorMock) ActivatedFeaturesFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.ActivatedFeaturesMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.ActivatedFeaturesCounter) == uint64(len(m.ActivatedFeaturesMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.ActivatedFeaturesMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.ActivatedFeaturesCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.ActivatedFeaturesFunc != nil {
+		return atomic.LoadUint64(&m.ActivatedFeaturesCounter) > 0
+	}
+
+	return true
+}
+
+type mFeatureActivatorMockProcessCapabilities struct {
+	mock              *FeatureActivatorMock
+	mainExpectation   *FeatureActivatorMockProcessCapabilitiesExpectation
+	expectationSeries []*FeatureActivatorMockProcessCapabilitiesExpectation
+}
+
+type FeatureActivatorMockProcessCapabilitiesExpectation struct {
+	input  *FeatureActivatorMockProcessCapabilitiesInput
+	result *FeatureActivatorMockProcessCapabilitiesResult
+}
+
+type FeatureActivatorMockProcessCapabilitiesInput struct {
+	p  insolar.PulseNumber
+	p1 []insolar.Reference
+	p2 map[string][]insolar.Reference
+	p3 map[string]map[insolar.Reference]insolar.PulseNumber
+}
+
+type FeatureActivatorMockProcessCapabilitiesResult struct {
+	r map[string]insolar.PulseNumber
+}
+
+//Expect specifies that invocation of FeatureActivator.ProcessCapabilities is expected from 1 to Infinity times
+func (m *mFeatureActivatorMockProcessCapabilities) Expect(p insolar.PulseNumber, p1 []insolar.Reference, p2 map[string][]insolar.Reference, p3 map[string]map[insolar.Reference]insolar.PulseNumber) *mFeatureActivatorMockProcessCapabilities {
+	m.mock.ProcessCapabilitiesFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &FeatureActivatorMockProcessCapabilitiesExpectation{}
+	}
+	m.mainExpectation.input = &FeatureActivatorMockProcessCapabilitiesInput{p, p1, p2, p3}
+	return m
+}
+
+//Return specifies results of invocation of FeatureActivator.ProcessCapabilities
+func (m *mFeatureActivatorMockProcessCapabilities) Return(r map[string]insolar.PulseNumber) *FeatureActivatorMock {
+	m.mock.ProcessCapabilitiesFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &FeatureActivatorMockProcessCapabilitiesExpectation{}
+	}
+	m.mainExpectation.result = &FeatureActivatorMockProcessCapabilitiesResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of FeatureActivator.ProcessCapabilities is expected once
+func (m *mFeatureActivatorMockProcessCapabilities) ExpectOnce(p insolar.PulseNumber, p1 []insolar.Reference, p2 map[string][]insolar.Reference, p3 map[string]map[insolar.Reference]insolar.PulseNumber) *FeatureActivatorMockProcessCapabilitiesExpectation {
+	m.mock.ProcessCapabilitiesFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &FeatureActivatorMockProcessCapabilitiesExpectation{}
+	expectation.input = &FeatureActivatorMockProcessCapabilitiesInput{p, p1, p2, p3}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *FeatureActivatorMockProcessCapabilitiesExpectation) Return(r map[string]insolar.PulseNumber) {
+	e.result = &FeatureActivatorMockProcessCapabilitiesResult{r}
+}
+
+//Set uses given function f as a mock of FeatureActivator.ProcessCapabilities method
+func (m *mFeatureActivatorMockProcessCapabilities) Set(f func(p insolar.PulseNumber, p1 []insolar.Reference, p2 map[string][]insolar.Reference, p3 map[string]map[insolar.Reference]insolar.PulseNumber) (r map[string]insolar.PulseNumber)) *FeatureActivatorMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.ProcessCapabilitiesFunc = f
+	return m.mock
+}
+
+//ProcessCapabilities implements github.com/insolar/insolar/network.FeatureActivator interface
+func (m *FeatureActivatorMock) ProcessCapabilities(p insolar.PulseNumber, p1 []insolar.Reference, p2 map[string][]insolar.Reference, p3 map[string]map[insolar.Reference]insolar.PulseNumber) (r map[string]insolar.PulseNumber) {
+	counter := atomic.AddUint64(&m.ProcessCapabilitiesPreCounter, 1)
+	defer atomic.AddUint64(&m.ProcessCapabilitiesCounter, 1)
+
+	if len(m.ProcessCapabilitiesMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.ProcessCapabilitiesMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to FeatureActivatorMock.ProcessCapabilities. %v %v %v %v", p, p1, p2, p3)
+			return
+		}
+
+		input := m.ProcessCapabilitiesMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, FeatureActivatorMockProcessCapabilitiesInput{p, p1, p2, p3}, "FeatureActivator.ProcessCapabilities got unexpected parameters")
+
+		result := m.ProcessCapabilitiesMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the FeatureActivatorMock.ProcessCapabilities")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.ProcessCapabilitiesMock.mainExpectation != nil {
+
+		input := m.ProcessCapabilitiesMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, FeatureActivatorMockProcessCapabilitiesInput{p, p1, p2, p3}, "FeatureActivator.ProcessCapabilities got unexpected parameters")
+		}
+
+		result := m.ProcessCapabilitiesMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the FeatureActivatorMock.ProcessCapabilities")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.ProcessCapabilitiesFunc == nil {
+		m.t.Fatalf("Unexpected call to FeatureActivatorMock.ProcessCapabilities. %v %v %v %v", p, p1, p2, p3)
+		return
+	}
+
+	return m.ProcessCapabilitiesFunc(p, p1, p2, p3)
+}
+
+//ProcessCapabilitiesMinimockCounter returns a count of FeatureActivatorMock.ProcessCapabilitiesFunc invocations
+func (m *FeatureActivatorMock) ProcessCapabilitiesMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.ProcessCapabilitiesCounter)
+}
+
+//ProcessCapabilitiesMinimockPreCounter returns the value of FeatureActivatorMock.ProcessCapabilities invocations
+func (m *FeatureActivatorMock) ProcessCapabilitiesMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.ProcessCapabilitiesPreCounter)
+}
+
+//ProcessCapabilitiesFinished returns true if mock invocations count is ok
+func (m *FeatureActivatorMock) ProcessCapabilitiesFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.ProcessCapabilitiesMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.ProcessCapabilitiesCounter) == uint64(len(m.ProcessCapabilitiesMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.ProcessCapabilitiesMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.ProcessCapabilitiesCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.ProcessCapabilitiesFunc != nil {
+		return atomic.LoadUint64(&m.ProcessCapabilitiesCounter) > 0
+	}
+
+	return true
+}
+
+type mFeatureActivatorMockSupportedFeatures struct {
+	mock              *FeatureActivatorMock
+	mainExpectation   *FeatureActivatorMockSupportedFeaturesExpectation
+	expectationSeries []*FeatureActivatorMockSupportedFeaturesExpectation
+}
+
+type FeatureActivatorMockSupportedFeaturesExpectation struct {
+	result *FeatureActivatorMockSupportedFeaturesResult
+}
+
+type FeatureActivatorMockSupportedFeaturesResult struct {
+	r []string
+}
+
+//Expect specifies that invocation of FeatureActivator.SupportedFeatures is expected from 1 to Infinity times
+func (m *mFeatureActivatorMockSupportedFeatures) Expect() *mFeatureActivatorMockSupportedFeatures {
+	m.mock.SupportedFeaturesFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &FeatureActivatorMockSupportedFeaturesExpectation{}
+	}
+
+	return m
+}
+
+//Return specifies results of invocation of FeatureActivator.SupportedFeatures
+func (m *mFeatureActivatorMockSupportedFeatures) Return(r []string) *FeatureActivatorMock {
+	m.mock.SupportedFeaturesFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &FeatureActivatorMockSupportedFeaturesExpectation{}
+	}
+	m.mainExpectation.result = &FeatureActivatorMockSupportedFeaturesResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of FeatureActivator.SupportedFeatures is expected once
+func (m *mFeatureActivatorMockSupportedFeatures) ExpectOnce() *FeatureActivatorMockSupportedFeaturesExpectation {
+	m.mock.SupportedFeaturesFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &FeatureActivatorMockSupportedFeaturesExpectation{}
+
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *FeatureActivatorMockSupportedFeaturesExpectation) Return(r []string) {
+	e.result = &FeatureActivatorMockSupportedFeaturesResult{r}
+}
+
+//Set uses given function f as a mock of FeatureActivator.SupportedFeatures method
+func (m *mFeatureActivatorMockSupportedFeatures) Set(f func() (r []string)) *FeatureActivatorMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.SupportedFeaturesFunc = f
+	return m.mock
+}
+
+//SupportedFeatures implements github.com/insolar/insolar/network.FeatureActivator interface
+func (m *FeatureActivatorMock) SupportedFeatures() (r []string) {
+	counter := atomic.AddUint64(&m.SupportedFeaturesPreCounter, 1)
+	defer atomic.AddUint64(&m.SupportedFeaturesCounter, 1)
+
+	if len(m.SupportedFeaturesMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.SupportedFeaturesMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to FeatureActivatorMock.SupportedFeatures.")
+			return
+		}
+
+		result := m.SupportedFeaturesMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the FeatureActivatorMock.SupportedFeatures")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.SupportedFeaturesMock.mainExpectation != nil {
+
+		result := m.SupportedFeaturesMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the FeatureActivatorMock.SupportedFeatures")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.SupportedFeaturesFunc == nil {
+		m.t.Fatalf("Unexpected call to FeatureActivatorMock.SupportedFeatures.")
+		return
+	}
+
+	return m.SupportedFeaturesFunc()
+}
+
+//SupportedFeaturesMinimockCounter returns a count of FeatureActivatorMock.SupportedFeaturesFunc invocations
+func (m *FeatureActivatorMock) SupportedFeaturesMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.SupportedFeaturesCounter)
+}
+
+//SupportedFeaturesMinimockPreCounter returns the value of FeatureActivatorMock.SupportedFeatures invocations
+func (m *FeatureActivatorMock) SupportedFeaturesMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.SupportedFeaturesPreCounter)
+}
+
+//SupportedFeaturesFinished returns true if mock invocations count is ok
+func (m *FeatureActivatorMock) SupportedFeaturesFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.SupportedFeaturesMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.SupportedFeaturesCounter) == uint64(len(m.SupportedFeaturesMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.SupportedFeaturesMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.SupportedFeaturesCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.SupportedFeaturesFunc != nil {
+		return atomic.LoadUint64(&m.SupportedFeaturesCounter) > 0
+	}
+
+	return true
+}
+
+//ValidateCallCounters checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *FeatureActivatorMock) ValidateCallCounters() {
+
+	if !m.ActivatedFeaturesFinished() {
+		m.t.Fatal("Expected call to FeatureActivatorMock.ActivatedFeatures")
+	}
+
+	if !m.ProcessCapabilitiesFinished() {
+		m.t.Fatal("Expected call to FeatureActivatorMock.ProcessCapabilities")
+	}
+
+	if !m.SupportedFeaturesFinished() {
+		m.t.Fatal("Expected call to FeatureActivatorMock.SupportedFeatures")
+	}
+
+}
+
+//CheckMocksCalled checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *FeatureActivatorMock) CheckMocksCalled() {
+	m.Finish()
+}
+
+//Finish checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish or use Finish method of minimock.Controller
+func (m *FeatureActivatorMock) Finish() {
+	m.MinimockFinish()
+}
+
+//MinimockFinish checks that all mocked methods of the interface have been called at least once
+func (m *FeatureActivatorMock) MinimockFinish() {
+
+	if !m.ActivatedFeaturesFinished() {
+		m.t.Fatal("Expected call to FeatureActivatorMock.ActivatedFeatures")
+	}
+
+	if !m.ProcessCapabilitiesFinished() {
+		m.t.Fatal("Expected call to FeatureActivatorMock.ProcessCapabilities")
+	}
+
+	if !m.SupportedFeaturesFinished() {
+		m.t.Fatal("Expected call to FeatureActivatorMock.SupportedFeatures")
+	}
+
+}
+
+//Wait waits for all mocked methods to be called at least once
+//Deprecated: please use MinimockWait or use Wait method of minimock.Controller
+func (m *FeatureActivatorMock) Wait(timeout time.Duration) {
+	m.MinimockWait(timeout)
+}
+
+//MinimockWait waits for all mocked methods to be called at least once
+//this method is called by minimock.Controller
+func (m *FeatureActivatorMock) MinimockWait(timeout time.Duration) {
+	timeoutCh := time.After(timeout)
+	for {
+		ok := true
+		ok = ok && m.ActivatedFeaturesFinished()
+		ok = ok && m.ProcessCapabilitiesFinished()
+		ok = ok && m.SupportedFeaturesFinished()
+
+		if ok {
+			return
+		}
+
+		select {
+		case <-timeoutCh:
+
+			if !m.ActivatedFeaturesFinished() {
+				m.t.Error("Expected call to FeatureActivatorMock.ActivatedFeatures")
+			}
+
+			if !m.ProcessCapabilitiesFinished() {
+				m.t.Error("Expected call to FeatureActivatorMock.ProcessCapabilities")
+			}
+
+			if !m.SupportedFeaturesFinished() {
+				m.t.Error("Expected call to FeatureActivatorMock.SupportedFeatures")
+			}
+
+			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+//AllMocksCalled returns true if all mocked methods were called before the execution of AllMocksCalled,
+//it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
+func (m *FeatureActivatorMock) AllMocksCalled() bool {
+
+	if !m.ActivatedFeaturesFinished() {
+		return false
+	}
+
+	if !m.ProcessCapabilitiesFinished() {
+		return false
+	}
+
+	if !m.SupportedFeaturesFinished() {
+		return false
+	}
+
+	return true
+}
@@ -7,6 +7,7 @@ The original interface "NodeKeeper" can be found in github.com/insolar/insolar/n
 */
 import (
 	context "context"
+	crypto "crypto"
 	"sync/atomic"
 	"time"
 
@@ -43,6 +44,11 @@ type NodeKeeperMock struct {
 	GetConsensusInfoPreCounter uint64
 	GetConsensusInfoMock       mNodeKeeperMockGetConsensusInfo
 
+	GetNodeKeysFunc       func() (r []crypto.PublicKey)
+	GetNodeKeysCounter    uint64
+	GetNodeKeysPreCounter uint64
+	GetNodeKeysMock       mNodeKeeperMockGetNodeKeys
+
 	GetOriginFunc       func() (r insolar.NetworkNode)
 	GetOriginCounter    uint64
 	GetOriginPreCounter uint64
@@ -121,6 +127,7 @@ func NewNodeKeeperMock(t minimock.Tester) *NodeKeeperMock {
 	m.GetClaimQueueMock = mNodeKeeperMockGetClaimQueue{mock: m}
 	m.GetCloudHashMock = mNodeKeeperMockGetCloudHash{mock: m}
 	m.GetConsensusInfoMock = mNodeKeeperMockGetConsensusInfo{mock: m}
+	m.GetNodeKeysMock = mNodeKeeperMockGetNodeKeys{mock: m}
 	m.GetOriginMock = mNodeKeeperMockGetOrigin{mock: m}
 	m.GetOriginAnnounceClaimMock = mNodeKeeperMockGetOriginAnnounceClaim{mock: m}
 	m.GetOriginJoinClaimMock = mNodeKeeperMockGetOriginJoinClaim{mock: m}
@@ -674,6 +681,140 @@ func (m *NodeKeeperMock) GetConsensusInfoFinished() bool {
 	return true
 }
 
+type mNodeKeeperMockGetNodeKeys struct {
+	mock              *NodeKeeperMock
+	mainExpectation   *NodeKeeperMockGetNodeKeysExpectation
+	expectationSeries []*NodeKeeperMockGetNodeKeysExpectation
+}
+
+type NodeKeeperMockGetNodeKeysExpectation struct {
+	result *NodeKeeperMockGetNodeKeysResult
+}
+
+type NodeKeeperMockGetNodeKeysResult struct {
+	r []crypto.PublicKey
+}
+
+//Expect specifies that invocation of NodeKeeper.GetNodeKeys is expected from 1 to Infinity times
+func (m *mNodeKeeperMockGetNodeKeys) Expect() *mNodeKeeperMockGetNodeKeys {
+	m.mock.GetNodeKeysFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &NodeKeeperMockGetNodeKeysExpectation{}
+	}
+
+	return m
+}
+
+//Return specifies results of invocation of NodeKeeper.GetNodeKeys
+func (m *mNodeKeeperMockGetNodeKeys) Return(r []crypto.PublicKey) *NodeKeeperMock {
+	m.mock.GetNodeKeysFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &NodeKeeperMockGetNodeKeysExpectation{}
+	}
+	m.mainExpectation.result = &NodeKeeperMockGetNodeKeysResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of NodeKeeper.GetNodeKeys is expected once
+func (m *mNodeKeeperMockGetNodeKeys) ExpectOnce() *NodeKeeperMockGetNodeKeysExpectation {
+	m.mock.GetNodeKeysFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &NodeKeeperMockGetNodeKeysExpectation{}
+
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *NodeKeeperMockGetNodeKeysExpectation) Return(r []crypto.PublicKey) {
+	e.result = &NodeKeeperMockGetNodeKeysResult{r}
+}
+
+//Set uses given function f as a mock of NodeKeeper.GetNodeKeys method
+func (m *mNodeKeeperMockGetNodeKeys) Set(f func() (r []crypto.PublicKey)) *NodeKeeperMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.GetNodeKeysFunc = f
+	return m.mock
+}
+
+//GetNodeKeys implements github.com/insolar/insolar/network.NodeKeeper interface
+func (m *NodeKeeperMock) GetNodeKeys() (r []crypto.PublicKey) {
+	counter := atomic.AddUint64(&m.GetNodeKeysPreCounter, 1)
+	defer atomic.AddUint64(&m.GetNodeKeysCounter, 1)
+
+	if len(m.GetNodeKeysMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.GetNodeKeysMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to NodeKeeperMock.GetNodeKeys.")
+			return
+		}
+
+		result := m.GetNodeKeysMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the NodeKeeperMock.GetNodeKeys")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.GetNodeKeysMock.mainExpectation != nil {
+
+		result := m.GetNodeKeysMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the NodeKeeperMock.GetNodeKeys")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.GetNodeKeysFunc == nil {
+		m.t.Fatalf("Unexpected call to NodeKeeperMock.GetNodeKeys.")
+		return
+	}
+
+	return m.GetNodeKeysFunc()
+}
+
+//GetNodeKeysMinimockCounter returns a count of NodeKeeperMock.GetNodeKeysFunc invocations
+func (m *NodeKeeperMock) GetNodeKeysMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.GetNodeKeysCounter)
+}
+
+//GetNodeKeysMinimockPreCounter returns the value of NodeKeeperMock.GetNodeKeys invocations
+func (m *NodeKeeperMock) GetNodeKeysMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.GetNodeKeysPreCounter)
+}
+
+//GetNodeKeysFinished returns true if mock invocations count is ok
+func (m *NodeKeeperMock) GetNodeKeysFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.GetNodeKeysMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.GetNodeKeysCounter) == uint64(len(m.GetNodeKeysMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.GetNodeKeysMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.GetNodeKeysCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.GetNodeKeysFunc != nil {
+		return atomic.LoadUint64(&m.GetNodeKeysCounter) > 0
+	}
+
+	return true
+}
+
 type mNodeKeeperMockGetOrigin struct {
 	mock              *NodeKeeperMock
 	mainExpectation   *NodeKeeperMockGetOriginExpectation
@@ -2476,6 +2617,10 @@ func (m *NodeKeeperMock) ValidateCallCounters() {
 		m.t.Fatal("Expected call to NodeKeeperMock.GetConsensusInfo")
 	}
 
+	if !m.GetNodeKeysFinished() {
+		m.t.Fatal("Expected call to NodeKeeperMock.GetNodeKeys")
+	}
+
 	if !m.GetOriginFinished() {
 		m.t.Fatal("Expected call to NodeKeeperMock.GetOrigin")
 	}
@@ -2561,6 +2706,10 @@ func (m *NodeKeeperMock) MinimockFinish() {
 		m.t.Fatal("Expected call to NodeKeeperMock.GetConsensusInfo")
 	}
 
+	if !m.GetNodeKeysFinished() {
+		m.t.Fatal("Expected call to NodeKeeperMock.GetNodeKeys")
+	}
+
 	if !m.GetOriginFinished() {
 		m.t.Fatal("Expected call to NodeKeeperMock.GetOrigin")
 	}
@@ -2631,6 +2780,7 @@ func (m *NodeKeeperMock) MinimockWait(timeout time.Duration) {
 		ok = ok && m.GetClaimQueueFinished()
 		ok = ok && m.GetCloudHashFinished()
 		ok = ok && m.GetConsensusInfoFinished()
+		ok = ok && m.GetNodeKeysFinished()
 		ok = ok && m.GetOriginFinished()
 		ok = ok && m.GetOriginAnnounceClaimFinished()
 		ok = ok && m.GetOriginJoinClaimFinished()
@@ -2668,6 +2818,10 @@ func (m *NodeKeeperMock) MinimockWait(timeout time.Duration) {
 				m.t.Error("Expected call to NodeKeeperMock.GetConsensusInfo")
 			}
 
+			if !m.GetNodeKeysFinished() {
+				m.t.Error("Expected call to NodeKeeperMock.GetNodeKeys")
+			}
+
 			if !m.GetOriginFinished() {
 				m.t.Error("Expected call to NodeKeeperMock.GetOrigin")
 			}
@@ -2748,6 +2902,10 @@ func (m *NodeKeeperMock) AllMocksCalled() bool {
 		return false
 	}
 
+	if !m.GetNodeKeysFinished() {
+		return false
+	}
+
 	if !m.GetOriginFinished() {
 		return false
 	}
@@ -0,0 +1,583 @@
+package network
+
+/*
+DO NOT EDIT!
+This code was generated automatically using github.com/gojuno/minimock v1.9
+The original interface "Recorder" can be found in github.com/insolar/insolar/network/telemetry
+*/
+import (
+	context "context"
+	"sync/atomic"
+	"time"
+
+	"github.com/gojuno/minimock"
+	insolar "github.com/insolar/insolar/insolar"
+	telemetry "github.com/insolar/insolar/network/telemetry"
+
+	testify_assert "github.com/stretchr/testify/assert"
+)
+
+//RecorderMock implements github.com/insolar/insolar/network/telemetry.Recorder
+type RecorderMock struct {
+	t minimock.Tester
+
+	RecordFunc       func(p context.Context, p1 *telemetry.Report)
+	RecordCounter    uint64
+	RecordPreCounter uint64
+	RecordMock       mRecorderMockRecord
+
+	ReportFunc       func(p insolar.PulseNumber) (r *telemetry.Report, r1 bool)
+	ReportCounter    uint64
+	ReportPreCounter uint64
+	ReportMock       mRecorderMockReport
+
+	ReportsFunc       func(p int) (r []telemetry.Report)
+	ReportsCounter    uint64
+	ReportsPreCounter uint64
+	ReportsMock       mRecorderMockReports
+}
+
+//NewRecorderMock returns a mock for github.com/insolar/insolar/network/telemetry.Recorder
+func NewRecorderMock(t minimock.Tester) *RecorderMock {
+	m := &RecorderMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.RecordMock = mRecorderMockRecord{mock: m}
+	m.ReportMock = mRecorderMockReport{mock: m}
+	m.ReportsMock = mRecorderMockReports{mock: m}
+
+	return m
+}
+
+type mRecorderMockRecord struct {
+	mock              *RecorderMock
+	mainExpectation   *RecorderMockRecordExpectation
+	expectationSeries []*RecorderMockRecordExpectation
+}
+
+type RecorderMockRecordExpectation struct {
+	input *RecorderMockRecordInput
+}
+
+type RecorderMockRecordInput struct {
+	p  context.Context
+	p1 *telemetry.Report
+}
+
+//Expect specifies that invocation of Recorder.Record is expected from 1 to Infinity times
+func (m *mRecorderMockRecord) Expect(p context.Context, p1 *telemetry.Report) *mRecorderMockRecord {
+	m.mock.RecordFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &RecorderMockRecordExpectation{}
+	}
+	m.mainExpectation.input = &RecorderMockRecordInput{p, p1}
+	return m
+}
+
+//Return specifies results of invocation of Recorder.Record
+func (m *mRecorderMockRecord) Return() *RecorderMock {
+	m.mock.RecordFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &RecorderMockRecordExpectation{}
+	}
+
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Recorder.Record is expected once
+func (m *mRecorderMockRecord) ExpectOnce(p context.Context, p1 *telemetry.Report) *RecorderMockRecordExpectation {
+	m.mock.RecordFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &RecorderMockRecordExpectation{}
+	expectation.input = &RecorderMockRecordInput{p, p1}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+//Set uses given function f as a mock of Recorder.Record method
+func (m *mRecorderMockRecord) Set(f func(p context.Context, p1 *telemetry.Report)) *RecorderMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.RecordFunc = f
+	return m.mock
+}
+
+//Record implements github.com/insolar/insolar/network/telemetry.Recorder interface
+func (m *RecorderMock) Record(p context.Context, p1 *telemetry.Report) {
+	counter := atomic.AddUint64(&m.RecordPreCounter, 1)
+	defer atomic.AddUint64(&m.RecordCounter, 1)
+
+	if len(m.RecordMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.RecordMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to RecorderMock.Record. %v %v", p, p1)
+			return
+		}
+
+		input := m.RecordMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, RecorderMockRecordInput{p, p1}, "Recorder.Record got unexpected parameters")
+
+		return
+	}
+
+	if m.RecordMock.mainExpectation != nil {
+
+		input := m.RecordMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, RecorderMockRecordInput{p, p1}, "Recorder.Record got unexpected parameters")
+		}
+
+		return
+	}
+
+	if m.RecordFunc == nil {
+		m.t.Fatalf("Unexpected call to RecorderMock.Record. %v %v", p, p1)
+		return
+	}
+
+	m.RecordFunc(p, p1)
+}
+
+//RecordMinimockCounter returns a count of RecorderMock.RecordFunc invocations
+func (m *RecorderMock) RecordMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.RecordCounter)
+}
+
+//RecordMinimockPreCounter returns the value of RecorderMock.Record invocations
+func (m *RecorderMock) RecordMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.RecordPreCounter)
+}
+
+//RecordFinished returns true if mock invocations count is ok
+func (m *RecorderMock) RecordFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.RecordMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.RecordCounter) == uint64(len(m.RecordMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.RecordMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.RecordCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.RecordFunc != nil {
+		return atomic.LoadUint64(&m.RecordCounter) > 0
+	}
+
+	return true
+}
+
+type mRecorderMockReport struct {
+	mock              *RecorderMock
+	mainExpectation   *RecorderMockReportExpectation
+	expectationSeries []*RecorderMockReportExpectation
+}
+
+type RecorderMockReportExpectation struct {
+	input  *RecorderMockReportInput
+	result *RecorderMockReportResult
+}
+
+type RecorderMockReportInput struct {
+	p insolar.PulseNumber
+}
+
+type RecorderMockReportResult struct {
+	r  *telemetry.Report
+	r1 bool
+}
+
+//Expect specifies that invocation of Recorder.Report is expected from 1 to Infinity times
+func (m *mRecorderMockReport) Expect(p insolar.PulseNumber) *mRecorderMockReport {
+	m.mock.ReportFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &RecorderMockReportExpectation{}
+	}
+	m.mainExpectation.input = &RecorderMockReportInput{p}
+	return m
+}
+
+//Return specifies results of invocation of Recorder.Report
+func (m *mRecorderMockReport) Return(r *telemetry.Report, r1 bool) *RecorderMock {
+	m.mock.ReportFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &RecorderMockReportExpectation{}
+	}
+	m.mainExpectation.result = &RecorderMockReportResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Recorder.Report is expected once
+func (m *mRecorderMockReport) ExpectOnce(p insolar.PulseNumber) *RecorderMockReportExpectation {
+	m.mock.ReportFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &RecorderMockReportExpectation{}
+	expectation.input = &RecorderMockReportInput{p}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *RecorderMockReportExpectation) Return(r *telemetry.Report, r1 bool) {
+	e.result = &RecorderMockReportResult{r, r1}
+}
+
+//Set uses given function f as a mock of Recorder.Report method
+func (m *mRecorderMockReport) Set(f func(p insolar.PulseNumber) (r *telemetry.Report, r1 bool)) *RecorderMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.ReportFunc = f
+	return m.mock
+}
+
+//Report implements github.com/insolar/insolar/network/telemetry.Recorder interface
+func (m *RecorderMock) Report(p insolar.PulseNumber) (r *telemetry.Report, r1 bool) {
+	counter := atomic.AddUint64(&m.ReportPreCounter, 1)
+	defer atomic.AddUint64(&m.ReportCounter, 1)
+
+	if len(m.ReportMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.ReportMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to RecorderMock.Report. %v", p)
+			return
+		}
+
+		input := m.ReportMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, RecorderMockReportInput{p}, "Recorder.Report got unexpected parameters")
+
+		result := m.ReportMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the RecorderMock.Report")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.ReportMock.mainExpectation != nil {
+
+		input := m.ReportMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, RecorderMockReportInput{p}, "Recorder.Report got unexpected parameters")
+		}
+
+		result := m.ReportMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the RecorderMock.Report")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.ReportFunc == nil {
+		m.t.Fatalf("Unexpected call to RecorderMock.Report. %v", p)
+		return
+	}
+
+	return m.ReportFunc(p)
+}
+
+//ReportMinimockCounter returns a count of RecorderMock.ReportFunc invocations
+func (m *RecorderMock) ReportMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.ReportCounter)
+}
+
+//ReportMinimockPreCounter returns the value of RecorderMock.Report invocations
+func (m *RecorderMock) ReportMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.ReportPreCounter)
+}
+
+//ReportFinished returns true if mock invocations count is ok
+func (m *RecorderMock) ReportFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.ReportMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.ReportCounter) == uint64(len(m.ReportMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.ReportMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.ReportCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.ReportFunc != nil {
+		return atomic.LoadUint64(&m.ReportCounter) > 0
+	}
+
+	return true
+}
+
+type mRecorderMockReports struct {
+	mock              *RecorderMock
+	mainExpectation   *RecorderMockReportsExpectation
+	expectationSeries []*RecorderMockReportsExpectation
+}
+
+type RecorderMockReportsExpectation struct {
+	input  *RecorderMockReportsInput
+	result *RecorderMockReportsResult
+}
+
+type RecorderMockReportsInput struct {
+	p int
+}
+
+type RecorderMockReportsResult struct {
+	r []telemetry.Report
+}
+
+//Expect specifies that invocation of Recorder.Reports is expected from 1 to Infinity times
+func (m *mRecorderMockReports) Expect(p int) *mRecorderMockReports {
+	m.mock.ReportsFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &RecorderMockReportsExpectation{}
+	}
+	m.mainExpectation.input = &RecorderMockReportsInput{p}
+	return m
+}
+
+//Return specifies results of invocation of Recorder.Reports
+func (m *mRecorderMockReports) Return(r []telemetry.Report) *RecorderMock {
+	m.mock.ReportsFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &RecorderMockReportsExpectation{}
+	}
+	m.mainExpectation.result = &RecorderMockReportsResult{r}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of Recorder.Reports is expected once
+func (m *mRecorderMockReports) ExpectOnce(p int) *RecorderMockReportsExpectation {
+	m.mock.ReportsFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &RecorderMockReportsExpectation{}
+	expectation.input = &RecorderMockReportsInput{p}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *RecorderMockReportsExpectation) Return(r []telemetry.Report) {
+	e.result = &RecorderMockReportsResult{r}
+}
+
+//Set uses given function f as a mock of Recorder.Reports method
+func (m *mRecorderMockReports) Set(f func(p int) (r []telemetry.Report)) *RecorderMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.ReportsFunc = f
+	return m.mock
+}
+
+//Reports implements github.com/insolar/insolar/network/telemetry.Recorder interface
+func (m *RecorderMock) Reports(p int) (r []telemetry.Report) {
+	counter := atomic.AddUint64(&m.ReportsPreCounter, 1)
+	defer atomic.AddUint64(&m.ReportsCounter, 1)
+
+	if len(m.ReportsMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.ReportsMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to RecorderMock.Reports. %v", p)
+			return
+		}
+
+		input := m.ReportsMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, RecorderMockReportsInput{p}, "Recorder.Reports got unexpected parameters")
+
+		result := m.ReportsMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the RecorderMock.Reports")
+			return
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.ReportsMock.mainExpectation != nil {
+
+		input := m.ReportsMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, RecorderMockReportsInput{p}, "Recorder.Reports got unexpected parameters")
+		}
+
+		result := m.ReportsMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the RecorderMock.Reports")
+		}
+
+		r = result.r
+
+		return
+	}
+
+	if m.ReportsFunc == nil {
+		m.t.Fatalf("Unexpected call to RecorderMock.Reports. %v", p)
+		return
+	}
+
+	return m.ReportsFunc(p)
+}
+
+//ReportsMinimockCounter returns a count of RecorderMock.ReportsFunc invocations
+func (m *RecorderMock) ReportsMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.ReportsCounter)
+}
+
+//ReportsMinimockPreCounter returns the value of RecorderMock.Reports invocations
+func (m *RecorderMock) ReportsMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.ReportsPreCounter)
+}
+
+//ReportsFinished returns true if mock invocations count is ok
+func (m *RecorderMock) ReportsFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.ReportsMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.ReportsCounter) == uint64(len(m.ReportsMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.ReportsMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.ReportsCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.ReportsFunc != nil {
+		return atomic.LoadUint64(&m.ReportsCounter) > 0
+	}
+
+	return true
+}
+
+//ValidateCallCounters checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *RecorderMock) ValidateCallCounters() {
+
+	if !m.RecordFinished() {
+		m.t.Fatal("Expected call to RecorderMock.Record")
+	}
+
+	if !m.ReportFinished() {
+		m.t.Fatal("Expected call to RecorderMock.Report")
+	}
+
+	if !m.ReportsFinished() {
+		m.t.Fatal("Expected call to RecorderMock.Reports")
+	}
+
+}
+
+//CheckMocksCalled checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *RecorderMock) CheckMocksCalled() {
+	m.Finish()
+}
+
+//Finish checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish or use Finish method of minimock.Controller
+func (m *RecorderMock) Finish() {
+	m.MinimockFinish()
+}
+
+//MinimockFinish checks that all mocked methods of the interface have been called at least once
+func (m *RecorderMock) MinimockFinish() {
+
+	if !m.RecordFinished() {
+		m.t.Fatal("Expected call to RecorderMock.Record")
+	}
+
+	if !m.ReportFinished() {
+		m.t.Fatal("Expected call to RecorderMock.Report")
+	}
+
+	if !m.ReportsFinished() {
+		m.t.Fatal("Expected call to RecorderMock.Reports")
+	}
+
+}
+
+//Wait waits for all mocked methods to be called at least once
+//Deprecated: please use MinimockWait or use Wait method of minimock.Controller
+func (m *RecorderMock) Wait(timeout time.Duration) {
+	m.MinimockWait(timeout)
+}
+
+//MinimockWait waits for all mocked methods to be called at least once
+//this method is called by minimock.Controller
+func (m *RecorderMock) MinimockWait(timeout time.Duration) {
+	timeoutCh := time.After(timeout)
+	for {
+		ok := true
+		ok = ok && m.RecordFinished()
+		ok = ok && m.ReportFinished()
+		ok = ok && m.ReportsFinished()
+
+		if ok {
+			return
+		}
+
+		select {
+		case <-timeoutCh:
+
+			if !m.RecordFinished() {
+				m.t.Error("Expected call to RecorderMock.Record")
+			}
+
+			if !m.ReportFinished() {
+				m.t.Error("Expected call to RecorderMock.Report")
+			}
+
+			if !m.ReportsFinished() {
+				m.t.Error("Expected call to RecorderMock.Reports")
+			}
+
+			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+//AllMocksCalled returns true if all mocked methods were called before the execution of AllMocksCalled,
+//it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
+func (m *RecorderMock) AllMocksCalled() bool {
+
+	if !m.RecordFinished() {
+		return false
+	}
+
+	if !m.ReportFinished() {
+		return false
+	}
+
+	if !m.ReportsFinished() {
+		return false
+	}
+
+	return true
+}
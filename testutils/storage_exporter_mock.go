@@ -0,0 +1,267 @@
+package testutils
+
+/*
+DO NOT EDIT!
+This code was generated automatically using github.com/gojuno/minimock v1.9
+The original interface "StorageExporter" can be found in github.com/insolar/insolar/insolar
+*/
+import (
+	context "context"
+	"sync/atomic"
+	"time"
+
+	"github.com/gojuno/minimock"
+	insolar "github.com/insolar/insolar/insolar"
+
+	testify_assert "github.com/stretchr/testify/assert"
+)
+
+//StorageExporterMock implements github.com/insolar/insolar/insolar.StorageExporter
+type StorageExporterMock struct {
+	t minimock.Tester
+
+	ExportFunc       func(p context.Context, p1 insolar.PulseNumber, p2 int) (r *insolar.StorageExportResult, r1 error)
+	ExportCounter    uint64
+	ExportPreCounter uint64
+	ExportMock       mStorageExporterMockExport
+}
+
+//NewStorageExporterMock returns a mock for github.com/insolar/insolar/insolar.StorageExporter
+func NewStorageExporterMock(t minimock.Tester) *StorageExporterMock {
+	m := &StorageExporterMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ExportMock = mStorageExporterMockExport{mock: m}
+
+	return m
+}
+
+type mStorageExporterMockExport struct {
+	mock              *StorageExporterMock
+	mainExpectation   *StorageExporterMockExportExpectation
+	expectationSeries []*StorageExporterMockExportExpectation
+}
+
+type StorageExporterMockExportExpectation struct {
+	input  *StorageExporterMockExportInput
+	result *StorageExporterMockExportResult
+}
+
+type StorageExporterMockExportInput struct {
+	p  context.Context
+	p1 insolar.PulseNumber
+	p2 int
+}
+
+type StorageExporterMockExportResult struct {
+	r  *insolar.StorageExportResult
+	r1 error
+}
+
+//Expect specifies that invocation of StorageExporter.Export is expected from 1 to Infinity times
+func (m *mStorageExporterMockExport) Expect(p context.Context, p1 insolar.PulseNumber, p2 int) *mStorageExporterMockExport {
+	m.mock.ExportFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &StorageExporterMockExportExpectation{}
+	}
+	m.mainExpectation.input = &StorageExporterMockExportInput{p, p1, p2}
+	return m
+}
+
+//Return specifies results of invocation of StorageExporter.Export
+func (m *mStorageExporterMockExport) Return(r *insolar.StorageExportResult, r1 error) *StorageExporterMock {
+	m.mock.ExportFunc = nil
+	m.expectationSeries = nil
+
+	if m.mainExpectation == nil {
+		m.mainExpectation = &StorageExporterMockExportExpectation{}
+	}
+	m.mainExpectation.result = &StorageExporterMockExportResult{r, r1}
+	return m.mock
+}
+
+//ExpectOnce specifies that invocation of StorageExporter.Export is expected once
+func (m *mStorageExporterMockExport) ExpectOnce(p context.Context, p1 insolar.PulseNumber, p2 int) *StorageExporterMockExportExpectation {
+	m.mock.ExportFunc = nil
+	m.mainExpectation = nil
+
+	expectation := &StorageExporterMockExportExpectation{}
+	expectation.input = &StorageExporterMockExportInput{p, p1, p2}
+	m.expectationSeries = append(m.expectationSeries, expectation)
+	return expectation
+}
+
+func (e *StorageExporterMockExportExpectation) Return(r *insolar.StorageExportResult, r1 error) {
+	e.result = &StorageExporterMockExportResult{r, r1}
+}
+
+//Set uses given function f as a mock of StorageExporter.Export method
+func (m *mStorageExporterMockExport) Set(f func(p context.Context, p1 insolar.PulseNumber, p2 int) (r *insolar.StorageExportResult, r1 error)) *StorageExporterMock {
+	m.mainExpectation = nil
+	m.expectationSeries = nil
+
+	m.mock.ExportFunc = f
+	return m.mock
+}
+
+//Export implements github.com/insolar/insolar/insolar.StorageExporter interface
+func (m *StorageExporterMock) Export(p context.Context, p1 insolar.PulseNumber, p2 int) (r *insolar.StorageExportResult, r1 error) {
+	counter := atomic.AddUint64(&m.ExportPreCounter, 1)
+	defer atomic.AddUint64(&m.ExportCounter, 1)
+
+	if len(m.ExportMock.expectationSeries) > 0 {
+		if counter > uint64(len(m.ExportMock.expectationSeries)) {
+			m.t.Fatalf("Unexpected call to StorageExporterMock.Export. %v %v %v", p, p1, p2)
+			return
+		}
+
+		input := m.ExportMock.expectationSeries[counter-1].input
+		testify_assert.Equal(m.t, *input, StorageExporterMockExportInput{p, p1, p2}, "StorageExporter.Export got unexpected parameters")
+
+		result := m.ExportMock.expectationSeries[counter-1].result
+		if result == nil {
+			m.t.Fatal("No results are set for the StorageExporterMock.Export")
+			return
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.ExportMock.mainExpectation != nil {
+
+		input := m.ExportMock.mainExpectation.input
+		if input != nil {
+			testify_assert.Equal(m.t, *input, StorageExporterMockExportInput{p, p1, p2}, "StorageExporter.Export got unexpected parameters")
+		}
+
+		result := m.ExportMock.mainExpectation.result
+		if result == nil {
+			m.t.Fatal("No results are set for the StorageExporterMock.Export")
+		}
+
+		r = result.r
+		r1 = result.r1
+
+		return
+	}
+
+	if m.ExportFunc == nil {
+		m.t.Fatalf("Unexpected call to StorageExporterMock.Export. %v %v %v", p, p1, p2)
+		return
+	}
+
+	return m.ExportFunc(p, p1, p2)
+}
+
+//ExportMinimockCounter returns a count of StorageExporterMock.ExportFunc invocations
+func (m *StorageExporterMock) ExportMinimockCounter() uint64 {
+	return atomic.LoadUint64(&m.ExportCounter)
+}
+
+//ExportMinimockPreCounter returns the value of StorageExporterMock.Export invocations
+func (m *StorageExporterMock) ExportMinimockPreCounter() uint64 {
+	return atomic.LoadUint64(&m.ExportPreCounter)
+}
+
+//ExportFinished returns true if mock invocations count is ok
+func (m *StorageExporterMock) ExportFinished() bool {
+	// if expectation series were set then invocations count should be equal to expectations count
+	if len(m.ExportMock.expectationSeries) > 0 {
+		return atomic.LoadUint64(&m.ExportCounter) == uint64(len(m.ExportMock.expectationSeries))
+	}
+
+	// if main expectation was set then invocations count should be greater than zero
+	if m.ExportMock.mainExpectation != nil {
+		return atomic.LoadUint64(&m.ExportCounter) > 0
+	}
+
+	// if func was set then invocations count should be greater than zero
+	if m.ExportFunc != nil {
+		return atomic.LoadUint64(&m.ExportCounter) > 0
+	}
+
+	return true
+}
+
+//ValidateCallCounters checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *StorageExporterMock) ValidateCallCounters() {
+
+	if !m.ExportFinished() {
+		m.t.Fatal("Expected call to StorageExporterMock.Export")
+	}
+
+}
+
+//CheckMocksCalled checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish method or use Finish method of minimock.Controller
+func (m *StorageExporterMock) CheckMocksCalled() {
+	m.Finish()
+}
+
+//Finish checks that all mocked methods of the interface have been called at least once
+//Deprecated: please use MinimockFinish or use Finish method of minimock.Controller
+func (m *StorageExporterMock) Finish() {
+	m.MinimockFinish()
+}
+
+//MinimockFinish checks that all mocked methods of the interface have been called at least once
+func (m *StorageExporterMock) MinimockFinish() {
+
+	if !m.ExportFinished() {
+		m.t.Fatal("Expected call to StorageExporterMock.Export")
+	}
+
+}
+
+//Wait waits for all mocked methods to be called at least once
+//Deprecated: please use MinimockWait or use Wait method of minimock.Controller
+func (m *StorageExporterMock) Wait(timeout time.Duration) {
+	m.MinimockWait(timeout)
+}
+
+//MinimockWait waits for all mocked methods to be called at least once
+//this method is called by minimock.Controller
+func (m *StorageExporterMock) MinimockWait(timeout time.Duration) {
+	timeoutCh := time.After(timeout)
+	for {
+		ok := true
+		ok = ok && m.ExportFinished()
+
+		if ok {
+			return
+		}
+
+		select {
+		case <-timeoutCh:
+
+			if !m.ExportFinished() {
+				m.t.Error("Expected call to StorageExporterMock.Export")
+			}
+
+			m.t.Fatalf("Some mocks were not called on time: %s", timeout)
+			return
+		default:
+			time.Sleep(time.Millisecond)
+		}
+	}
+}
+
+//AllMocksCalled returns true if all mocked methods were called before the execution of AllMocksCalled,
+//it can be used with assert/require, i.e. assert.True(mock.AllMocksCalled())
+func (m *StorageExporterMock) AllMocksCalled() bool {
+
+	if !m.ExportFinished() {
+		return false
+	}
+
+	return true
+}
@@ -45,6 +45,8 @@ type TapeRecord struct {
 
 type TestMessageBus struct {
 	handlers      map[insolar.MessageType]insolar.MessageHandler
+	inbound       []insolar.InboundInterceptor
+	outbound      []insolar.OutboundInterceptor
 	pf            message.ParcelFactory
 	PulseAccessor pulse.Accessor
 	ReadingTape   []TapeRecord
@@ -122,7 +124,23 @@ func (mb *TestMessageBus) MustRegister(p insolar.MessageType, handler insolar.Me
 	}
 }
 
-func (mb *TestMessageBus) Send(ctx context.Context, m insolar.Message, _ *insolar.MessageSendOptions) (insolar.Reply, error) {
+func (mb *TestMessageBus) AddInboundInterceptor(interceptor insolar.InboundInterceptor) {
+	mb.inbound = append(mb.inbound, interceptor)
+}
+
+func (mb *TestMessageBus) AddOutboundInterceptor(interceptor insolar.OutboundInterceptor) {
+	mb.outbound = append(mb.outbound, interceptor)
+}
+
+func (mb *TestMessageBus) Send(ctx context.Context, m insolar.Message, ops *insolar.MessageSendOptions) (insolar.Reply, error) {
+	send := insolar.MessageSender(mb.send)
+	for i := len(mb.outbound) - 1; i >= 0; i-- {
+		send = mb.outbound[i](send)
+	}
+	return send(ctx, m, ops)
+}
+
+func (mb *TestMessageBus) send(ctx context.Context, m insolar.Message, _ *insolar.MessageSendOptions) (insolar.Reply, error) {
 	if mb.ReadingTape != nil {
 		if len(mb.ReadingTape) == 0 {
 			return nil, errors.Errorf("No expected messages, got %+v", m)
@@ -153,6 +171,10 @@ func (mb *TestMessageBus) Send(ctx context.Context, m insolar.Message, _ *insola
 		return nil, errors.New(fmt.Sprint("no handler for message type:", t.String()))
 	}
 
+	for i := len(mb.inbound) - 1; i >= 0; i-- {
+		handler = mb.inbound[i](handler)
+	}
+
 	ctx = parcel.Context(context.Background())
 
 	reply, err := handler(ctx, parcel)
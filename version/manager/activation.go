@@ -0,0 +1,188 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package manager
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/blang/semver"
+
+	"github.com/insolar/insolar/insolar"
+)
+
+// capabilitySupportTTL is a distance in pulse numbers during which node capability claim is taken into account.
+// Pulse numbers are the same on all nodes, so the window does not depend on the number of rounds passed by the node.
+const capabilitySupportTTL = insolar.PulseNumber(100)
+
+// reportedActivation is an activation pulse of a feature reported by a node at the pulse.
+type reportedActivation struct {
+	pulse      insolar.PulseNumber
+	activation insolar.PulseNumber
+}
+
+// SetNodeVersion changes version of the current node. It is safe to call while the node takes part in consensus.
+func (vm *VersionManager) SetNodeVersion(nodeVersion *semver.Version) {
+	vm.activationLock.Lock()
+	defer vm.activationLock.Unlock()
+
+	vm.NodeVersion = nodeVersion
+}
+
+// SupportedFeatures returns sorted keys of features supported by the current node version.
+func (vm *VersionManager) SupportedFeatures() []string {
+	vm.activationLock.RLock()
+	defer vm.activationLock.RUnlock()
+
+	result := make([]string, 0, len(vm.VersionTable))
+	for key, feature := range vm.VersionTable {
+		if feature.StartVersion.Compare(*vm.NodeVersion) <= 0 {
+			result = append(result, key)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// ActivatedFeatures returns activation pulses of features activated by the network.
+func (vm *VersionManager) ActivatedFeatures() map[string]insolar.PulseNumber {
+	vm.activationLock.RLock()
+	defer vm.activationLock.RUnlock()
+
+	result := make(map[string]insolar.PulseNumber, len(vm.activated))
+	for key, pulse := range vm.activated {
+		result[key] = pulse
+	}
+	return result
+}
+
+// ProcessCapabilities takes into account capability claims of active nodes received during consensus
+// and activates features supported by configured percent of active nodes. Activation is permanent.
+// A node that has missed the activation (e.g. joiner or restarted node) adopts the activation pulse
+// reported by configured percent of active nodes. Returns activation pulses of features activated at the pulse.
+func (vm *VersionManager) ProcessCapabilities(
+	pulse insolar.PulseNumber,
+	active []insolar.Reference,
+	advertised map[string][]insolar.Reference,
+	reported map[string]map[insolar.Reference]insolar.PulseNumber,
+) map[string]insolar.PulseNumber {
+	vm.activationLock.Lock()
+	defer vm.activationLock.Unlock()
+
+	activeSet := make(map[insolar.Reference]struct{}, len(active))
+	for _, ref := range active {
+		activeSet[ref] = struct{}{}
+	}
+
+	for key, nodes := range advertised {
+		key = strings.ToLower(key)
+		if vm.Get(key) == nil {
+			continue
+		}
+		if vm.support[key] == nil {
+			vm.support[key] = make(map[insolar.Reference]insolar.PulseNumber)
+		}
+		for _, ref := range nodes {
+			if _, ok := activeSet[ref]; ok {
+				vm.support[key][ref] = pulse
+			}
+		}
+	}
+	for key, nodes := range reported {
+		key = strings.ToLower(key)
+		if vm.Get(key) == nil {
+			continue
+		}
+		if vm.reported[key] == nil {
+			vm.reported[key] = make(map[insolar.Reference]reportedActivation)
+		}
+		for ref, activation := range nodes {
+			if _, ok := activeSet[ref]; ok {
+				vm.reported[key][ref] = reportedActivation{pulse: pulse, activation: activation}
+			}
+		}
+	}
+	vm.expireClaims(pulse, activeSet)
+
+	quorum := func(count int) bool {
+		return len(active) > 0 && count*100 >= vm.activationPercent*len(active)
+	}
+	result := make(map[string]insolar.PulseNumber)
+	for key, nodes := range vm.reported {
+		if _, ok := vm.activated[key]; ok {
+			continue
+		}
+		counts := make(map[insolar.PulseNumber]int)
+		for _, r := range nodes {
+			counts[r.activation]++
+		}
+		// activation percent is greater than 50, so only one activation pulse can reach quorum
+		for activation, count := range counts {
+			if quorum(count) {
+				vm.activated[key] = activation
+				result[key] = activation
+			}
+		}
+	}
+	for key, nodes := range vm.support {
+		if _, ok := vm.activated[key]; ok {
+			continue
+		}
+		if quorum(len(nodes)) {
+			vm.activated[key] = pulse
+			result[key] = pulse
+		}
+	}
+	return result
+}
+
+// expireClaims forgets claims of nodes that are not active anymore and claims older than capabilitySupportTTL.
+func (vm *VersionManager) expireClaims(pulse insolar.PulseNumber, activeSet map[insolar.Reference]struct{}) {
+	expired := func(ref insolar.Reference, claimPulse insolar.PulseNumber) bool {
+		_, isActive := activeSet[ref]
+		return !isActive || pulse-claimPulse >= capabilitySupportTTL
+	}
+	for _, nodes := range vm.support {
+		for ref, claimPulse := range nodes {
+			if expired(ref, claimPulse) {
+				delete(nodes, ref)
+			}
+		}
+	}
+	for _, nodes := range vm.reported {
+		for ref, r := range nodes {
+			if expired(ref, r.pulse) {
+				delete(nodes, ref)
+			}
+		}
+	}
+}
+
+// IsActivated checks if feature was activated by the network.
+func (vm *VersionManager) IsActivated(key string) bool {
+	_, ok := vm.ActivationPulse(key)
+	return ok
+}
+
+// ActivationPulse returns pulse number at which feature was activated by the network.
+func (vm *VersionManager) ActivationPulse(key string) (insolar.PulseNumber, bool) {
+	vm.activationLock.RLock()
+	defer vm.activationLock.RUnlock()
+
+	pulse, ok := vm.activated[strings.ToLower(key)]
+	return pulse, ok
+}
@@ -0,0 +1,142 @@
+//
+// Copyright 2019 Insolar Technologies GmbH
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package manager
+
+import (
+	"testing"
+
+	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestActivationManager(t *testing.T) *VersionManager {
+	vm, err := NewVersionManager(configuration.NewVersionManager())
+	require.NoError(t, err)
+	_, err = vm.Add("feature", "v1.1.0", "Feature for activation test")
+	require.NoError(t, err)
+	_, err = vm.Add("future", "v2.0.0", "Feature that is not supported by the node")
+	require.NoError(t, err)
+	nodeVersion, err := ParseVersion("v1.5.0")
+	require.NoError(t, err)
+	vm.SetNodeVersion(nodeVersion)
+	return vm
+}
+
+func TestVersionManager_SupportedFeatures(t *testing.T) {
+	vm := newTestActivationManager(t)
+	assert.Equal(t, []string{"feature"}, vm.SupportedFeatures())
+}
+
+func TestVersionManager_ProcessCapabilities(t *testing.T) {
+	vm := newTestActivationManager(t)
+	active := []insolar.Reference{testutils.RandomRef(), testutils.RandomRef(), testutils.RandomRef(), testutils.RandomRef()}
+
+	activated := vm.ProcessCapabilities(insolar.PulseNumber(100), active, map[string][]insolar.Reference{
+		"feature": active[:2],
+		"unknown": active,
+	}, nil)
+	assert.Empty(t, activated)
+	assert.False(t, vm.IsAvailable("feature"))
+
+	// support from nodes that are not active is ignored
+	activated = vm.ProcessCapabilities(insolar.PulseNumber(110), active, map[string][]insolar.Reference{
+		"FEATURE": {testutils.RandomRef()},
+	}, nil)
+	assert.Empty(t, activated)
+
+	// previous claims are still taken into account
+	activated = vm.ProcessCapabilities(insolar.PulseNumber(120), active, map[string][]insolar.Reference{
+		"feature": active[2:3],
+	}, nil)
+	assert.Equal(t, map[string]insolar.PulseNumber{"feature": 120}, activated)
+	assert.True(t, vm.IsAvailable("Feature"))
+	pulse, ok := vm.ActivationPulse("feature")
+	assert.True(t, ok)
+	assert.Equal(t, insolar.PulseNumber(120), pulse)
+	assert.Equal(t, map[string]insolar.PulseNumber{"feature": 120}, vm.ActivatedFeatures())
+
+	// activation is permanent
+	activated = vm.ProcessCapabilities(insolar.PulseNumber(130), active, nil, nil)
+	assert.Empty(t, activated)
+	assert.True(t, vm.IsActivated("feature"))
+}
+
+func TestVersionManager_ProcessCapabilities_SupportExpires(t *testing.T) {
+	vm := newTestActivationManager(t)
+	active := []insolar.Reference{testutils.RandomRef(), testutils.RandomRef(), testutils.RandomRef()}
+
+	vm.ProcessCapabilities(insolar.PulseNumber(100), active, map[string][]insolar.Reference{"feature": active[:1]}, nil)
+	vm.ProcessCapabilities(insolar.PulseNumber(150), active, nil, nil)
+	activated := vm.ProcessCapabilities(100+capabilitySupportTTL, active, map[string][]insolar.Reference{"feature": active[1:2]}, nil)
+	assert.Empty(t, activated)
+	assert.False(t, vm.IsActivated("feature"))
+}
+
+func TestVersionManager_ProcessCapabilities_SupportDoesNotDependOnRounds(t *testing.T) {
+	vm := newTestActivationManager(t)
+	active := []insolar.Reference{testutils.RandomRef(), testutils.RandomRef(), testutils.RandomRef()}
+
+	// node that passes every round and node that has missed rounds make the same decision
+	passed := newTestActivationManager(t)
+	passed.ProcessCapabilities(insolar.PulseNumber(100), active, map[string][]insolar.Reference{"feature": active[:1]}, nil)
+	vm.ProcessCapabilities(insolar.PulseNumber(100), active, map[string][]insolar.Reference{"feature": active[:1]}, nil)
+	for pulse := insolar.PulseNumber(110); pulse < 100+capabilitySupportTTL; pulse += 10 {
+		passed.ProcessCapabilities(pulse, active, nil, nil)
+	}
+
+	last := 100 + capabilitySupportTTL - 1
+	assert.Equal(t,
+		passed.ProcessCapabilities(last, active, map[string][]insolar.Reference{"feature": active[1:2]}, nil),
+		vm.ProcessCapabilities(last, active, map[string][]insolar.Reference{"feature": active[1:2]}, nil),
+	)
+	assert.True(t, vm.IsActivated("feature"))
+}
+
+func TestVersionManager_ProcessCapabilities_AdoptsReportedActivation(t *testing.T) {
+	vm := newTestActivationManager(t)
+	active := []insolar.Reference{testutils.RandomRef(), testutils.RandomRef(), testutils.RandomRef()}
+
+	// activation pulse reported by minority is not adopted
+	activated := vm.ProcessCapabilities(insolar.PulseNumber(200), active, nil, map[string]map[insolar.Reference]insolar.PulseNumber{
+		"feature": {active[0]: 120, active[1]: 130},
+	})
+	assert.Empty(t, activated)
+	assert.False(t, vm.IsAvailable("feature"))
+
+	// reported activation pulse takes precedence over support of active nodes
+	activated = vm.ProcessCapabilities(insolar.PulseNumber(210), active, map[string][]insolar.Reference{
+		"feature": active,
+	}, map[string]map[insolar.Reference]insolar.PulseNumber{
+		"feature": {active[2]: 120},
+		"unknown": {active[0]: 120, active[1]: 120, active[2]: 120},
+	})
+	assert.Equal(t, map[string]insolar.PulseNumber{"feature": 120}, activated)
+	assert.True(t, vm.IsAvailable("feature"))
+	pulse, ok := vm.ActivationPulse("feature")
+	assert.True(t, ok)
+	assert.Equal(t, insolar.PulseNumber(120), pulse)
+}
+
+func TestNewVersionManager_InvalidActivationPercent(t *testing.T) {
+	_, err := NewVersionManager(configuration.VersionManager{MinAlowedVersion: "v0.3.0", FeatureActivationPercent: 50})
+	assert.Error(t, err)
+	_, err = NewVersionManager(configuration.VersionManager{MinAlowedVersion: "v0.3.0", FeatureActivationPercent: 101})
+	assert.Error(t, err)
+}
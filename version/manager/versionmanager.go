@@ -20,16 +20,29 @@ import (
 	"context"
 	"errors"
 	"strings"
+	"sync"
 
 	"github.com/blang/semver"
 	"github.com/insolar/insolar/configuration"
+	"github.com/insolar/insolar/insolar"
+	"github.com/insolar/insolar/version"
 	"github.com/spf13/viper"
 )
 
 type VersionManager struct {
 	VersionTable  map[string]*Feature
 	AgreedVersion *semver.Version
-	viper         *viper.Viper
+	// NodeVersion is a version of current node, features with greater start version are not supported by the node
+	NodeVersion *semver.Version
+	viper       *viper.Viper
+
+	activationPercent int
+	activationLock    sync.RWMutex
+	// support contains pulse of the last capability claim for each feature and node
+	support map[string]map[insolar.Reference]insolar.PulseNumber
+	// reported contains activation pulses handed by nodes that know about the activation
+	reported  map[string]map[insolar.Reference]reportedActivation
+	activated map[string]insolar.PulseNumber
 }
 
 type VersionTable struct {
@@ -49,12 +62,25 @@ func GetVersionManager() (*VersionManager, error) {
 	return instance, nil
 }
 
+// InitVersionManager creates VersionManager from configuration and makes it accessible with GetVersionManager.
+func InitVersionManager(cfg configuration.VersionManager) (*VersionManager, error) {
+	vm, err := NewVersionManager(cfg)
+	if err != nil {
+		return nil, err
+	}
+	instance = vm
+	return vm, nil
+}
+
 func (vm *VersionManager) IsAvailable(key string) bool {
 	key = strings.ToLower(key)
 	feature := vm.Get(key)
 	if feature == nil {
 		return false
 	}
+	if vm.IsActivated(key) {
+		return true
+	}
 	if feature.StartVersion.Compare(*vm.AgreedVersion) <= 0 {
 		return true
 	}
@@ -68,10 +94,22 @@ func NewVersionManager(cfg configuration.VersionManager) (*VersionManager, error
 	if err != nil {
 		return nil, err
 	}
+	nodeVersion, err := ParseVersion(version.Version)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.FeatureActivationPercent <= 50 || cfg.FeatureActivationPercent > 100 {
+		return nil, errors.New("Feature activation percent should be in range (50, 100]")
+	}
 	vm := &VersionManager{
-		versionTable,
-		baseVersion,
-		viper.New(),
+		VersionTable:      versionTable,
+		AgreedVersion:     baseVersion,
+		NodeVersion:       nodeVersion,
+		viper:             viper.New(),
+		activationPercent: cfg.FeatureActivationPercent,
+		support:           make(map[string]map[insolar.Reference]insolar.PulseNumber),
+		reported:          make(map[string]map[insolar.Reference]reportedActivation),
+		activated:         make(map[string]insolar.PulseNumber),
 	}
 	vm.viper.SetDefault("versiontable", vm.VersionTable)
 	vm.viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))